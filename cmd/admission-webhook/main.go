@@ -27,6 +27,7 @@ import (
 
 	"github.com/pingcap/tidb-operator/pkg/features"
 	"github.com/pingcap/tidb-operator/pkg/version"
+	"github.com/pingcap/tidb-operator/pkg/webhook/pod"
 	"github.com/pingcap/tidb-operator/pkg/webhook/statefulset"
 	"github.com/pingcap/tidb-operator/pkg/webhook/strategy"
 
@@ -79,8 +80,9 @@ func main() {
 
 	statefulSetAdmissionHook := statefulset.NewStatefulSetAdmissionControl()
 	strategyAdmissionHook := strategy.NewStrategyAdmissionHook(&strategy.Registry)
+	podEvictionAdmissionHook := pod.NewPodEvictionAdmissionControl()
 
-	runAdmissionServer(statefulSetAdmissionHook, strategyAdmissionHook)
+	runAdmissionServer(statefulSetAdmissionHook, strategyAdmissionHook, podEvictionAdmissionHook)
 }
 
 // the following code copied from generic-admission-server before the commit
@@ -16,6 +16,7 @@ package backup
 import (
 	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -30,12 +31,14 @@ import (
 	backupUtil "github.com/pingcap/tidb-operator/cmd/backup-manager/app/util"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/backup/constants"
+	"github.com/pingcap/tidb-operator/pkg/backup/snapshotter"
 	pkgutil "github.com/pingcap/tidb-operator/pkg/backup/util"
 	"github.com/pingcap/tidb-operator/pkg/controller"
 	"github.com/pingcap/tidb-operator/pkg/util"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Options contains the input arguments to the backup command
@@ -48,6 +51,7 @@ func (bo *Options) backupData(
 	ctx context.Context,
 	backup *v1alpha1.Backup,
 	statusUpdater controller.BackupConditionUpdaterInterface,
+	genericCli client.Client,
 ) error {
 	var backupType string
 	if backup.Spec.Type == "" {
@@ -60,7 +64,10 @@ func (bo *Options) backupData(
 		backupType,
 	}
 
-	var logCallback func(line string)
+	var (
+		logCallback func(line string)
+		taker       snapshotter.VolumeSnapshotTaker
+	)
 	// Add extra args for volume snapshot backup.
 	if bo.Mode == string(v1alpha1.BackupModeVolumeSnapshot) && !bo.Initialize {
 		var (
@@ -85,10 +92,18 @@ func (bo *Options) backupData(
 		if err != nil {
 			return err
 		}
-		// Currently, we only support aws ebs volume snapshot.
 		specificArgs = append(specificArgs, "--type=aws-ebs")
 		specificArgs = append(specificArgs, fmt.Sprintf("--volume-file=%s", localCSBFile))
 		specificArgs = append(specificArgs, "--operator-paused-gc-and-scheduler=true")
+		// BR can only take the snapshots of aws ebs volumes. For other volumes, BR only resolves the ts
+		// of the backup and writes the backup meta, then the snapshots are taken by the VolumeSnapshotTaker
+		// while GC and PD schedulers are still paused by the initializing job.
+		if !snapshotter.SnapshotsTakenByBR(backup) {
+			specificArgs = append(specificArgs, "--skip-aws=true")
+			if taker, err = snapshotter.NewVolumeSnapshotTaker(backup, genericCli); err != nil {
+				return err
+			}
+		}
 		logCallback = func(line string) {
 			if strings.Contains(line, successTag) {
 				extract := strings.Split(line, successTag)[1]
@@ -121,7 +136,47 @@ func (bo *Options) backupData(
 	if err != nil {
 		return err
 	}
-	return bo.brCommandRunWithLogCallback(ctx, fullArgs, logCallback)
+	if err := bo.brCommandRunWithLogCallback(ctx, fullArgs, logCallback); err != nil {
+		return err
+	}
+	if taker != nil {
+		return bo.takeVolumeSnapshots(ctx, backup, taker)
+	}
+	return nil
+}
+
+// takeVolumeSnapshots takes the snapshots of the volumes in the backup meta written by BR,
+// and writes the snapshot IDs back to the backup meta.
+func (bo *Options) takeVolumeSnapshots(ctx context.Context, backup *v1alpha1.Backup, taker snapshotter.VolumeSnapshotTaker) error {
+	externalStorage, err := pkgutil.NewStorageBackend(backup.Spec.StorageProvider, &pkgutil.StorageCredential{})
+	if err != nil {
+		return err
+	}
+	defer externalStorage.Close()
+
+	contents, err := externalStorage.ReadAll(ctx, constants.MetaFile)
+	if err != nil {
+		return fmt.Errorf("read backup meta of %s failed, err: %v", bo, err)
+	}
+	meta := &pkgutil.EBSBasedBRMeta{}
+	if err := json.Unmarshal(contents, meta); err != nil {
+		return fmt.Errorf("unmarshal backup meta of %s failed, err: %v", bo, err)
+	}
+
+	klog.Infof("take volume snapshots of %s", bo)
+	if err := taker.TakeSnapshots(ctx, backup, meta); err != nil {
+		return err
+	}
+
+	contents, err = json.Marshal(meta)
+	if err != nil {
+		return fmt.Errorf("marshal backup meta of %s failed, err: %v", bo, err)
+	}
+	if err := externalStorage.WriteAll(ctx, constants.MetaFile, contents, nil); err != nil {
+		return fmt.Errorf("write backup meta of %s failed, err: %v", bo, err)
+	}
+	klog.Infof("volume snapshots of %s are taken", bo)
+	return nil
 }
 
 // constructOptions constructs options for BR
@@ -29,6 +29,8 @@ import (
 	"github.com/pingcap/tidb-operator/cmd/backup-manager/app/util"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	bkconstants "github.com/pingcap/tidb-operator/pkg/backup/constants"
+	"github.com/pingcap/tidb-operator/pkg/backup/snapshotter"
+	backuputil "github.com/pingcap/tidb-operator/pkg/backup/util"
 	listers "github.com/pingcap/tidb-operator/pkg/client/listers/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/controller"
 	pkgutil "github.com/pingcap/tidb-operator/pkg/util"
@@ -38,6 +40,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/json"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -49,6 +52,7 @@ const (
 type Manager struct {
 	backupLister  listers.BackupLister
 	StatusUpdater controller.BackupConditionUpdaterInterface
+	genericCli    client.Client
 	Options
 }
 
@@ -56,10 +60,12 @@ type Manager struct {
 func NewManager(
 	backupLister listers.BackupLister,
 	statusUpdater controller.BackupConditionUpdaterInterface,
+	genericCli client.Client,
 	backupOpts Options) *Manager {
 	return &Manager{
 		backupLister,
 		statusUpdater,
+		genericCli,
 		backupOpts,
 	}
 }
@@ -316,6 +322,22 @@ func (bm *Manager) performBackup(ctx context.Context, backup *v1alpha1.Backup, d
 		}
 	}
 
+	// the backup data is retained by the storage at write time, check it before writing any data
+	if backuputil.GetObjectLock(backup.Spec.StorageProvider) != nil {
+		if err := util.CheckBackupDataRetention(ctx, backup.Spec.StorageProvider, &backuputil.StorageCredential{}); err != nil {
+			errs = append(errs, err)
+			klog.Errorf("check retention of backup storage of cluster %s failed, err: %s", bm, err)
+			uerr := bm.StatusUpdater.Update(backup, &v1alpha1.BackupCondition{
+				Type:    v1alpha1.BackupFailed,
+				Status:  corev1.ConditionTrue,
+				Reason:  "CheckBackupDataRetentionFailed",
+				Message: err.Error(),
+			}, nil)
+			errs = append(errs, uerr)
+			return errorutils.NewAggregate(errs)
+		}
+	}
+
 	// change Prepare to Running before real backup process start
 	if err := bm.StatusUpdater.Update(backup, &v1alpha1.BackupCondition{
 		Type:   v1alpha1.BackupRunning,
@@ -325,11 +347,11 @@ func (bm *Manager) performBackup(ctx context.Context, backup *v1alpha1.Backup, d
 	}
 
 	// run br binary to do the real job
-	backupErr := bm.backupData(ctx, backup, bm.StatusUpdater)
+	backupErr := bm.backupData(ctx, backup, bm.StatusUpdater, bm.genericCli)
 
 	defer func() {
 		// Calculate the backup size for ebs backup job even if it fails
-		if bm.Mode == string(v1alpha1.BackupModeVolumeSnapshot) && !bm.Initialize {
+		if bm.Mode == string(v1alpha1.BackupModeVolumeSnapshot) && !bm.Initialize && snapshotter.SnapshotsTakenByBR(backup) {
 			fullBackupSize, incrementalBackupSize, err := util.CalcVolSnapBackupSize(ctx, backup.Spec.StorageProvider, backup.Spec.CalcSizeLevel)
 			if err != nil {
 				klog.Errorf("Failed to calc volume snapshot backup, err: %v", err)
@@ -427,7 +449,7 @@ func (bm *Manager) performBackup(ctx context.Context, backup *v1alpha1.Backup, d
 			return errorutils.NewAggregate(errs)
 		}
 		klog.Infof("Get br metadata for backup files in %s of cluster %s success", backupFullPath, bm)
-		backupSize := int64(util.GetBRArchiveSize(backupMeta))
+		backupSize := int64(backuputil.GetBRArchiveSize(backupMeta))
 		backupSizeReadable := humanize.Bytes(uint64(backupSize))
 		commitTS := backupMeta.EndVersion
 		klog.Infof("Get size %d for backup files in %s of cluster %s success", backupSize, backupFullPath, bm)
@@ -442,6 +464,34 @@ func (bm *Manager) performBackup(ctx context.Context, backup *v1alpha1.Backup, d
 		}
 	}
 
+	// verify the backup data is retained before it is complete, so that a complete backup can't be deleted before the retain date
+	if updateStatus != nil && backuputil.GetObjectLock(backup.Spec.StorageProvider) != nil {
+		result, err := util.VerifyBackupDataRetention(ctx, backup.Spec.StorageProvider, &backuputil.StorageCredential{}, backup.CreationTimestamp.Time)
+		if err != nil {
+			errs = append(errs, err)
+			klog.Errorf("verify retention of backup files in %s of cluster %s failed, err: %s", backupFullPath, bm, err)
+			failedCondition := v1alpha1.BackupFailed
+			if bm.Mode == string(v1alpha1.BackupModeVolumeSnapshot) {
+				failedCondition = v1alpha1.VolumeBackupFailed
+			}
+			uerr := bm.StatusUpdater.Update(backup, &v1alpha1.BackupCondition{
+				Type:    failedCondition,
+				Status:  corev1.ConditionTrue,
+				Reason:  "VerifyBackupDataRetentionFailed",
+				Message: err.Error(),
+			}, nil)
+			errs = append(errs, uerr)
+			return errorutils.NewAggregate(errs)
+		}
+		klog.Infof("verify %d backup files in %s of cluster %s are retained until %s success", result.ObjectCount, backupFullPath, bm, result.RetainUntil.Format(time.RFC3339))
+	}
+
+	// copy the snapshot backup to the replica storages before it is complete,
+	// so that the replicas are ready once the backup is seen as complete
+	if completeCondition == v1alpha1.BackupComplete && len(backup.Spec.Replicas) > 0 {
+		bm.replicateBackup(ctx, backup)
+	}
+
 	return bm.StatusUpdater.Update(backup, &v1alpha1.BackupCondition{
 		Type:   completeCondition,
 		Status: corev1.ConditionTrue,
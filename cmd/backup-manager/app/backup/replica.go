@@ -0,0 +1,132 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	backupUtil "github.com/pingcap/tidb-operator/cmd/backup-manager/app/util"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	pkgutil "github.com/pingcap/tidb-operator/pkg/backup/util"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// replicaCopyConcurrency is the number of objects copied to a replica storage concurrently
+const replicaCopyConcurrency = 8
+
+// replicateBackup copies the backup data to each replica storage and records the status of each replica.
+// The backup data is already written, so the failure of a replica is recorded in its status and doesn't fail the backup.
+func (bm *Manager) replicateBackup(ctx context.Context, backup *v1alpha1.Backup) {
+	src, err := pkgutil.NewStorageBackend(backup.Spec.StorageProvider, &pkgutil.StorageCredential{})
+	if err != nil {
+		klog.Errorf("create storage backend of backup %s failed, err: %s", bm, err)
+		for i := range backup.Spec.Replicas {
+			bm.updateReplicaStatus(backup, &v1alpha1.BackupReplicaStatus{
+				Index:   i,
+				Phase:   v1alpha1.BackupReplicaFailed,
+				Message: fmt.Sprintf("create storage backend of backup failed, err: %v", err),
+			})
+		}
+		return
+	}
+	defer src.Close()
+
+	for i := range backup.Spec.Replicas {
+		bm.replicateBackupTo(ctx, backup, src, i)
+	}
+}
+
+// replicateBackupTo copies the backup data to the replica storage with the index
+func (bm *Manager) replicateBackupTo(ctx context.Context, backup *v1alpha1.Backup, src *pkgutil.StorageBackend, index int) {
+	replica := backup.Spec.Replicas[index]
+	status := &v1alpha1.BackupReplicaStatus{
+		Index: index,
+		Phase: v1alpha1.BackupReplicaCopying,
+	}
+	replicaPath, err := backupUtil.GetStoragePath(&replica)
+	if err != nil {
+		status.Phase = v1alpha1.BackupReplicaFailed
+		status.Message = err.Error()
+		bm.updateReplicaStatus(backup, status)
+		return
+	}
+	status.Path = replicaPath
+	bm.updateReplicaStatus(backup, status)
+
+	klog.Infof("start to copy backup %s data to replica %d %s", bm, index, replicaPath)
+	result, err := copyToReplica(ctx, src, replica, index)
+	if err != nil {
+		klog.Errorf("copy backup %s data to replica %d %s failed, err: %s", bm, index, replicaPath, err)
+		status.Phase = v1alpha1.BackupReplicaFailed
+		status.Message = err.Error()
+		bm.updateReplicaStatus(backup, status)
+		return
+	}
+
+	klog.Infof("copy backup %s data to replica %d %s success, objects: %d, size: %d", bm, index, replicaPath, result.ObjectCount, result.Size)
+	status.Phase = v1alpha1.BackupReplicaComplete
+	status.ObjectCount = result.ObjectCount
+	status.Size = result.Size
+	status.TimeCompleted = metav1.Time{Time: time.Now()}
+	bm.updateReplicaStatus(backup, status)
+}
+
+func (bm *Manager) updateReplicaStatus(backup *v1alpha1.Backup, status *v1alpha1.BackupReplicaStatus) {
+	if err := bm.StatusUpdater.Update(backup, nil, &controller.BackupUpdateStatus{
+		ReplicaStatus: status,
+	}); err != nil {
+		klog.Errorf("update replica %d status of backup %s failed, err: %s", status.Index, bm, err)
+	}
+}
+
+// copyToReplica copies all the objects of the source storage to the replica storage and verifies them,
+// the objects must be retained by the replica storage at write time if it has object lock
+func copyToReplica(ctx context.Context, src *pkgutil.StorageBackend, replica v1alpha1.StorageProvider, index int) (*pkgutil.CopyObjectsResult, error) {
+	if replica.Local != nil {
+		// the directory of local storage must exist before opening it
+		dir := path.Join(replica.Local.VolumeMount.MountPath, replica.Local.Prefix)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, fmt.Errorf("create directory %s failed, err: %v", dir, err)
+		}
+	}
+
+	dst, err := pkgutil.NewStorageBackend(replica, pkgutil.GetReplicaStorageCredential(index, replica))
+	if err != nil {
+		return nil, fmt.Errorf("create storage backend failed, err: %v", err)
+	}
+	defer dst.Close()
+
+	if dst.ObjectLock() != nil {
+		if err := dst.CheckDefaultRetention(ctx); err != nil {
+			return nil, fmt.Errorf("check retention of replica storage failed, err: %v", err)
+		}
+	}
+	started := time.Now()
+	result, err := pkgutil.CopyObjects(ctx, src, dst, replicaCopyConcurrency)
+	if err != nil {
+		return nil, err
+	}
+	if dst.ObjectLock() != nil {
+		if _, err := pkgutil.VerifyObjectsRetention(ctx, dst, started, replicaCopyConcurrency); err != nil {
+			return nil, fmt.Errorf("verify retention of objects failed, err: %v", err)
+		}
+	}
+	return result, nil
+}
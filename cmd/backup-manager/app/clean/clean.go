@@ -21,16 +21,41 @@ import (
 	"io"
 	"os"
 	"os/exec"
+	"path"
 	"time"
 
 	"github.com/pingcap/tidb-operator/cmd/backup-manager/app/constants"
 	"github.com/pingcap/tidb-operator/cmd/backup-manager/app/util"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/backup/snapshotter"
 	bkutil "github.com/pingcap/tidb-operator/pkg/backup/util"
+	"gocloud.dev/blob"
+	errorutils "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// ObjectsRetainedError represents that some objects of the backup are skipped by the clean,
+// as they are still under retention of the object lock
+type ObjectsRetainedError struct {
+	Count       int
+	RetainUntil time.Time
+}
+
+func (e *ObjectsRetainedError) Error() string {
+	return fmt.Sprintf("%d objects are skipped as they are retained until %s", e.Count, e.RetainUntil.Format(time.RFC3339))
+}
+
+// add records the objects retained by other, the latest retain date is kept
+func (e *ObjectsRetainedError) add(other *ObjectsRetainedError) {
+	e.Count += other.Count
+	if other.RetainUntil.After(e.RetainUntil) {
+		e.RetainUntil = other.RetainUntil
+	}
+}
+
 var (
 	defaultBackoff = wait.Backoff{
 		Duration: 100 * time.Millisecond,
@@ -57,21 +82,21 @@ func (bo *Options) String() string {
 }
 
 // cleanBackupMetaWithVolSnapshots clean snapshot and the backup meta
-func (bo *Options) cleanBackupMetaWithVolSnapshots(ctx context.Context, backup *v1alpha1.Backup) error {
+func (bo *Options) cleanBackupMetaWithVolSnapshots(ctx context.Context, backup *v1alpha1.Backup, genericCli client.Client) error {
 	backend, err := bkutil.NewStorageBackend(backup.Spec.StorageProvider, &bkutil.StorageCredential{})
 	if err != nil {
 		return err
 	}
 	defer backend.Close()
 
-	err = bo.deleteSnapshotsAndBackupMeta(ctx, backup)
+	err = bo.deleteSnapshotsAndBackupMeta(ctx, backup, genericCli)
 	if err != nil {
 		klog.Errorf("For backup %s clean, failed to clean backup: %s", bo, err)
 	}
 	return err
 }
 
-func (bo *Options) deleteSnapshotsAndBackupMeta(ctx context.Context, backup *v1alpha1.Backup) error {
+func (bo *Options) deleteSnapshotsAndBackupMeta(ctx context.Context, backup *v1alpha1.Backup, genericCli client.Client) error {
 	//1. get backupmeta and fetch the snapshot information
 	//rclone copy remote:/bukect/backup/backupmeta /backupmeta
 	opts := util.GetOptions(backup.Spec.StorageProvider)
@@ -109,7 +134,7 @@ func (bo *Options) deleteSnapshotsAndBackupMeta(ctx context.Context, backup *v1a
 	if backup.Spec.CleanOption != nil {
 		deleteRatio = backup.Spec.CleanOption.SnapshotsDeleteRatio
 	}
-	if err = bo.deleteVolumeSnapshots(metaInfo, deleteRatio); err != nil {
+	if err = bo.deleteVolumeSnapshots(ctx, backup, metaInfo, deleteRatio, genericCli); err != nil {
 		klog.Errorf("delete volume snapshot failure, a mannual check or delete aciton require.")
 		return err
 	}
@@ -122,7 +147,27 @@ func (bo *Options) deleteSnapshotsAndBackupMeta(ctx context.Context, backup *v1a
 	return nil
 }
 
-func (bo *Options) deleteVolumeSnapshots(meta *bkutil.EBSBasedBRMeta, deleteRatio float64) error {
+func (bo *Options) deleteVolumeSnapshots(
+	ctx context.Context,
+	backup *v1alpha1.Backup,
+	meta *bkutil.EBSBasedBRMeta,
+	deleteRatio float64,
+	genericCli client.Client,
+) error {
+	// the snapshots not taken by BR are deleted by the VolumeSnapshotTaker taking them
+	taker, err := snapshotter.NewVolumeSnapshotTaker(backup, genericCli)
+	if err != nil {
+		klog.Errorf("new volume snapshot taker failure.")
+		return err
+	}
+	if taker != nil {
+		if err := taker.DeleteSnapshots(ctx, backup, meta); err != nil {
+			klog.Errorf("delete snapshots failure.")
+			return err
+		}
+		return nil
+	}
+
 	newVolumeIDMap := make(map[string]string)
 	for i := range meta.TiKVComponent.Stores {
 		store := meta.TiKVComponent.Stores[i]
@@ -147,32 +192,83 @@ func (bo *Options) deleteVolumeSnapshots(meta *bkutil.EBSBasedBRMeta, deleteRati
 
 // CleanBRRemoteBackupData clean the backup data from remote
 func (bo *Options) CleanBRRemoteBackupData(ctx context.Context, backup *v1alpha1.Backup) error {
+	return bo.cleanBRStorage(ctx, backup, backup.Spec.StorageProvider, &bkutil.StorageCredential{})
+}
+
+// CleanDumplingStreamBackupData clean the backup data written by the stream mode of dumpling from remote
+func (bo *Options) CleanDumplingStreamBackupData(ctx context.Context, backup *v1alpha1.Backup) error {
+	provider := bkutil.AppendStoragePrefix(backup.Spec.StorageProvider, path.Base(backup.Status.BackupPath))
+	return bo.cleanBRStorage(ctx, backup, provider, &bkutil.StorageCredential{})
+}
+
+// CleanBRReplicaBackupData clean the backup data from the replica storages.
+// Only the replicas which have started copying are cleaned, others have no data.
+// ObjectsRetainedError with the latest retain date of the replicas is returned if no other errors occur.
+func (bo *Options) CleanBRReplicaBackupData(ctx context.Context, backup *v1alpha1.Backup) error {
+	var errs []error
+	retained := &ObjectsRetainedError{}
+	for _, status := range backup.Status.Replicas {
+		if status.Index < 0 || status.Index >= len(backup.Spec.Replicas) {
+			continue
+		}
+		klog.Infof("For backup %s clean, start to clean replica %d %s", bo, status.Index, status.Path)
+		err := bo.cleanBRStorage(ctx, backup, backup.Spec.Replicas[status.Index], bkutil.GetReplicaStorageCredential(status.Index, backup.Spec.Replicas[status.Index]))
+		var retainedErr *ObjectsRetainedError
+		if errors.As(err, &retainedErr) {
+			klog.Warningf("For backup %s clean, replica %d %s is not cleaned completely, %s", bo, status.Index, status.Path, retainedErr)
+			retained.add(retainedErr)
+		} else if err != nil {
+			errs = append(errs, fmt.Errorf("clean replica %d %s failed, err: %v", status.Index, status.Path, err))
+		}
+	}
+	if len(errs) > 0 {
+		return errorutils.NewAggregate(errs)
+	}
+	if retained.Count > 0 {
+		return retained
+	}
+	return nil
+}
+
+// cleanBRStorage cleans all the objects of the storage, ObjectsRetainedError is returned
+// if some objects are skipped as they are still under retention
+func (bo *Options) cleanBRStorage(ctx context.Context, backup *v1alpha1.Backup, provider v1alpha1.StorageProvider, cred *bkutil.StorageCredential) error {
 	opt := backup.GetCleanOption()
 
-	backend, err := bkutil.NewStorageBackend(backup.Spec.StorageProvider, &bkutil.StorageCredential{})
+	backend, err := bkutil.NewStorageBackend(provider, cred)
 	if err != nil {
 		return err
 	}
 	defer backend.Close()
 
 	round := 0
-	return util.RetryOnError(ctx, opt.RetryCount, 0, util.RetriableOnAnyError, func() error {
+	var retained *ObjectsRetainedError
+	err = util.RetryOnError(ctx, opt.RetryCount, 0, util.RetriableOnAnyError, func() error {
 		round++
-		err := bo.cleanBRRemoteBackupDataOnce(ctx, backend, opt, round)
+		var err error
+		retained, err = bo.cleanBRRemoteBackupDataOnce(ctx, backend, opt, round)
 		if err != nil {
 			klog.Errorf("For backup %s clean %d, failed to clean backup: %s", bo, round, err)
 		}
 		return err
 	})
+	if err != nil {
+		return err
+	}
+	if retained != nil {
+		return retained
+	}
+	return nil
 }
 
-func (bo *Options) cleanBRRemoteBackupDataOnce(ctx context.Context, backend *bkutil.StorageBackend, opt v1alpha1.CleanOption, round int) error {
+func (bo *Options) cleanBRRemoteBackupDataOnce(ctx context.Context, backend *bkutil.StorageBackend, opt v1alpha1.CleanOption, round int) (*ObjectsRetainedError, error) {
 	klog.Infof("For backup %s clean %d, start to clean backup with opt: %+v", bo, round, opt)
 
 	iter := backend.ListPage(nil)
 	backoff := defaultBackoff
 	index := 0
 	count, deletedCount, failedCount := 0, 0, 0
+	retained := &ObjectsRetainedError{}
 	for {
 		needBackoff := false
 		index++
@@ -183,13 +279,21 @@ func (bo *Options) cleanBRRemoteBackupDataOnce(ctx context.Context, backend *bku
 			break
 		}
 		if err != nil {
-			return err
+			return nil, err
+		}
+		count += len(objs)
+
+		// the objects under retention can't be deleted, skip them
+		if backend.ObjectLock() != nil {
+			objs, err = skipRetainedObjects(ctx, backend, objs, retained)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		klog.Infof("%s, try to delete %d objects", logPrefix, len(objs))
 		result := backend.BatchDeleteObjects(ctx, objs, opt.BatchDeleteOption)
 
-		count += len(objs)
 		deletedCount += len(result.Deleted)
 		failedCount += len(result.Errors)
 
@@ -220,20 +324,75 @@ func (bo *Options) cleanBRRemoteBackupDataOnce(ctx context.Context, backend *bku
 		}
 	}
 
-	klog.Infof("For backup %s clean %d, clean backup finished, total:%d deleted:%d failed:%d", bo, round, count, deletedCount, failedCount)
+	klog.Infof("For backup %s clean %d, clean backup finished, total:%d deleted:%d failed:%d retained:%d", bo, round, count, deletedCount, failedCount, retained.Count)
 
-	if deletedCount < count {
-		return fmt.Errorf("some objects failed to be deleted")
+	if deletedCount+retained.Count < count {
+		return nil, fmt.Errorf("some objects failed to be deleted")
 	}
 
-	objs, err := backend.ListPage(nil).Next(ctx, int(opt.PageSize))
+	// only the retained objects are left
+	pageSize := int(opt.PageSize)
+	if retained.Count > 0 {
+		pageSize = retained.Count + 1
+	}
+	objs, err := backend.ListPage(nil).Next(ctx, pageSize)
 	if err != nil && err != io.EOF {
-		return err
+		return nil, err
+	}
+	if len(objs) > retained.Count {
+		return nil, fmt.Errorf("some objects are missing to be deleted")
+	}
+
+	if retained.Count > 0 {
+		klog.Warningf("For backup %s clean %d, %s", bo, round, retained)
+		return retained, nil
+	}
+	return nil, nil
+}
+
+// skipRetainedObjects returns the objects which are not under retention, and records the retained objects
+func skipRetainedObjects(ctx context.Context, backend *bkutil.StorageBackend, objs []*blob.ListObject, retained *ObjectsRetainedError) ([]*blob.ListObject, error) {
+	now := time.Now()
+	retainUntils := make([]time.Time, len(objs))
+	errs := make([]error, len(objs))
+	workqueue.ParallelizeUntil(ctx, int(v1alpha1.DefaultBatchDeleteOption.RoutineConcurrency), len(objs), func(piece int) {
+		retainUntils[piece], errs[piece] = backend.CheckObjectRetention(ctx, objs[piece].Key, now)
+	})
+
+	unretained := make([]*blob.ListObject, 0, len(objs))
+	for i, obj := range objs {
+		if errs[i] != nil {
+			return nil, fmt.Errorf("check retention of object %s failed, err: %v", obj.Key, errs[i])
+		}
+		if retainUntils[i].IsZero() {
+			unretained = append(unretained, obj)
+			continue
+		}
+		klog.V(4).Infof("For backup clean, skip object %s retained until %s", obj.Key, retainUntils[i].Format(time.RFC3339))
+		retained.Count++
+		if retainUntils[i].After(retained.RetainUntil) {
+			retained.RetainUntil = retainUntils[i]
+		}
 	}
-	if len(objs) != 0 {
-		return fmt.Errorf("some objects are missing to be deleted")
+	return unretained, nil
+}
+
+// checkArchiveRetention returns ObjectsRetainedError if the archive of the backup by dumpling is still under retention
+func (bo *Options) checkArchiveRetention(ctx context.Context, backup *v1alpha1.Backup) error {
+	provider := bkutil.SetStorageBucketAndPrefix(backup.Spec.StorageProvider, path.Dir(backup.Status.BackupPath))
+	backend, err := bkutil.NewStorageBackend(provider, &bkutil.StorageCredential{})
+	if err != nil {
+		return err
 	}
+	defer backend.Close()
 
+	retainUntil, err := backend.CheckObjectRetention(ctx, path.Base(backup.Status.BackupPath), time.Now())
+	if err != nil {
+		return fmt.Errorf("check retention of backup %s failed, err: %v", backup.Status.BackupPath, err)
+	}
+	if !retainUntil.IsZero() {
+		return &ObjectsRetainedError{Count: 1, RetainUntil: retainUntil}
+	}
 	return nil
 }
 
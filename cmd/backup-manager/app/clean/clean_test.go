@@ -16,6 +16,7 @@ package clean
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strconv"
 	"testing"
 	"time"
@@ -292,7 +293,99 @@ func TestCleanBRRemoteBackupDataOnce(t *testing.T) {
 		})
 		defer timepatch.Reset()
 
-		err := bo.cleanBRRemoteBackupDataOnce(context.TODO(), backend, *opt, 1)
+		_, err := bo.cleanBRRemoteBackupDataOnce(context.TODO(), backend, *opt, 1)
 		tt.expect(err, backoff)
 	}
 }
+
+func TestSkipRetainedObjects(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	backend := &util.StorageBackend{}
+	backend.Bucket = blob.NewBucket(&util.MockDriver{Type: v1alpha1.BackupStorageTypeS3})
+	retainUntil := time.Now().Add(time.Hour).UTC()
+	patch := gomonkey.ApplyMethod(reflect.TypeOf(backend), "CheckObjectRetention", func(_ *util.StorageBackend, _ context.Context, key string, _ time.Time) (time.Time, error) {
+		switch key {
+		case "retained":
+			return retainUntil, nil
+		case "error":
+			return time.Time{}, fmt.Errorf("check failed")
+		default:
+			return time.Time{}, nil
+		}
+	})
+	defer patch.Reset()
+
+	retained := &ObjectsRetainedError{}
+	objs, err := skipRetainedObjects(context.TODO(), backend, []*blob.ListObject{{Key: "retained"}, {Key: "expired"}, {Key: "retained"}}, retained)
+	g.Expect(err).Should(BeNil())
+	g.Expect(objs).Should(HaveLen(1))
+	g.Expect(objs[0].Key).Should(Equal("expired"))
+	g.Expect(retained.Count).Should(Equal(2))
+	g.Expect(retained.RetainUntil).Should(Equal(retainUntil))
+
+	_, err = skipRetainedObjects(context.TODO(), backend, []*blob.ListObject{{Key: "error"}}, retained)
+	g.Expect(err).ShouldNot(BeNil())
+
+	g.Expect(isObjectsRetained(fmt.Errorf("failed"))).Should(BeFalse())
+	g.Expect(isObjectsRetained(fmt.Errorf("clean failed, err: %w", retained))).Should(BeTrue())
+}
+
+func TestMergeObjectsRetained(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	now := time.Now().UTC()
+	primary := &ObjectsRetainedError{Count: 2, RetainUntil: now.Add(time.Hour)}
+	replica := &ObjectsRetainedError{Count: 3, RetainUntil: now.Add(2 * time.Hour)}
+
+	g.Expect(mergeObjectsRetained(nil, nil)).Should(BeNil())
+	g.Expect(mergeObjectsRetained(primary, nil)).Should(Equal(primary))
+	g.Expect(mergeObjectsRetained(nil, replica)).Should(Equal(replica))
+
+	// the failure of replicas is not hidden by the retained objects
+	failed := fmt.Errorf("clean replica 0 failed")
+	g.Expect(mergeObjectsRetained(primary, failed)).Should(Equal(failed))
+
+	// the latest retain date of the primary and replicas is kept
+	err := mergeObjectsRetained(primary, replica)
+	g.Expect(err).Should(Equal(&ObjectsRetainedError{Count: 5, RetainUntil: now.Add(2 * time.Hour)}))
+	err = mergeObjectsRetained(replica, primary)
+	g.Expect(err).Should(Equal(&ObjectsRetainedError{Count: 5, RetainUntil: now.Add(2 * time.Hour)}))
+	g.Expect(primary.Count).Should(Equal(2))
+}
+
+func TestCleanBRReplicaBackupData(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	now := time.Now().UTC()
+	errs := map[string]error{
+		"replica-0": &ObjectsRetainedError{Count: 1, RetainUntil: now.Add(2 * time.Hour)},
+		"replica-1": &ObjectsRetainedError{Count: 2, RetainUntil: now.Add(time.Hour)},
+	}
+	bo := &Options{}
+	patch := gomonkey.ApplyPrivateMethod(reflect.TypeOf(bo), "cleanBRStorage", func(_ *Options, _ context.Context, _ *v1alpha1.Backup, provider v1alpha1.StorageProvider, _ *util.StorageCredential) error {
+		return errs[provider.S3.Prefix]
+	})
+	defer patch.Reset()
+
+	backup := &v1alpha1.Backup{}
+	for i := 0; i < 3; i++ {
+		backup.Spec.Replicas = append(backup.Spec.Replicas, v1alpha1.StorageProvider{S3: &v1alpha1.S3StorageProvider{Prefix: fmt.Sprintf("replica-%d", i)}})
+		backup.Status.Replicas = append(backup.Status.Replicas, v1alpha1.BackupReplicaStatus{Index: i})
+	}
+
+	// the latest retain date of the replicas is reported
+	err := bo.CleanBRReplicaBackupData(context.TODO(), backup)
+	g.Expect(err).Should(Equal(&ObjectsRetainedError{Count: 3, RetainUntil: now.Add(2 * time.Hour)}))
+
+	// other failures are reported rather than the retained objects
+	errs["replica-2"] = fmt.Errorf("clean failed")
+	err = bo.CleanBRReplicaBackupData(context.TODO(), backup)
+	g.Expect(err).ShouldNot(BeNil())
+	g.Expect(isObjectsRetained(err)).Should(BeFalse())
+
+	delete(errs, "replica-0")
+	delete(errs, "replica-1")
+	delete(errs, "replica-2")
+	g.Expect(bo.CleanBRReplicaBackupData(context.TODO(), backup)).Should(BeNil())
+}
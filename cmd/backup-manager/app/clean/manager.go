@@ -15,23 +15,28 @@ package clean
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 
 	"github.com/pingcap/tidb-operator/cmd/backup-manager/app/util"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	bkutil "github.com/pingcap/tidb-operator/pkg/backup/util"
 	listers "github.com/pingcap/tidb-operator/pkg/client/listers/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/controller"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	errorutils "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Manager mainly used to manage backup related work
 type Manager struct {
 	backupLister  listers.BackupLister
 	StatusUpdater controller.BackupConditionUpdaterInterface
+	genericCli    client.Client
 	Options
 }
 
@@ -39,10 +44,12 @@ type Manager struct {
 func NewManager(
 	backupLister listers.BackupLister,
 	statusUpdater controller.BackupConditionUpdaterInterface,
+	genericCli client.Client,
 	backupOpts Options) *Manager {
 	return &Manager{
 		backupLister,
 		statusUpdater,
+		genericCli,
 		backupOpts,
 	}
 }
@@ -83,7 +90,7 @@ func (bm *Manager) performCleanBackup(ctx context.Context, backup *v1alpha1.Back
 		}
 
 		// clean backup will delete all vol snapshots
-		err = bm.cleanBackupMetaWithVolSnapshots(ctx, backup)
+		err = bm.cleanBackupMetaWithVolSnapshots(ctx, backup, bm.genericCli)
 		if err != nil {
 			klog.Errorf("delete backup %s for cluster %s backup failure", backup.Name, bm)
 		}
@@ -91,12 +98,37 @@ func (bm *Manager) performCleanBackup(ctx context.Context, backup *v1alpha1.Back
 	} else {
 		if backup.Spec.BR != nil {
 			err = bm.CleanBRRemoteBackupData(ctx, backup)
+			if err == nil || isObjectsRetained(err) {
+				err = mergeObjectsRetained(err, bm.CleanBRReplicaBackupData(ctx, backup))
+			}
+		} else if backup.IsDumplingStreaming() {
+			err = bm.CleanDumplingStreamBackupData(ctx, backup)
 		} else {
-			opts := util.GetOptions(backup.Spec.StorageProvider)
-			err = bm.cleanRemoteBackupData(ctx, backup.Status.BackupPath, opts)
+			if bkutil.GetObjectLock(backup.Spec.StorageProvider) != nil {
+				err = bm.checkArchiveRetention(ctx, backup)
+			}
+			if err == nil {
+				opts := util.GetOptions(backup.Spec.StorageProvider)
+				err = bm.cleanRemoteBackupData(ctx, backup.Status.BackupPath, opts)
+			}
 		}
 	}
 
+	var retainedErr *ObjectsRetainedError
+	if errors.As(err, &retainedErr) {
+		// the objects under retention can't be deleted by anyone, report them rather than fail the clean,
+		// the backup is kept and cleaned again after the retain date
+		klog.Warningf("clean cluster %s backup %s partially, %s", bm, backup.Status.BackupPath, retainedErr)
+		return bm.StatusUpdater.Update(backup, &v1alpha1.BackupCondition{
+			Type:    v1alpha1.BackupClean,
+			Status:  corev1.ConditionFalse,
+			Reason:  "ObjectsUnderRetention",
+			Message: retainedErr.Error(),
+		}, &controller.BackupUpdateStatus{
+			RetainUntil: &metav1.Time{Time: retainedErr.RetainUntil},
+		})
+	}
+
 	if err != nil {
 		errs = append(errs, err)
 		klog.Errorf("clean cluster %s backup %s failed, err: %s", bm, backup.Status.BackupPath, err)
@@ -150,3 +182,26 @@ func (bm *Manager) getVolumeSnapshotBackup(backups []*v1alpha1.Backup) *v1alpha1
 	// reach end of backup list, there is no volume snapshot backups
 	return nil
 }
+
+func isObjectsRetained(err error) bool {
+	var retainedErr *ObjectsRetainedError
+	return errors.As(err, &retainedErr)
+}
+
+// mergeObjectsRetained merges the errors of cleaning the primary and replica storages, the objects retained
+// in both are reported by one ObjectsRetainedError so that the backup is kept until the latest retain date
+func mergeObjectsRetained(err, replicaErr error) error {
+	var retained, replicaRetained *ObjectsRetainedError
+	if !errors.As(replicaErr, &replicaRetained) {
+		if replicaErr != nil {
+			return replicaErr
+		}
+		return err
+	}
+	if !errors.As(err, &retained) {
+		return replicaErr
+	}
+	merged := *retained
+	merged.add(replicaRetained)
+	return &merged
+}
@@ -60,6 +60,10 @@ func runBackup(backupOpts backup.Options, kubecfg string) error {
 	if err != nil {
 		return err
 	}
+	genericCli, err := util.NewGenericCli(kubecfg)
+	if err != nil {
+		return err
+	}
 	options := []informers.SharedInformerOption{
 		informers.WithNamespace(backupOpts.Namespace),
 	}
@@ -76,6 +80,6 @@ func runBackup(backupOpts backup.Options, kubecfg string) error {
 	cache.WaitForCacheSync(ctx.Done(), backupInformer.Informer().HasSynced)
 
 	klog.Infof("start to process backup %s", backupOpts.String())
-	bm := backup.NewManager(backupInformer.Lister(), statusUpdater, backupOpts)
+	bm := backup.NewManager(backupInformer.Lister(), statusUpdater, genericCli, backupOpts)
 	return bm.ProcessBackup()
 }
@@ -50,6 +50,10 @@ func runClean(backupOpts clean.Options, kubecfg string) error {
 	if err != nil {
 		return err
 	}
+	genericCli, err := util.NewGenericCli(kubecfg)
+	if err != nil {
+		return err
+	}
 	options := []informers.SharedInformerOption{
 		informers.WithNamespace(backupOpts.Namespace),
 	}
@@ -67,6 +71,6 @@ func runClean(backupOpts clean.Options, kubecfg string) error {
 	cache.WaitForCacheSync(ctx.Done(), backupInformer.Informer().HasSynced)
 
 	klog.Infof("start to clean backup %s", backupOpts.String())
-	bm := clean.NewManager(backupInformer.Lister(), statusUpdater, backupOpts)
+	bm := clean.NewManager(backupInformer.Lister(), statusUpdater, genericCli, backupOpts)
 	return bm.ProcessCleanBackup()
 }
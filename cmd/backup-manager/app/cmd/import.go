@@ -45,8 +45,10 @@ func NewImportCommand() *cobra.Command {
 	cmd.Flags().StringVar(&ro.Namespace, "namespace", "", "Restore CR's namespace")
 	cmd.Flags().StringVar(&ro.ResourceName, "restoreName", "", "Restore CRD object name")
 	cmd.Flags().BoolVar(&ro.TLSClient, "client-tls", false, "Whether client tls is enabled")
+	cmd.Flags().BoolVar(&ro.TLSCluster, "cluster-tls", false, "Whether cluster tls is enabled")
 	cmd.Flags().BoolVar(&ro.SkipClientCA, "skipClientCA", false, "Whether to skip tidb server's certificates validation")
 	cmd.Flags().StringVar(&ro.BackupPath, "backupPath", "", "The location of the backup")
+	cmd.Flags().StringVar(&ro.PDAddress, "pd-addr", "", "The address of PD of the tidb cluster imported by the lightning local backend")
 	return cmd
 }
 
@@ -16,22 +16,38 @@ package export
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/http"
 	"os/exec"
 	"path"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/mholt/archiver/v3"
 	"github.com/pingcap/tidb-operator/cmd/backup-manager/app/constants"
 	backupUtil "github.com/pingcap/tidb-operator/cmd/backup-manager/app/util"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	backuputil "github.com/pingcap/tidb-operator/pkg/backup/util"
+	"github.com/pingcap/tidb-operator/pkg/controller"
 	"github.com/pingcap/tidb-operator/pkg/util"
+	dto "github.com/prometheus/client_model/go"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 )
 
+const (
+	dumplingStatusPort = 8281
+
+	dumplingFinishedRowsMetric      = "dumpling_dump_finished_rows"
+	dumplingEstimateTotalRowsMetric = "dumpling_dump_estimate_total_rows"
+
+	dumplingProgressStep = "Dump"
+)
+
 // Options contains the input arguments to the backup command
 type Options struct {
 	backupUtil.GenericOptions
@@ -44,9 +60,13 @@ func (bo *Options) getBackupFullPath() string {
 	return filepath.Join(constants.BackupRootPath, bo.getBackupRelativePath())
 }
 
+func getBackupName() string {
+	return fmt.Sprintf("backup-%s", time.Now().UTC().Format(time.RFC3339))
+}
+
 func (bo *Options) getBackupRelativePath() string {
 	var backupRelativePath string
-	backupName := fmt.Sprintf("backup-%s", time.Now().UTC().Format(time.RFC3339))
+	backupName := getBackupName()
 	if len(bo.Prefix) == 0 {
 		backupRelativePath = fmt.Sprintf("%s/%s", bo.Bucket, backupName)
 	} else {
@@ -59,10 +79,22 @@ func (bo *Options) getDestBucketURI(remotePath string) string {
 	return fmt.Sprintf("%s://%s", bo.StorageType, remotePath)
 }
 
-func (bo *Options) dumpTidbClusterData(ctx context.Context, bfPath string, backup *v1alpha1.Backup) error {
-	err := backupUtil.EnsureDirectoryExist(bfPath)
+// getStreamBackupURI returns the URI of the directory which the stream mode of dumpling writes files to
+func getStreamBackupURI(provider v1alpha1.StorageProvider, backupName string) (string, error) {
+	storagePath, err := backuputil.GetStoragePath(provider)
 	if err != nil {
-		return err
+		return "", err
+	}
+	return strings.TrimSuffix(storagePath, "/") + "/" + backupName, nil
+}
+
+func (bo *Options) dumpTidbClusterData(ctx context.Context, bfPath string, backup *v1alpha1.Backup, statusUpdater controller.BackupConditionUpdaterInterface) error {
+	// the stream mode writes files to the storage directly
+	if !backup.IsDumplingStreaming() {
+		err := backupUtil.EnsureDirectoryExist(bfPath)
+		if err != nil {
+			return err
+		}
 	}
 	args := []string{
 		fmt.Sprintf("--output=%s", bfPath),
@@ -70,6 +102,7 @@ func (bo *Options) dumpTidbClusterData(ctx context.Context, bfPath string, backu
 		fmt.Sprintf("--port=%d", bo.Port),
 		fmt.Sprintf("--user=%s", bo.User),
 		fmt.Sprintf("--password=%s", bo.Password),
+		fmt.Sprintf("--status-addr=0.0.0.0:%d", dumplingStatusPort),
 	}
 	args = append(args, backupUtil.ConstructDumplingOptionsForBackup(backup)...)
 	if bo.TLSClient {
@@ -89,6 +122,9 @@ func (bo *Options) dumpTidbClusterData(ctx context.Context, bfPath string, backu
 	for _, arg := range args {
 		if strings.HasPrefix(arg, "--password=") {
 			args_redacted = append(args_redacted, "--password=******")
+		} else if strings.HasPrefix(arg, "--output=") && strings.Contains(arg, "?") {
+			// the query of the storage URL may contain credentials such as sas-token
+			args_redacted = append(args_redacted, arg[:strings.Index(arg, "?")]+"?******")
 		} else {
 			args_redacted = append(args_redacted, arg)
 		}
@@ -96,13 +132,108 @@ func (bo *Options) dumpTidbClusterData(ctx context.Context, bfPath string, backu
 
 	klog.Infof("The dump process is ready, command \"%s %s\"", binPath, strings.Join(args_redacted, " "))
 
+	progressCtx, progressCancel := context.WithCancel(ctx)
+	var progressWg sync.WaitGroup
+	progressWg.Add(1)
+	go func() {
+		defer progressWg.Done()
+		bo.updateProgressFromStatus(progressCtx, backup, statusUpdater)
+	}()
 	output, err := exec.CommandContext(ctx, binPath, args...).CombinedOutput()
+	progressCancel()
+	progressWg.Wait()
 	if err != nil {
-		return fmt.Errorf("cluster %s, execute dumpling command %v failed, output: %s, err: %v", bo, args, string(output), err)
+		return fmt.Errorf("cluster %s, execute dumpling command %v failed, output: %s, err: %v", bo, args_redacted, string(output), err)
 	}
+	bo.updateProgress(backup, 100, statusUpdater)
 	return nil
 }
 
+// dumplingProgress returns the progress of dumpling from its metrics
+func dumplingProgress(families map[string]*dto.MetricFamily) (float64, error) {
+	for _, name := range []string{dumplingFinishedRowsMetric, dumplingEstimateTotalRowsMetric} {
+		if _, ok := families[name]; !ok {
+			return 0, fmt.Errorf("metric %s not found", name)
+		}
+	}
+	finished := backupUtil.SumMetric(families[dumplingFinishedRowsMetric], "")[""]
+	total := backupUtil.SumMetric(families[dumplingEstimateTotalRowsMetric], "")[""]
+	if total <= 0 {
+		return 0, nil
+	}
+	return backupUtil.Percent(finished / total), nil
+}
+
+// updateProgressFromStatus polls the status API of dumpling and updates the progress of backup
+func (bo *Options) updateProgressFromStatus(ctx context.Context, backup *v1alpha1.Backup, statusUpdater controller.BackupConditionUpdaterInterface) {
+	url := fmt.Sprintf("http://127.0.0.1:%d/metrics", dumplingStatusPort)
+	client := &http.Client{Timeout: 5 * time.Second}
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			metrics, err := backupUtil.GetMetrics(ctx, client, url)
+			if err != nil {
+				klog.Warningf("get dumpling metrics of cluster %s failed, err: %v", bo, err)
+				continue
+			}
+			progress, err := dumplingProgress(metrics)
+			if err != nil {
+				klog.Warningf("parse dumpling progress of cluster %s failed, err: %v", bo, err)
+				continue
+			}
+			bo.updateProgress(backup, progress, statusUpdater)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (bo *Options) updateProgress(backup *v1alpha1.Backup, progress float64, statusUpdater controller.BackupConditionUpdaterInterface) {
+	step := dumplingProgressStep
+	if err := statusUpdater.Update(backup, nil, &controller.BackupUpdateStatus{
+		ProgressStep:       &step,
+		Progress:           &progress,
+		ProgressUpdateTime: &metav1.Time{Time: time.Now()},
+	}); err != nil {
+		klog.Errorf("update backup %s progress error %v", bo, err)
+	}
+}
+
+// getStreamBackupInfo reads the commitTs and the size of the files written by the stream mode of dumpling
+func getStreamBackupInfo(ctx context.Context, provider v1alpha1.StorageProvider, backupName string) (string, int64, error) {
+	backend, err := backuputil.NewStorageBackend(backuputil.AppendStoragePrefix(provider, backupName), &backuputil.StorageCredential{})
+	if err != nil {
+		return "", 0, err
+	}
+	defer backend.Close()
+
+	contents, err := backend.ReadAll(ctx, constants.MetaDataFile)
+	if err != nil {
+		return "", 0, fmt.Errorf("read metadata file of %s failed, err: %v", backupName, err)
+	}
+	commitTs, err := backupUtil.ParseCommitTsFromMetadata(constants.MetaDataFile, contents)
+	if err != nil {
+		return "", 0, err
+	}
+
+	var size int64
+	iter := backend.List(nil)
+	for {
+		obj, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", 0, fmt.Errorf("list files of %s failed, err: %v", backupName, err)
+		}
+		size += obj.Size
+	}
+	return commitTs, size, nil
+}
+
 func (bo *Options) backupDataToRemote(ctx context.Context, source, bucketURI string, opts []string) error {
 	destBucket := backupUtil.NormalizeBucketURI(bucketURI)
 	tmpDestBucket := fmt.Sprintf("%s.tmp", destBucket)
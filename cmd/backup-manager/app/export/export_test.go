@@ -0,0 +1,57 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	backupUtil "github.com/pingcap/tidb-operator/cmd/backup-manager/app/util"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+)
+
+func TestDumplingProgress(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	metrics := `# HELP dumpling_dump_finished_rows counter for dumpling finished rows
+# TYPE dumpling_dump_finished_rows counter
+dumpling_dump_finished_rows 250
+# HELP dumpling_dump_estimate_total_rows estimate total rows for dumpling tables
+# TYPE dumpling_dump_estimate_total_rows counter
+dumpling_dump_estimate_total_rows 1000
+`
+	families, err := backupUtil.ParseMetrics([]byte(metrics))
+	g.Expect(err).Should(BeNil())
+	progress, err := dumplingProgress(families)
+	g.Expect(err).Should(BeNil())
+	g.Expect(progress).Should(Equal(25.0))
+
+	families, err = backupUtil.ParseMetrics([]byte("# TYPE dumpling_dump_finished_rows counter\ndumpling_dump_finished_rows 1\n"))
+	g.Expect(err).Should(BeNil())
+	_, err = dumplingProgress(families)
+	g.Expect(err).ShouldNot(BeNil())
+}
+
+func TestGetStreamBackupURI(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	uri, err := getStreamBackupURI(v1alpha1.StorageProvider{
+		Gcs: &v1alpha1.GcsStorageProvider{Bucket: "bucket", Prefix: "prefix"},
+	}, "backup-1")
+	g.Expect(err).Should(BeNil())
+	g.Expect(uri).Should(Equal("gcs://bucket/prefix/backup-1"))
+
+	_, err = getStreamBackupURI(v1alpha1.StorageProvider{}, "backup-1")
+	g.Expect(err).ShouldNot(BeNil())
+}
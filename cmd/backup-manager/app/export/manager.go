@@ -17,6 +17,7 @@ import (
 	"context"
 	"database/sql"
 	"os"
+	"path"
 	"strings"
 	"time"
 
@@ -72,6 +73,11 @@ func (bm *BackupManager) setOptions(backup *v1alpha1.Backup) (string, error) {
 	}
 	bm.Options.Password = util.GetOptionValueFromEnv(bkconstants.TidbPasswordKey, bkconstants.BackupManagerEnvVarPrefix)
 
+	// the stream mode writes files to the prefix of storage provider directly
+	if backup.IsDumplingStreaming() {
+		return "", nil
+	}
+
 	prefix, reason, err := backuputil.GetBackupPrefixName(backup)
 	if err != nil {
 		return reason, err
@@ -171,6 +177,22 @@ func (bm *BackupManager) performBackup(ctx context.Context, backup *v1alpha1.Bac
 	}
 
 	var errs []error
+	// the backup data is retained by the storage at write time, check it before writing any data
+	if backuputil.GetObjectLock(backup.Spec.StorageProvider) != nil {
+		if err := util.CheckBackupDataRetention(ctx, backup.Spec.StorageProvider, &backuputil.StorageCredential{}); err != nil {
+			errs = append(errs, err)
+			klog.Errorf("check retention of backup storage of cluster %s failed, err: %s", bm, err)
+			uerr := bm.StatusUpdater.Update(backup, &v1alpha1.BackupCondition{
+				Type:    v1alpha1.BackupFailed,
+				Status:  corev1.ConditionTrue,
+				Reason:  "CheckBackupDataRetentionFailed",
+				Message: err.Error(),
+			}, nil)
+			errs = append(errs, uerr)
+			return errorutils.NewAggregate(errs)
+		}
+	}
+
 	oldTikvGCTime, err := bm.GetTikvGCLifeTime(ctx, db)
 	if err != nil {
 		errs = append(errs, err)
@@ -251,11 +273,31 @@ func (bm *BackupManager) performBackup(ctx context.Context, backup *v1alpha1.Bac
 		klog.Infof("set cluster %s %s to %s success", bm, constants.TikvGCVariable, constants.TikvGCLifeTime)
 	}
 
-	backupFullPath := bm.getBackupFullPath()
-	// TODO: Concurrent get file size and upload backup data to speed up processing time
-	archiveBackupPath := backupFullPath + constants.DefaultArchiveExtention
-	remotePath := strings.TrimPrefix(archiveBackupPath, constants.BackupRootPath+"/")
-	bucketURI := bm.getDestBucketURI(remotePath)
+	var backupFullPath, archiveBackupPath, bucketURI, streamBackupName, dumpOutput string
+	if backup.IsDumplingStreaming() {
+		streamBackupName = getBackupName()
+		bucketURI, err = getStreamBackupURI(backup.Spec.StorageProvider, streamBackupName)
+		if err != nil {
+			errs = append(errs, err)
+			klog.Errorf("get cluster %s stream backup path failed, err: %s", bm, err)
+			uerr := bm.StatusUpdater.Update(backup, &v1alpha1.BackupCondition{
+				Type:    v1alpha1.BackupFailed,
+				Status:  corev1.ConditionTrue,
+				Reason:  "GetBackupRemotePathFailed",
+				Message: err.Error(),
+			}, nil)
+			errs = append(errs, uerr)
+			return errorutils.NewAggregate(errs)
+		}
+		dumpOutput = backuputil.AppendStorageQuery(backup.Spec.StorageProvider, bucketURI)
+	} else {
+		backupFullPath = bm.getBackupFullPath()
+		// TODO: Concurrent get file size and upload backup data to speed up processing time
+		archiveBackupPath = backupFullPath + constants.DefaultArchiveExtention
+		remotePath := strings.TrimPrefix(archiveBackupPath, constants.BackupRootPath+"/")
+		bucketURI = bm.getDestBucketURI(remotePath)
+		dumpOutput = backupFullPath
+	}
 	updatePathStatus := &controller.BackupUpdateStatus{
 		BackupPath: &bucketURI,
 	}
@@ -267,7 +309,7 @@ func (bm *BackupManager) performBackup(ctx context.Context, backup *v1alpha1.Bac
 		return err
 	}
 
-	backupErr := bm.dumpTidbClusterData(ctx, backupFullPath, backup)
+	backupErr := bm.dumpTidbClusterData(ctx, dumpOutput, backup, bm.StatusUpdater)
 	if oldTikvGCTimeDuration < tikvGCTimeDuration {
 		// use another context to revert `tikv_gc_life_time` back.
 		// `DefaultTerminationGracePeriodSeconds` for a pod is 30, so we use a smaller timeout value here.
@@ -304,6 +346,10 @@ func (bm *BackupManager) performBackup(ctx context.Context, backup *v1alpha1.Bac
 		errs = append(errs, uerr)
 		return errorutils.NewAggregate(errs)
 	}
+	if backup.IsDumplingStreaming() {
+		klog.Infof("dump cluster %s data to %s success", bm, bucketURI)
+		return bm.completeStreamBackup(ctx, backup, streamBackupName, started)
+	}
 	klog.Infof("dump cluster %s data to %s success", bm, backupFullPath)
 
 	commitTs, err := util.GetCommitTsFromMetadata(backupFullPath)
@@ -372,6 +418,12 @@ func (bm *BackupManager) performBackup(ctx context.Context, backup *v1alpha1.Bac
 	// backup to remote succeed, archive can be deleted now
 	os.RemoveAll(archiveBackupPath)
 
+	remoteDir := path.Dir(strings.TrimPrefix(bucketURI, bm.getDestBucketURI("")))
+	provider := backuputil.SetStorageBucketAndPrefix(backup.Spec.StorageProvider, remoteDir)
+	if err := bm.verifyBackupDataRetention(ctx, backup, provider, path.Base(archiveBackupPath)); err != nil {
+		return err
+	}
+
 	finish := time.Now()
 
 	backupSizeReadable := humanize.Bytes(uint64(size))
@@ -388,3 +440,62 @@ func (bm *BackupManager) performBackup(ctx context.Context, backup *v1alpha1.Bac
 		Status: corev1.ConditionTrue,
 	}, updateStatus)
 }
+
+// completeStreamBackup reads the commitTs and size of the backup written by the stream mode of dumpling
+// and marks the backup complete
+func (bm *BackupManager) completeStreamBackup(ctx context.Context, backup *v1alpha1.Backup, backupName string, started time.Time) error {
+	commitTs, size, err := getStreamBackupInfo(ctx, backup.Spec.StorageProvider, backupName)
+	if err != nil {
+		klog.Errorf("get cluster %s stream backup %s info failed, err: %s", bm, backupName, err)
+		uerr := bm.StatusUpdater.Update(backup, &v1alpha1.BackupCondition{
+			Type:    v1alpha1.BackupFailed,
+			Status:  corev1.ConditionTrue,
+			Reason:  "GetBackupInfoFailed",
+			Message: err.Error(),
+		}, nil)
+		return errorutils.NewAggregate([]error{err, uerr})
+	}
+	klog.Infof("get cluster %s stream backup %s commitTs %s size %d success", bm, backupName, commitTs, size)
+
+	provider := backuputil.AppendStoragePrefix(backup.Spec.StorageProvider, backupName)
+	if err := bm.verifyBackupDataRetention(ctx, backup, provider); err != nil {
+		return err
+	}
+
+	finish := time.Now()
+
+	backupSizeReadable := humanize.Bytes(uint64(size))
+	updateStatus := &controller.BackupUpdateStatus{
+		TimeStarted:        &metav1.Time{Time: started},
+		TimeCompleted:      &metav1.Time{Time: finish},
+		BackupSize:         &size,
+		BackupSizeReadable: &backupSizeReadable,
+		CommitTs:           &commitTs,
+	}
+
+	return bm.StatusUpdater.Update(backup, &v1alpha1.BackupCondition{
+		Type:   v1alpha1.BackupComplete,
+		Status: corev1.ConditionTrue,
+	}, updateStatus)
+}
+
+// verifyBackupDataRetention verifies the objects of the backup data are retained if the storage has object lock,
+// keys limits the objects to verify if it is not empty
+func (bm *BackupManager) verifyBackupDataRetention(ctx context.Context, backup *v1alpha1.Backup, provider v1alpha1.StorageProvider, keys ...string) error {
+	if backuputil.GetObjectLock(provider) == nil {
+		return nil
+	}
+	result, err := util.VerifyBackupDataRetention(ctx, provider, &backuputil.StorageCredential{}, backup.CreationTimestamp.Time, keys...)
+	if err != nil {
+		klog.Errorf("verify retention of cluster %s backup data failed, err: %s", bm, err)
+		uerr := bm.StatusUpdater.Update(backup, &v1alpha1.BackupCondition{
+			Type:    v1alpha1.BackupFailed,
+			Status:  corev1.ConditionTrue,
+			Reason:  "VerifyBackupDataRetentionFailed",
+			Message: err.Error(),
+		}, nil)
+		return errorutils.NewAggregate([]error{err, uerr})
+	}
+	klog.Infof("verify %d objects of cluster %s backup data are retained until %s success", result.ObjectCount, bm, result.RetainUntil.Format(time.RFC3339))
+	return nil
+}
@@ -17,15 +17,19 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
 	"os/exec"
 	"path"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/mholt/archiver/v3"
 	"github.com/pingcap/tidb-operator/cmd/backup-manager/app/constants"
 	backupUtil "github.com/pingcap/tidb-operator/cmd/backup-manager/app/util"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	backuputil "github.com/pingcap/tidb-operator/pkg/backup/util"
+	"github.com/pingcap/tidb-operator/pkg/controller"
 	"github.com/pingcap/tidb-operator/pkg/util"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/klog/v2"
@@ -35,6 +39,8 @@ import (
 type Options struct {
 	backupUtil.GenericOptions
 	BackupPath string
+	// PDAddress is the address of PD of the tidb cluster imported by the lightning local backend
+	PDAddress string
 }
 
 func (ro *Options) getRestoreDataPath() string {
@@ -43,6 +49,49 @@ func (ro *Options) getRestoreDataPath() string {
 	return filepath.Join(constants.BackupRootPath, backupSuffix)
 }
 
+// isStreamBackup returns whether the backup is written by the stream mode of dumpling, which is
+// a directory of files with the metadata file of dumpling in the storage rather than an archive
+func (ro *Options) isStreamBackup(ctx context.Context, restore *v1alpha1.Restore) (bool, error) {
+	provider := backuputil.SetStorageBucketAndPrefix(restore.Spec.StorageProvider, ro.BackupPath)
+	backend, err := backuputil.NewStorageBackend(provider, &backuputil.StorageCredential{})
+	if err != nil {
+		return false, err
+	}
+	defer backend.Close()
+
+	exist, err := backend.Exists(ctx, constants.MetaDataFile)
+	if err != nil {
+		return false, fmt.Errorf("check metadata file of backup %s failed, err: %v", ro.BackupPath, err)
+	}
+	return exist, nil
+}
+
+// getStreamBackupData returns the URL which TiDB Lightning reads the stream backup from
+// and the commitTs in the metadata file of the backup
+func (ro *Options) getStreamBackupData(ctx context.Context, restore *v1alpha1.Restore) (string, string, error) {
+	provider := backuputil.SetStorageBucketAndPrefix(restore.Spec.StorageProvider, ro.BackupPath)
+	storagePath, err := backuputil.GetStoragePath(provider)
+	if err != nil {
+		return "", "", err
+	}
+
+	backend, err := backuputil.NewStorageBackend(provider, &backuputil.StorageCredential{})
+	if err != nil {
+		return "", "", err
+	}
+	defer backend.Close()
+
+	contents, err := backend.ReadAll(ctx, constants.MetaDataFile)
+	if err != nil {
+		return "", "", fmt.Errorf("read metadata file of backup %s failed, err: %v", ro.BackupPath, err)
+	}
+	commitTs, err := backupUtil.ParseCommitTsFromMetadata(constants.MetaDataFile, contents)
+	if err != nil {
+		return "", "", err
+	}
+	return backuputil.AppendStorageQuery(provider, storagePath), commitTs, nil
+}
+
 func (ro *Options) downloadBackupData(ctx context.Context, localPath string, opts []string) error {
 	if err := backupUtil.EnsureDirectoryExist(filepath.Dir(localPath)); err != nil {
 		return err
@@ -83,16 +132,24 @@ func (ro *Options) downloadBackupData(ctx context.Context, localPath string, opt
 	return nil
 }
 
-func (ro *Options) loadTidbClusterData(ctx context.Context, restorePath string, restore *v1alpha1.Restore) error {
+func (ro *Options) loadTidbClusterData(ctx context.Context, restorePath string, streamBackup bool, restore *v1alpha1.Restore, statusUpdater controller.RestoreConditionUpdaterInterface) error {
 	tableFilter := restore.Spec.TableFilter
 
-	if exist := backupUtil.IsDirExist(restorePath); !exist {
-		return fmt.Errorf("dir %s does not exist or is not a dir", restorePath)
+	// the stream backup is read from the storage directly
+	if !streamBackup {
+		if exist := backupUtil.IsDirExist(restorePath); !exist {
+			return fmt.Errorf("dir %s does not exist or is not a dir", restorePath)
+		}
+	}
+	localBackend := restore.IsLightningLocalBackend()
+	backend := v1alpha1.LightningBackendTiDB
+	if localBackend {
+		backend = v1alpha1.LightningBackendLocal
 	}
 	// args for restore
 	args := []string{
-		"--status-addr=0.0.0.0:8289",
-		"--backend=tidb",
+		fmt.Sprintf("--status-addr=0.0.0.0:%d", lightningStatusPort),
+		fmt.Sprintf("--backend=%s", backend),
 		"--server-mode=false",
 		"--log-file=-", // "-" to stdout
 		fmt.Sprintf("--tidb-user=%s", ro.User),
@@ -106,24 +163,66 @@ func (ro *Options) loadTidbClusterData(ctx context.Context, restorePath string,
 		args = append(args, "-f", filter)
 	}
 
-	if ro.TLSClient {
+	workDir := lightningWorkDir(restore, ro.BackupPath)
+	if localBackend {
+		if err := prepareLightningWorkDir(workDir); err != nil {
+			return fmt.Errorf("cluster %s, prepare lightning work dir failed, err: %v", ro, err)
+		}
+		// TLS of the local backend is set in the config file, as PD and TiKV use the cluster client certificates
+		localArgs, err := ro.writeLightningLocalConfig(restore, workDir)
+		if err != nil {
+			return fmt.Errorf("cluster %s, prepare lightning local backend failed, err: %v", ro, err)
+		}
+		args = append(args, localArgs...)
+	} else if ro.TLSClient {
 		if !ro.SkipClientCA {
 			args = append(args, fmt.Sprintf("--ca=%s", path.Join(util.TiDBClientTLSPath, corev1.ServiceAccountRootCAKey)))
 		}
 		args = append(args, fmt.Sprintf("--cert=%s", path.Join(util.TiDBClientTLSPath, corev1.TLSCertKey)))
 		args = append(args, fmt.Sprintf("--key=%s", path.Join(util.TiDBClientTLSPath, corev1.TLSPrivateKeyKey)))
 	}
+	if restore.Spec.Lightning != nil {
+		args = append(args, restore.Spec.Lightning.Options...)
+	}
 
 	binPath := "/tidb-lightning"
 	if restore.Spec.ToolImage != "" {
 		binPath = path.Join(util.LightningBinPath, "tidb-lightning")
 	}
 
-	klog.Infof("The lightning process is ready, command \"%s %s\"", binPath, strings.Join(args, " "))
+	argsRedacted := make([]string, 0, len(args))
+	for _, arg := range args {
+		if strings.HasPrefix(arg, "--d=") && strings.Contains(arg, "?") {
+			// the query of the storage URL may contain credentials such as sas-token
+			arg = arg[:strings.Index(arg, "?")] + "?******"
+		}
+		argsRedacted = append(argsRedacted, arg)
+	}
+
+	klog.Infof("The lightning process is ready, command \"%s %s\"", binPath, strings.Join(argsRedacted, " "))
 
+	progressCtx, progressCancel := context.WithCancel(ctx)
+	var progressWg sync.WaitGroup
+	progressWg.Add(1)
+	go func() {
+		defer progressWg.Done()
+		ro.updateProgressFromStatus(progressCtx, restore, localBackend, statusUpdater)
+	}()
 	output, err := exec.CommandContext(ctx, binPath, args...).CombinedOutput()
+	progressCancel()
+	progressWg.Wait()
 	if err != nil {
-		return fmt.Errorf("cluster %s, execute loader command %v failed, output: %s, err: %v", ro, args, string(output), err)
+		return fmt.Errorf("cluster %s, execute loader command %v failed, output: %s, err: %v", ro, argsRedacted, string(output), err)
+	}
+
+	progress := 100.0
+	ro.updateProgress(restore, lightningProgressStepRestore, progress, statusUpdater)
+	if localBackend {
+		ro.updateProgress(restore, lightningProgressStepImport, progress, statusUpdater)
+		// the checkpoint and the sorted KV pairs are useless once the import is complete
+		if err := os.RemoveAll(workDir); err != nil {
+			klog.Warningf("cluster %s, remove lightning work dir %s failed, err: %v", ro, workDir, err)
+		}
 	}
 	return nil
 }
@@ -0,0 +1,260 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _import
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/pingcap/tidb-operator/cmd/backup-manager/app/constants"
+	backupUtil "github.com/pingcap/tidb-operator/cmd/backup-manager/app/util"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/util"
+	dto "github.com/prometheus/client_model/go"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	lightningStatusPort = 8289
+	// lightningRootDir is in the restore volume, so the checkpoint left by a failed
+	// import pod can be used by the next pod of the same restore
+	lightningRootDir        = constants.BackupRootPath + "/.lightning"
+	lightningConfigFile     = "tidb-lightning.toml"
+	lightningCheckpointFile = "checkpoint.pb"
+	lightningSortedKVDir    = "sorted-kv"
+
+	lightningBytesMetric = "lightning_bytes"
+
+	lightningProgressStepRestore = "Restore"
+	lightningProgressStepImport  = "Import"
+)
+
+// lightningConfig is the part of TiDB Lightning config which can not be set by command line flags
+type lightningConfig struct {
+	TiDB         lightningTiDBConfig       `toml:"tidb"`
+	TiKVImporter lightningImporterConfig   `toml:"tikv-importer"`
+	Checkpoint   lightningCheckpointConfig `toml:"checkpoint"`
+	Security     *lightningSecurityConfig  `toml:"security,omitempty"`
+}
+
+type lightningTiDBConfig struct {
+	TLS      string                   `toml:"tls,omitempty"`
+	Security *lightningSecurityConfig `toml:"security,omitempty"`
+}
+
+type lightningImporterConfig struct {
+	Backend     string `toml:"backend"`
+	SortedKVDir string `toml:"sorted-kv-dir"`
+	DiskQuota   int64  `toml:"disk-quota,omitempty"`
+}
+
+type lightningCheckpointConfig struct {
+	Enable bool   `toml:"enable"`
+	Driver string `toml:"driver"`
+	DSN    string `toml:"dsn"`
+}
+
+type lightningSecurityConfig struct {
+	CAPath   string `toml:"ca-path,omitempty"`
+	CertPath string `toml:"cert-path,omitempty"`
+	KeyPath  string `toml:"key-path,omitempty"`
+}
+
+// lightningWorkDir returns the work dir of TiDB Lightning, which is keyed by the namespace and name of the restore
+// and the hash of the backup path and the import options, so that the checkpoint is resumed by the retried pods
+// and the recreated restore of the same data, but not by other restores sharing the restore volume
+func lightningWorkDir(restore *v1alpha1.Restore, backupPath string) string {
+	h := sha256.New()
+	h.Write([]byte(backupPath))
+	h.Write([]byte(strings.Join(restore.Spec.TableFilter, ",")))
+	if restore.Spec.Lightning != nil {
+		h.Write([]byte(strings.Join(restore.Spec.Lightning.Options, " ")))
+	}
+	return filepath.Join(lightningRootDir, restore.Namespace, restore.Name, fmt.Sprintf("%x", h.Sum(nil)[:8]))
+}
+
+func lightningCheckpointPath(workDir string) string {
+	return filepath.Join(workDir, lightningCheckpointFile)
+}
+
+// prepareLightningWorkDir removes the work dirs left by the same restore with a different spec, as their
+// checkpoints can't be resumed, and creates the work dir. The work dirs of other restores are kept.
+func prepareLightningWorkDir(workDir string) error {
+	restoreDir := filepath.Dir(workDir)
+	entries, err := os.ReadDir(restoreDir)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("read lightning work dir %s failed, err: %v", restoreDir, err)
+	}
+	for _, entry := range entries {
+		dir := filepath.Join(restoreDir, entry.Name())
+		if dir == workDir {
+			continue
+		}
+		klog.Infof("remove stale lightning work dir %s", dir)
+		if err := os.RemoveAll(dir); err != nil {
+			return fmt.Errorf("remove stale lightning work dir %s failed, err: %v", dir, err)
+		}
+	}
+	return os.MkdirAll(workDir, 0755)
+}
+
+// newLightningLocalConfig returns the config of the local backend, the sorted KV pairs and the
+// checkpoint are stored in the restore volume.
+func (ro *Options) newLightningLocalConfig(restore *v1alpha1.Restore, workDir string) (*lightningConfig, error) {
+	cfg := &lightningConfig{
+		TiKVImporter: lightningImporterConfig{
+			Backend:     string(v1alpha1.LightningBackendLocal),
+			SortedKVDir: filepath.Join(workDir, lightningSortedKVDir),
+		},
+		Checkpoint: lightningCheckpointConfig{
+			Enable: true,
+			Driver: "file",
+			DSN:    lightningCheckpointPath(workDir),
+		},
+	}
+	if quota := restore.Spec.Lightning.DiskQuota; quota != "" {
+		q, err := resource.ParseQuantity(quota)
+		if err != nil {
+			return nil, fmt.Errorf("parse disk quota %s failed, err: %v", quota, err)
+		}
+		cfg.TiKVImporter.DiskQuota = q.Value()
+	}
+
+	// the cluster client certificates are used to connect to PD and TiKV
+	if ro.TLSCluster {
+		cfg.Security = &lightningSecurityConfig{
+			CAPath:   path.Join(util.ClusterClientTLSPath, corev1.ServiceAccountRootCAKey),
+			CertPath: path.Join(util.ClusterClientTLSPath, corev1.TLSCertKey),
+			KeyPath:  path.Join(util.ClusterClientTLSPath, corev1.TLSPrivateKeyKey),
+		}
+	}
+	switch {
+	case ro.TLSClient:
+		cfg.TiDB.TLS = "cluster"
+		if ro.SkipClientCA {
+			cfg.TiDB.TLS = "skip-verify"
+		}
+		cfg.TiDB.Security = &lightningSecurityConfig{
+			CertPath: path.Join(util.TiDBClientTLSPath, corev1.TLSCertKey),
+			KeyPath:  path.Join(util.TiDBClientTLSPath, corev1.TLSPrivateKeyKey),
+		}
+		if !ro.SkipClientCA {
+			cfg.TiDB.Security.CAPath = path.Join(util.TiDBClientTLSPath, corev1.ServiceAccountRootCAKey)
+		}
+	case ro.TLSCluster:
+		// do not use the cluster client certificates to connect to TiDB
+		cfg.TiDB.TLS = "false"
+	}
+	return cfg, nil
+}
+
+// writeLightningLocalConfig writes the config of the local backend into the work dir and
+// returns the arguments to run TiDB Lightning with it.
+func (ro *Options) writeLightningLocalConfig(restore *v1alpha1.Restore, workDir string) ([]string, error) {
+	cfg, err := ro.newLightningLocalConfig(restore, workDir)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(cfg.TiKVImporter.SortedKVDir, 0755); err != nil {
+		return nil, fmt.Errorf("create sorted kv dir %s failed, err: %v", cfg.TiKVImporter.SortedKVDir, err)
+	}
+	buf := new(bytes.Buffer)
+	if err := toml.NewEncoder(buf).Encode(cfg); err != nil {
+		return nil, fmt.Errorf("encode lightning config failed, err: %v", err)
+	}
+	configPath := filepath.Join(workDir, lightningConfigFile)
+	if err := os.WriteFile(configPath, buf.Bytes(), 0644); err != nil {
+		return nil, fmt.Errorf("write lightning config %s failed, err: %v", configPath, err)
+	}
+
+	return []string{
+		fmt.Sprintf("--config=%s", configPath),
+		fmt.Sprintf("--pd-urls=%s", ro.PDAddress),
+	}, nil
+}
+
+// lightningProgress returns the progress of the encoding step and the ingesting step from
+// the metrics of TiDB Lightning.
+func lightningProgress(families map[string]*dto.MetricFamily) (restorePercent, importPercent float64, err error) {
+	family, ok := families[lightningBytesMetric]
+	if !ok {
+		return 0, 0, fmt.Errorf("metric %s not found", lightningBytesMetric)
+	}
+	states := backupUtil.SumMetric(family, "state")
+	if total := states["total_restore"]; total > 0 {
+		restorePercent = backupUtil.Percent(states["restored"] / total)
+	}
+	if written := states["written"]; written > 0 {
+		importPercent = backupUtil.Percent(states["imported"] / written * restorePercent / 100)
+	}
+	return restorePercent, importPercent, nil
+}
+
+// updateProgressFromStatus polls the status API of TiDB Lightning and updates the progress of restore
+func (ro *Options) updateProgressFromStatus(
+	ctx context.Context,
+	restore *v1alpha1.Restore,
+	localBackend bool,
+	statusUpdater controller.RestoreConditionUpdaterInterface,
+) {
+	url := fmt.Sprintf("http://127.0.0.1:%d/metrics", lightningStatusPort)
+	client := &http.Client{Timeout: 5 * time.Second}
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			metrics, err := backupUtil.GetMetrics(ctx, client, url)
+			if err != nil {
+				klog.Warningf("get lightning metrics of cluster %s failed, err: %v", ro, err)
+				continue
+			}
+			restorePercent, importPercent, err := lightningProgress(metrics)
+			if err != nil {
+				klog.Warningf("parse lightning progress of cluster %s failed, err: %v", ro, err)
+				continue
+			}
+			ro.updateProgress(restore, lightningProgressStepRestore, restorePercent, statusUpdater)
+			if localBackend {
+				ro.updateProgress(restore, lightningProgressStepImport, importPercent, statusUpdater)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (ro *Options) updateProgress(restore *v1alpha1.Restore, step string, progress float64, statusUpdater controller.RestoreConditionUpdaterInterface) {
+	if err := statusUpdater.Update(restore, nil, &controller.RestoreUpdateStatus{
+		ProgressStep:       &step,
+		Progress:           &progress,
+		ProgressUpdateTime: &metav1.Time{Time: time.Now()},
+	}); err != nil {
+		klog.Errorf("update restore %s step %s progress error %v", ro, step, err)
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _import
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	. "github.com/onsi/gomega"
+	backupUtil "github.com/pingcap/tidb-operator/cmd/backup-manager/app/util"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestLightningProgress(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	metrics := `# HELP lightning_bytes count of total bytes
+# TYPE lightning_bytes counter
+lightning_bytes{state="total_restore"} 1000
+lightning_bytes{state="restored"} 500
+lightning_bytes{state="written"} 400
+lightning_bytes{state="imported"} 200
+`
+	families, err := backupUtil.ParseMetrics([]byte(metrics))
+	g.Expect(err).Should(BeNil())
+	restorePercent, importPercent, err := lightningProgress(families)
+	g.Expect(err).Should(BeNil())
+	g.Expect(restorePercent).Should(Equal(50.0))
+	g.Expect(importPercent).Should(Equal(25.0))
+
+	families, err = backupUtil.ParseMetrics([]byte("# TYPE lightning_chunks gauge\nlightning_chunks{state=\"finished\"} 1\n"))
+	g.Expect(err).Should(BeNil())
+	_, _, err = lightningProgress(families)
+	g.Expect(err).ShouldNot(BeNil())
+}
+
+func TestNewLightningLocalConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	restore := &v1alpha1.Restore{
+		Spec: v1alpha1.RestoreSpec{
+			Lightning: &v1alpha1.LightningConfig{
+				Backend:   v1alpha1.LightningBackendLocal,
+				Cluster:   "tidb",
+				DiskQuota: "1Gi",
+			},
+		},
+	}
+	ro := &Options{}
+	ro.TLSCluster = true
+	workDir := "/backup/.lightning/uid"
+	cfg, err := ro.newLightningLocalConfig(restore, workDir)
+	g.Expect(err).Should(BeNil())
+	g.Expect(cfg.TiKVImporter.DiskQuota).Should(Equal(int64(1 << 30)))
+	g.Expect(cfg.Checkpoint.DSN).Should(Equal(lightningCheckpointPath(workDir)))
+	g.Expect(cfg.Security).ShouldNot(BeNil())
+	// TiDB is not connected with the cluster client certificates
+	g.Expect(cfg.TiDB.TLS).Should(Equal("false"))
+
+	buf := new(bytes.Buffer)
+	g.Expect(toml.NewEncoder(buf).Encode(cfg)).Should(Succeed())
+	g.Expect(buf.String()).Should(ContainSubstring(`sorted-kv-dir = "/backup/.lightning/uid/sorted-kv"`))
+
+	ro.TLSClient = true
+	ro.SkipClientCA = true
+	cfg, err = ro.newLightningLocalConfig(restore, workDir)
+	g.Expect(err).Should(BeNil())
+	g.Expect(cfg.TiDB.TLS).Should(Equal("skip-verify"))
+	g.Expect(cfg.TiDB.Security.CAPath).Should(BeEmpty())
+
+	restore.Spec.Lightning.DiskQuota = "invalid"
+	_, err = ro.newLightningLocalConfig(restore, workDir)
+	g.Expect(err).ShouldNot(BeNil())
+}
+
+func TestLightningWorkDir(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	restore := &v1alpha1.Restore{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "restore", UID: "uid"},
+		Spec: v1alpha1.RestoreSpec{
+			Lightning: &v1alpha1.LightningConfig{Backend: v1alpha1.LightningBackendLocal},
+		},
+	}
+	workDir := lightningWorkDir(restore, "s3://bucket/backup")
+	g.Expect(filepath.Dir(workDir)).Should(Equal(filepath.Join(lightningRootDir, "ns", "restore")))
+	g.Expect(lightningWorkDir(restore, "s3://bucket/backup")).Should(Equal(workDir))
+
+	// the checkpoint is resumed by the recreated restore
+	recreated := restore.DeepCopy()
+	recreated.UID = "recreated"
+	g.Expect(lightningWorkDir(recreated, "s3://bucket/backup")).Should(Equal(workDir))
+
+	// the checkpoint is not resumed by another restore, or the restore of different data
+	g.Expect(lightningWorkDir(restore, "s3://bucket/other")).ShouldNot(Equal(workDir))
+	other := restore.DeepCopy()
+	other.Name = "other"
+	g.Expect(lightningWorkDir(other, "s3://bucket/backup")).ShouldNot(Equal(workDir))
+	other = restore.DeepCopy()
+	other.Spec.TableFilter = []string{"db.*"}
+	g.Expect(lightningWorkDir(other, "s3://bucket/backup")).ShouldNot(Equal(workDir))
+}
+
+func TestPrepareLightningWorkDir(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	root := t.TempDir()
+	workDir := filepath.Join(root, "ns", "restore", "new")
+	stale := filepath.Join(root, "ns", "restore", "stale")
+	other := filepath.Join(root, "ns", "other", "checkpoint")
+	for _, dir := range []string{stale, other} {
+		g.Expect(os.MkdirAll(dir, 0755)).Should(Succeed())
+	}
+
+	// only the work dirs of the same restore are removed
+	g.Expect(prepareLightningWorkDir(workDir)).Should(Succeed())
+	g.Expect(workDir).Should(BeADirectory())
+	g.Expect(stale).ShouldNot(BeADirectory())
+	g.Expect(other).Should(BeADirectory())
+}
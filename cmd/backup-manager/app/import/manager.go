@@ -112,62 +112,107 @@ func (rm *RestoreManager) performRestore(ctx context.Context, restore *v1alpha1.
 	}
 
 	var errs []error
-	restoreDataPath := rm.getRestoreDataPath()
-	opts := util.GetOptions(restore.Spec.StorageProvider)
-	if err := rm.downloadBackupData(ctx, restoreDataPath, opts); err != nil {
-		errs = append(errs, err)
-		klog.Errorf("download cluster %s backup %s data failed, err: %s", rm, rm.BackupPath, err)
-		uerr := rm.StatusUpdater.Update(restore, &v1alpha1.RestoreCondition{
-			Type:    v1alpha1.RestoreFailed,
-			Status:  corev1.ConditionTrue,
-			Reason:  "DownloadBackupDataFailed",
-			Message: fmt.Sprintf("download backup %s data failed, err: %v", rm.BackupPath, err),
-		}, nil)
-		errs = append(errs, uerr)
-		return errorutils.NewAggregate(errs)
-	}
-	klog.Infof("download cluster %s backup %s data success", rm, rm.BackupPath)
-
-	restoreDataDir := filepath.Dir(restoreDataPath)
-	unarchiveDataPath, err := unarchiveBackupData(restoreDataPath, restoreDataDir)
+	var loadDataPath, commitTs string
+	restoreDataPath := rm.BackupPath
+	streamBackup, err := rm.isStreamBackup(ctx, restore)
 	if err != nil {
 		errs = append(errs, err)
-		klog.Errorf("unarchive cluster %s backup %s data failed, err: %s", rm, restoreDataPath, err)
+		klog.Errorf("check cluster %s backup %s format failed, err: %s", rm, rm.BackupPath, err)
 		uerr := rm.StatusUpdater.Update(restore, &v1alpha1.RestoreCondition{
 			Type:    v1alpha1.RestoreFailed,
 			Status:  corev1.ConditionTrue,
-			Reason:  "UnarchiveBackupDataFailed",
-			Message: fmt.Sprintf("unarchive backup %s data failed, err: %v", restoreDataPath, err),
+			Reason:  "CheckBackupFormatFailed",
+			Message: err.Error(),
 		}, nil)
 		errs = append(errs, uerr)
 		return errorutils.NewAggregate(errs)
 	}
-	klog.Infof("unarchive cluster %s backup %s data success", rm, restoreDataPath)
+	if streamBackup {
+		loadDataPath, commitTs, err = rm.getStreamBackupData(ctx, restore)
+		if err != nil {
+			errs = append(errs, err)
+			klog.Errorf("get cluster %s stream backup %s data failed, err: %s", rm, rm.BackupPath, err)
+			uerr := rm.StatusUpdater.Update(restore, &v1alpha1.RestoreCondition{
+				Type:    v1alpha1.RestoreFailed,
+				Status:  corev1.ConditionTrue,
+				Reason:  "GetCommitTsFailed",
+				Message: err.Error(),
+			}, nil)
+			errs = append(errs, uerr)
+			return errorutils.NewAggregate(errs)
+		}
+		klog.Infof("get cluster %s commitTs %s success", rm, commitTs)
+	} else {
+		restoreDataPath = rm.getRestoreDataPath()
+		opts := util.GetOptions(restore.Spec.StorageProvider)
+		if err := rm.downloadBackupData(ctx, restoreDataPath, opts); err != nil {
+			errs = append(errs, err)
+			klog.Errorf("download cluster %s backup %s data failed, err: %s", rm, rm.BackupPath, err)
+			uerr := rm.StatusUpdater.Update(restore, &v1alpha1.RestoreCondition{
+				Type:    v1alpha1.RestoreFailed,
+				Status:  corev1.ConditionTrue,
+				Reason:  "DownloadBackupDataFailed",
+				Message: fmt.Sprintf("download backup %s data failed, err: %v", rm.BackupPath, err),
+			}, nil)
+			errs = append(errs, uerr)
+			return errorutils.NewAggregate(errs)
+		}
+		klog.Infof("download cluster %s backup %s data success", rm, rm.BackupPath)
+
+		restoreDataDir := filepath.Dir(restoreDataPath)
+		loadDataPath, err = unarchiveBackupData(restoreDataPath, restoreDataDir)
+		if err != nil {
+			errs = append(errs, err)
+			klog.Errorf("unarchive cluster %s backup %s data failed, err: %s", rm, restoreDataPath, err)
+			uerr := rm.StatusUpdater.Update(restore, &v1alpha1.RestoreCondition{
+				Type:    v1alpha1.RestoreFailed,
+				Status:  corev1.ConditionTrue,
+				Reason:  "UnarchiveBackupDataFailed",
+				Message: fmt.Sprintf("unarchive backup %s data failed, err: %v", restoreDataPath, err),
+			}, nil)
+			errs = append(errs, uerr)
+			return errorutils.NewAggregate(errs)
+		}
+		klog.Infof("unarchive cluster %s backup %s data success", rm, restoreDataPath)
+
+		commitTs, err = util.GetCommitTsFromMetadata(loadDataPath)
+		if err != nil {
+			errs = append(errs, err)
+			klog.Errorf("get cluster %s commitTs failed, err: %s", rm, err)
+			uerr := rm.StatusUpdater.Update(restore, &v1alpha1.RestoreCondition{
+				Type:    v1alpha1.RestoreFailed,
+				Status:  corev1.ConditionTrue,
+				Reason:  "GetCommitTsFailed",
+				Message: err.Error(),
+			}, nil)
+			errs = append(errs, uerr)
+			return errorutils.NewAggregate(errs)
+		}
+		klog.Infof("get cluster %s commitTs %s success", rm, commitTs)
+	}
 
-	commitTs, err := util.GetCommitTsFromMetadata(unarchiveDataPath)
-	if err != nil {
-		errs = append(errs, err)
-		klog.Errorf("get cluster %s commitTs failed, err: %s", rm, err)
-		uerr := rm.StatusUpdater.Update(restore, &v1alpha1.RestoreCondition{
-			Type:    v1alpha1.RestoreFailed,
-			Status:  corev1.ConditionTrue,
-			Reason:  "GetCommitTsFailed",
-			Message: err.Error(),
-		}, nil)
-		errs = append(errs, uerr)
-		return errorutils.NewAggregate(errs)
+	checkpointPath := lightningCheckpointPath(lightningWorkDir(restore, rm.BackupPath))
+	if restore.IsLightningLocalBackend() && util.IsFileExist(checkpointPath) {
+		klog.Infof("cluster %s resumes the import from lightning checkpoint %s", rm, checkpointPath)
 	}
-	klog.Infof("get cluster %s commitTs %s success", rm, commitTs)
 
-	err = rm.loadTidbClusterData(ctx, unarchiveDataPath, restore)
+	err = rm.loadTidbClusterData(ctx, loadDataPath, streamBackup, restore, rm.StatusUpdater)
 	if err != nil {
 		errs = append(errs, err)
 		klog.Errorf("restore cluster %s from backup %s failed, err: %s", rm, rm.BackupPath, err)
+		message := fmt.Sprintf("loader backup %s data failed, err: %v", restoreDataPath, err)
+		conditionType := v1alpha1.RestoreFailed
+		if restore.IsLightningLocalBackend() && util.IsFileExist(checkpointPath) {
+			// the checkpoint is kept in the restore pvc and the import job retries the failed pod, which resumes
+			// from it. The restore is marked as failed by the controller after the job runs out of retries.
+			message = fmt.Sprintf("%s, lightning checkpoint is saved to %s in pvc %s", message, checkpointPath, restore.GetRestorePVCName())
+			conditionType = v1alpha1.RestoreRetryFailed
+		}
 		uerr := rm.StatusUpdater.Update(restore, &v1alpha1.RestoreCondition{
-			Type:    v1alpha1.RestoreFailed,
+			Type:    conditionType,
 			Status:  corev1.ConditionTrue,
 			Reason:  "LoaderBackupDataFailed",
-			Message: fmt.Sprintf("loader backup %s data failed, err: %v", restoreDataPath, err),
+			Message: message,
 		}, nil)
 		errs = append(errs, uerr)
 		return errorutils.NewAggregate(errs)
@@ -16,6 +16,7 @@ package restore
 import (
 	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -30,6 +31,7 @@ import (
 	backupUtil "github.com/pingcap/tidb-operator/cmd/backup-manager/app/util"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/backup/constants"
+	"github.com/pingcap/tidb-operator/pkg/backup/snapshotter"
 	pkgutil "github.com/pingcap/tidb-operator/pkg/backup/util"
 	"github.com/pingcap/tidb-operator/pkg/controller"
 	"github.com/pingcap/tidb-operator/pkg/util"
@@ -104,17 +106,22 @@ func (ro *Options) restoreData(
 		}
 		restoreType = "point"
 	case string(v1alpha1.RestoreModeVolumeSnapshot):
-		// Currently, we only support aws ebs volume snapshot.
 		args = append(args, "--type=aws-ebs")
 		if ro.Prepare {
 			args = append(args, "--prepare")
 			csbPath = path.Join(util.BRBinPath, "csb_restore.json")
 			args = append(args, fmt.Sprintf("--output-file=%s", csbPath))
-			args = append(args, fmt.Sprintf("--target-az=%s", ro.TargetAZ))
-			if ro.UseFSR {
-				args = append(args, "--use-fsr=true")
+			if snapshotter.VolumesRestoredByBR(restore) {
+				args = append(args, fmt.Sprintf("--target-az=%s", ro.TargetAZ))
+				if ro.UseFSR {
+					args = append(args, "--use-fsr=true")
+				} else {
+					args = append(args, "--use-fsr=false")
+				}
 			} else {
-				args = append(args, "--use-fsr=false")
+				// BR can only create aws ebs volumes from the snapshots,
+				// other volumes are created from the snapshots after BR prepares the cluster
+				args = append(args, "--skip-aws=true")
 			}
 			progressStep = "Volume Restore"
 		} else {
@@ -196,6 +203,9 @@ func (ro *Options) restoreData(
 	}
 
 	if csbPath != "" {
+		if err = ro.createVolumes(ctx, restore, csbPath); err != nil {
+			return err
+		}
 		err = ro.processCloudSnapBackup(ctx, restore, csbPath, restoreControl)
 		if err != nil {
 			return err
@@ -222,6 +232,32 @@ func (ro *Options) restoreData(
 	return nil
 }
 
+// createVolumes creates the volumes from the snapshots for the volumes BR can't restore,
+// and records the volume IDs in the restore meta.
+func (ro *Options) createVolumes(ctx context.Context, restore *v1alpha1.Restore, csbPath string) error {
+	creator, err := snapshotter.NewVolumeCreator(restore)
+	if err != nil || creator == nil {
+		return err
+	}
+	contents, err := os.ReadFile(csbPath)
+	if err != nil {
+		klog.Errorf("read metadata file %s failed, err: %s", csbPath, err)
+		return err
+	}
+	meta := &pkgutil.EBSBasedBRMeta{}
+	if err = json.Unmarshal(contents, meta); err != nil {
+		return fmt.Errorf("unmarshal metadata file %s failed, err: %v", csbPath, err)
+	}
+	klog.Infof("create volumes from the snapshots for cluster %s", ro)
+	if err = creator.CreateVolumes(ctx, restore, meta); err != nil {
+		return fmt.Errorf("create volumes for cluster %s failed, err: %v", ro, err)
+	}
+	if contents, err = json.Marshal(meta); err != nil {
+		return err
+	}
+	return os.WriteFile(csbPath, contents, 0644)
+}
+
 // copy the restore meta to remote storage since k8s has limit to handle massive data pass between pods
 func (ro *Options) processCloudSnapBackup(
 	ctx context.Context,
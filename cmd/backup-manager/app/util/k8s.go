@@ -23,6 +23,8 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 )
 
 // NewEventRecorder return the specify source's recoder
@@ -74,6 +76,20 @@ func NewKubeAndCRCli(kubeconfig string) (kubernetes.Interface, versioned.Interfa
 	return kubeCli, crCli, nil
 }
 
+// NewGenericCli create a generic client, it's used to operate the resources without typed clients,
+// such as VolumeSnapshots. The REST mappings are discovered lazily, so it's cheap if never used.
+func NewGenericCli(kubeconfig string) (client.Client, error) {
+	cfg, err := newConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	mapper, err := apiutil.NewDynamicRESTMapper(cfg, apiutil.WithLazyDiscovery)
+	if err != nil {
+		return nil, err
+	}
+	return client.New(cfg, client.Options{Mapper: mapper})
+}
+
 func newConfig(kubeconfig string) (cfg *rest.Config, err error) {
 	if kubeconfig != "" {
 		cfg, err = clientcmd.BuildConfigFromFlags("", kubeconfig)
@@ -0,0 +1,85 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+// GetMetrics gets the metrics from the status API of tools such as dumpling and TiDB Lightning
+func GetMetrics(ctx context.Context, client *http.Client, url string) (map[string]*dto.MetricFamily, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("get %s failed, status code: %d", url, resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetrics(body)
+}
+
+// ParseMetrics parses the metrics in the prometheus text format
+func ParseMetrics(data []byte) (map[string]*dto.MetricFamily, error) {
+	var parser expfmt.TextParser
+	return parser.TextToMetricFamilies(bytes.NewReader(data))
+}
+
+// SumMetric sums the values of the counter or gauge metric.
+// If label is not empty, the values are grouped by the value of the label.
+func SumMetric(family *dto.MetricFamily, label string) map[string]float64 {
+	sums := map[string]float64{}
+	if family == nil {
+		return sums
+	}
+	for _, m := range family.GetMetric() {
+		value := m.GetGauge().GetValue()
+		if m.GetCounter() != nil {
+			value = m.GetCounter().GetValue()
+		}
+		if label == "" {
+			sums[""] += value
+			continue
+		}
+		for _, l := range m.GetLabel() {
+			if l.GetName() == label {
+				sums[l.GetValue()] += value
+			}
+		}
+	}
+	return sums
+}
+
+// Percent converts the ratio to percentage, which is no more than 100
+func Percent(ratio float64) float64 {
+	if ratio > 1 {
+		ratio = 1
+	}
+	return ratio * 100
+}
@@ -221,6 +221,12 @@ func ConstructDumplingOptionsForBackup(backup *v1alpha1.Backup) []string {
 		return args
 	}
 
+	if config.Dumpling.FileType != "" {
+		args = append(args, fmt.Sprintf("--filetype=%s", config.Dumpling.FileType))
+	}
+	if config.Dumpling.FileSize != "" {
+		args = append(args, fmt.Sprintf("--filesize=%s", config.Dumpling.FileSize))
+	}
 	if len(config.Dumpling.Options) != 0 {
 		args = append(args, config.Dumpling.Options...)
 	} else {
@@ -325,17 +331,20 @@ metadata file format is as follows:
 	Finished dump at: 2019-06-13 10:00:04
 */
 func GetCommitTsFromMetadata(backupPath string) (string, error) {
-	var commitTs string
-
 	metaFile := filepath.Join(backupPath, constants.MetaDataFile)
 	if exist := IsFileExist(metaFile); !exist {
-		return commitTs, fmt.Errorf("file %s does not exist or is not regular file", metaFile)
+		return "", fmt.Errorf("file %s does not exist or is not regular file", metaFile)
 	}
 	contents, err := ioutil.ReadFile(metaFile)
 	if err != nil {
-		return commitTs, fmt.Errorf("read metadata file %s failed, err: %v", metaFile, err)
+		return "", fmt.Errorf("read metadata file %s failed, err: %v", metaFile, err)
 	}
+	return ParseCommitTsFromMetadata(metaFile, contents)
+}
 
+// ParseCommitTsFromMetadata parses the commitTs from the contents of dumpling's metadata file
+func ParseCommitTsFromMetadata(metaFile string, contents []byte) (string, error) {
+	var commitTs string
 	for _, lineStr := range strings.Split(string(contents), "\n") {
 		if !strings.Contains(lineStr, "Pos") {
 			continue
@@ -350,17 +359,31 @@ func GetCommitTsFromMetadata(backupPath string) (string, error) {
 	return commitTs, nil
 }
 
-// GetBRArchiveSize returns the total size of the backup archive.
-func GetBRArchiveSize(meta *kvbackup.BackupMeta) uint64 {
-	if meta.BackupSize != 0 {
-		return meta.BackupSize
+// objectLockConcurrency is the number of objects whose retention is verified concurrently
+const objectLockConcurrency = 16
+
+// CheckBackupDataRetention checks the objects written to the storage are retained at write time
+// as required by its object lock
+func CheckBackupDataRetention(ctx context.Context, provider v1alpha1.StorageProvider, cred *util.StorageCredential) error {
+	s, err := util.NewStorageBackend(provider, cred)
+	if err != nil {
+		return err
 	}
-	// ASSERT: the version of meta must be v1
-	total := uint64(meta.Size())
-	for _, file := range meta.Files {
-		total += file.Size_
+	defer s.Close()
+
+	return s.CheckDefaultRetention(ctx)
+}
+
+// VerifyBackupDataRetention verifies all the objects of the storage written since the time are retained
+// as required by its object lock, keys limits the objects to verify if it is not empty
+func VerifyBackupDataRetention(ctx context.Context, provider v1alpha1.StorageProvider, cred *util.StorageCredential, since time.Time, keys ...string) (*util.ObjectsRetentionResult, error) {
+	s, err := util.NewStorageBackend(provider, cred)
+	if err != nil {
+		return nil, err
 	}
-	return total
+	defer s.Close()
+
+	return util.VerifyObjectsRetention(ctx, s, since, objectLockConcurrency, keys...)
 }
 
 // GetBRMetaData get backup metadata from cloud storage
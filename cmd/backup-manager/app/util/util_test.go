@@ -131,6 +131,20 @@ func TestConstructDumplingOptionsForBackup(t *testing.T) {
 	}
 }
 
+func TestConstructDumplingFileOptionsForBackup(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	backup := newBackup()
+	backup.Spec.Dumpling = &v1alpha1.DumplingConfig{
+		FileType: v1alpha1.DumplingFileTypeCSV,
+		FileSize: "256MiB",
+	}
+	expectArgs := append([]string{}, defaultTableFilterOptions...)
+	expectArgs = append(expectArgs, "--filetype=csv", "--filesize=256MiB")
+	expectArgs = append(expectArgs, defaultOptions...)
+	g.Expect(ConstructDumplingOptionsForBackup(backup)).To(Equal(expectArgs))
+}
+
 func TestConstructBRGlobalOptionsForBackup(t *testing.T) {
 	g := NewGomegaWithT(t)
 
@@ -30,15 +30,22 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/controller"
 	"github.com/pingcap/tidb-operator/pkg/controller/autoscaler"
 	"github.com/pingcap/tidb-operator/pkg/controller/backup"
+	"github.com/pingcap/tidb-operator/pkg/controller/backuprepository"
 	"github.com/pingcap/tidb-operator/pkg/controller/backupschedule"
 	compact "github.com/pingcap/tidb-operator/pkg/controller/compactbackup"
 	"github.com/pingcap/tidb-operator/pkg/controller/dmcluster"
+	"github.com/pingcap/tidb-operator/pkg/controller/dmsource"
+	"github.com/pingcap/tidb-operator/pkg/controller/dmtask"
 	"github.com/pingcap/tidb-operator/pkg/controller/restore"
+	"github.com/pingcap/tidb-operator/pkg/controller/ticdcchangefeed"
 	"github.com/pingcap/tidb-operator/pkg/controller/tidbcluster"
 	"github.com/pingcap/tidb-operator/pkg/controller/tidbdashboard"
 	"github.com/pingcap/tidb-operator/pkg/controller/tidbinitializer"
 	"github.com/pingcap/tidb-operator/pkg/controller/tidbmonitor"
 	"github.com/pingcap/tidb-operator/pkg/controller/tidbngmonitoring"
+	"github.com/pingcap/tidb-operator/pkg/controller/tidbplacementpolicy"
+	"github.com/pingcap/tidb-operator/pkg/controller/tidbresourcegroup"
+	"github.com/pingcap/tidb-operator/pkg/controller/tidbuser"
 	"github.com/pingcap/tidb-operator/pkg/features"
 	"github.com/pingcap/tidb-operator/pkg/metrics"
 	"github.com/pingcap/tidb-operator/pkg/scheme"
@@ -182,6 +189,8 @@ func main() {
 			tidbcluster.NewController(deps),
 			tidbcluster.NewPodController(deps),
 			dmcluster.NewController(deps),
+			dmsource.NewController(deps),
+			dmtask.NewController(deps),
 			backup.NewController(deps),
 			compact.NewController(deps),
 			restore.NewController(deps),
@@ -190,6 +199,11 @@ func main() {
 			tidbmonitor.NewController(deps),
 			tidbngmonitoring.NewController(deps),
 			tidbdashboard.NewController(deps),
+			ticdcchangefeed.NewController(deps),
+			tidbuser.NewController(deps),
+			tidbplacementpolicy.NewController(deps),
+			tidbresourcegroup.NewController(deps),
+			backuprepository.NewController(deps),
 		}
 		if features.DefaultFeatureGate.Enabled(features.AutoScaling) {
 			controllers = append(controllers, autoscaler.NewController(deps))
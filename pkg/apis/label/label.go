@@ -59,6 +59,9 @@ const (
 	// BackupLabelKey is backup key
 	BackupLabelKey string = "tidb.pingcap.com/backup"
 
+	// BackupRepositoryLabelKey is backup repository key of the imported backups
+	BackupRepositoryLabelKey string = "tidb.pingcap.com/backup-repository"
+
 	// RestoreLabelKey is restore key
 	RestoreLabelKey string = "tidb.pingcap.com/restore"
 	// RestoreWarmUpLabelKey defines which pod the restore warms up
@@ -105,6 +108,9 @@ const (
 	AnnEvictLeaderBeginTime = "tidb.pingcap.com/evictLeaderBeginTime"
 	// AnnTiCDCGracefulShutdownBeginTime is pod annotation key to indicate the begin time for graceful shutdown TiCDC
 	AnnTiCDCGracefulShutdownBeginTime = "tidb.pingcap.com/ticdc-graceful-shutdown-begin-time"
+	// AnnPromoteUpgradePrefix is tc annotation key prefix to promote the staged upgrade of a component,
+	// the value is the revision in the upgrade stage status of the component
+	AnnPromoteUpgradePrefix = "tidb.pingcap.com/promote-upgrade-"
 	// AnnStsLastSyncTimestamp is sts annotation key to indicate the last timestamp the operator sync the sts
 	AnnStsLastSyncTimestamp = "tidb.pingcap.com/sync-timestamp"
 	// AnnTiflashMountCMInTiflashContainer is tiflash pod annotation key to indicate whether directly mount ConfigMap
@@ -151,6 +157,12 @@ const (
 
 	// AnnSkipTLSWhenConnectTiDB describes whether skip TLS when connecting to TiDB Server
 	AnnSkipTLSWhenConnectTiDB = "tidb.tidb.pingcap.com/skip-tls-when-connect-tidb"
+	// AnnTLSCertHash is pod annotation key of the hash of the certificate issued by the BuiltIn TLS issuer,
+	// pods are rolling updated when the certificate is renewed
+	AnnTLSCertHash = "tidb.pingcap.com/tls-cert-hash"
+
+	// AnnVerifyBackup is annotation key of the backup verified by the resources of backup verification
+	AnnVerifyBackup = "tidb.pingcap.com/verify-backup"
 
 	// AnnBackupCloudSnapKey is the annotation key for backup metadata based cloud snapshot
 	AnnBackupCloudSnapKey string = "tidb.pingcap.com/backup-cloud-snapshot"
@@ -195,6 +207,24 @@ const (
 	// TiDBMonitorProtectionFinalizer is the name of finalizer on TidbMonitors
 	TiDBMonitorProtectionFinalizer string = "tidb.pingcap.com/monitor-protection"
 
+	// TiCDCChangefeedProtectionFinalizer is the name of finalizer on TiCDCChangefeeds
+	TiCDCChangefeedProtectionFinalizer string = "tidb.pingcap.com/changefeed-protection"
+
+	// DMSourceProtectionFinalizer is the name of finalizer on DMSources
+	DMSourceProtectionFinalizer string = "tidb.pingcap.com/dm-source-protection"
+
+	// DMTaskProtectionFinalizer is the name of finalizer on DMTasks
+	DMTaskProtectionFinalizer string = "tidb.pingcap.com/dm-task-protection"
+
+	// TiDBUserProtectionFinalizer is the name of finalizer on TidbUsers
+	TiDBUserProtectionFinalizer string = "tidb.pingcap.com/user-protection"
+
+	// TiDBPlacementPolicyProtectionFinalizer is the name of finalizer on TidbPlacementPolicies
+	TiDBPlacementPolicyProtectionFinalizer string = "tidb.pingcap.com/placement-policy-protection"
+
+	// TiDBResourceGroupProtectionFinalizer is the name of finalizer on TidbResourceGroups
+	TiDBResourceGroupProtectionFinalizer string = "tidb.pingcap.com/resource-group-protection"
+
 	// CleanJobLabelVal is clean job label value
 	CleanJobLabelVal string = "clean"
 	// RestoreJobLabelVal is restore job label value
@@ -79,6 +79,11 @@ func (bk *Backup) GetBackupPVCName() string {
 	return fmt.Sprintf("backup-pvc-%s", bk.GetTidbEndpointHash())
 }
 
+// IsDumplingStreaming returns whether the backup writes the output of dumpling to the storage directly
+func (bk *Backup) IsDumplingStreaming() bool {
+	return bk.Spec.BR == nil && bk.Spec.Dumpling != nil && bk.Spec.Dumpling.OutputMode == DumplingOutputModeStream
+}
+
 // GetInstanceName return the backup instance name
 func (bk *Backup) GetInstanceName() string {
 	if bk.Labels != nil {
@@ -138,8 +143,8 @@ func UpdateBackupCondition(status *BackupStatus, condition *BackupCondition) boo
 
 	isDiffPhase := status.Phase != condition.Type
 
-	// restart condition no need to update to phase
-	if isDiffPhase && condition.Type != BackupRestart {
+	// restart and verified condition no need to update to phase
+	if isDiffPhase && condition.Type != BackupRestart && condition.Type != BackupVerified {
 		status.Phase = condition.Type
 	}
 
@@ -303,6 +308,12 @@ func IsBackupClean(backup *Backup) bool {
 	return condition != nil && condition.Status == corev1.ConditionTrue
 }
 
+// IsBackupUnderRetention returns true if some objects of a Backup are skipped by the clean
+// as they are still retained by the object lock
+func IsBackupUnderRetention(backup *Backup) bool {
+	return backup.Status.RetainUntil != nil && !IsBackupClean(backup)
+}
+
 // IsBackupCleanFailed returns true if a Backup has failed to clean up
 func IsBackupCleanFailed(backup *Backup) bool {
 	_, condition := GetBackupCondition(&backup.Status, BackupCleanFailed)
@@ -0,0 +1,111 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupRepository is a storage of the backups which are imported into Backup objects.
+// The direct sub directories of the storage prefix are scanned periodically, the BR snapshot,
+// log and volume-snapshot backups recognized by their metadata are created as complete Backup
+// objects, or adopted if there are already Backup objects of them, so that they can be
+// restored and cleaned declaratively after the Kubernetes cluster is migrated or rebuilt.
+//
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName="brepo"
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Backups",type=integer,JSONPath=`.status.backups`,description="The number of backups recognized in the storage"
+// +kubebuilder:printcolumn:name="LastSyncTime",type=date,JSONPath=`.status.lastSyncTime`,description="The last time the storage was scanned"
+// +kubebuilder:printcolumn:name="Error",type=string,JSONPath=`.status.error`,description="The last error when scanning the storage",priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type BackupRepository struct {
+	metav1.TypeMeta `json:",inline"`
+	// +k8s:openapi-gen=false
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec BackupRepositorySpec `json:"spec"`
+
+	// +k8s:openapi-gen=false
+	Status BackupRepositoryStatus `json:"status,omitempty"`
+}
+
+// BackupRepositoryList is BackupRepository list
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type BackupRepositoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +k8s:openapi-gen=false
+	metav1.ListMeta `json:"metadata"`
+
+	Items []BackupRepository `json:"items"`
+}
+
+// BackupRepositorySpec describes the storage of the backups
+//
+// +k8s:openapi-gen=true
+type BackupRepositorySpec struct {
+	// StorageProvider configures the storage of the backups,
+	// each direct sub directory of the prefix is recognized as a backup.
+	StorageProvider `json:",inline"`
+
+	// BR is the BR config of the imported backups, e.g. the cluster they are backed up from.
+	// Defaults to an empty BR config, the imported backups are still cleaned by BR.
+	// +optional
+	BR *BRConfig `json:"br,omitempty"`
+
+	// CleanPolicy is the clean policy of the imported backups.
+	// Defaults to Retain, which means the backup data are kept when the Backup objects are deleted.
+	// +optional
+	CleanPolicy CleanPolicyType `json:"cleanPolicy,omitempty"`
+
+	// SyncInterval is the interval to scan the storage, in the format of Go Duration.
+	// Defaults to 1h.
+	// +optional
+	SyncInterval string `json:"syncInterval,omitempty"`
+
+	// Paused stops scanning the storage
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// BackupRepositoryStatus is the status of the backup repository
+type BackupRepositoryStatus struct {
+	// ObservedGeneration is the generation of the spec the storage was scanned with
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Backups is the number of backups recognized in the storage
+	// +optional
+	Backups int32 `json:"backups,omitempty"`
+	// Conflicts are the backups not imported as the Backup objects of their names belong to other backups
+	// +optional
+	Conflicts []string `json:"conflicts,omitempty"`
+	// Error is the last error when scanning the storage
+	// +optional
+	Error string `json:"error,omitempty"`
+	// +optional
+	// +nullable
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
+// GetCleanPolicy returns the clean policy of the imported backups
+func (r *BackupRepository) GetCleanPolicy() CleanPolicyType {
+	if r.Spec.CleanPolicy != "" {
+		return r.Spec.CleanPolicy
+	}
+	return CleanPolicyTypeRetain
+}
@@ -61,6 +61,34 @@ const (
 	TiDBDashboardKind    = "TidbDashboard"
 	TiDBDashboardKindKey = "tidbdashboard"
 
+	TiCDCChangefeedName    = "ticdcchangefeeds"
+	TiCDCChangefeedKind    = "TiCDCChangefeed"
+	TiCDCChangefeedKindKey = "ticdcchangefeed"
+
+	DMSourceName    = "dmsources"
+	DMSourceKind    = "DMSource"
+	DMSourceKindKey = "dmsource"
+
+	DMTaskName    = "dmtasks"
+	DMTaskKind    = "DMTask"
+	DMTaskKindKey = "dmtask"
+
+	TiDBUserName    = "tidbusers"
+	TiDBUserKind    = "TidbUser"
+	TiDBUserKindKey = "tidbuser"
+
+	TiDBPlacementPolicyName    = "tidbplacementpolicies"
+	TiDBPlacementPolicyKind    = "TidbPlacementPolicy"
+	TiDBPlacementPolicyKindKey = "tidbplacementpolicy"
+
+	TiDBResourceGroupName    = "tidbresourcegroups"
+	TiDBResourceGroupKind    = "TidbResourceGroup"
+	TiDBResourceGroupKindKey = "tidbresourcegroup"
+
+	BackupRepositoryName    = "backuprepositories"
+	BackupRepositoryKind    = "BackupRepository"
+	BackupRepositoryKindKey = "backuprepository"
+
 	SpecPath = "github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1."
 )
 
@@ -0,0 +1,122 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DMSource is an upstream MySQL compatible database of a DMCluster.
+// The source is created through the OpenAPI of dm-master, which is enabled
+// for dm-master v5.3.0 or later unless `openapi` is set in the config of dm-master.
+//
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName="dms"
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Cluster",type=string,JSONPath=`.spec.cluster.name`,description="The DMCluster of the source"
+// +kubebuilder:printcolumn:name="Worker",type=string,JSONPath=`.status.workerName`,description="The dm-worker bound to the source"
+// +kubebuilder:printcolumn:name="Relay",type=string,JSONPath=`.status.relayStage`,description="The stage of the relay log"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type DMSource struct {
+	metav1.TypeMeta `json:",inline"`
+	// +k8s:openapi-gen=false
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec DMSourceSpec `json:"spec"`
+
+	// +k8s:openapi-gen=false
+	Status DMSourceStatus `json:"status,omitempty"`
+}
+
+// DMSourceList is DMSource list
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type DMSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +k8s:openapi-gen=false
+	metav1.ListMeta `json:"metadata"`
+
+	Items []DMSource `json:"items"`
+}
+
+// DMSourceSpec describes the upstream database of DM
+//
+// +k8s:openapi-gen=true
+type DMSourceSpec struct {
+	// Cluster is the DMCluster which migrates data from the source,
+	// the namespace defaults to the namespace of the source.
+	Cluster ClusterRef `json:"cluster"`
+
+	// SourceName is the name of the source in DM.
+	// Defaults to the name of the DMSource.
+	// +optional
+	SourceName string `json:"sourceName,omitempty"`
+
+	// Host is the host of the upstream database
+	Host string `json:"host"`
+
+	// Port is the port of the upstream database
+	// +kubebuilder:validation:Minimum=1
+	Port int32 `json:"port"`
+
+	// SecretName is the name of the secret which stores the credentials of the upstream database,
+	// the secret must contain the `user` key and may contain the `password` key.
+	SecretName string `json:"secretName"`
+
+	// EnableGTID indicates whether to use GTID to pull binlogs from the upstream database
+	// +optional
+	EnableGTID bool `json:"enableGTID,omitempty"`
+
+	// EnableRelay indicates whether to enable the relay log of the source
+	// +optional
+	EnableRelay bool `json:"enableRelay,omitempty"`
+}
+
+// DMSourceStatus is the status of the DM source
+type DMSourceStatus struct {
+	// ObservedGeneration is the generation of the spec applied to DM
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// WorkerName is the name of the dm-worker bound to the source
+	// +optional
+	WorkerName string `json:"workerName,omitempty"`
+	// RelayStage is the stage of the relay log if the relay log is enabled
+	// +optional
+	RelayStage string `json:"relayStage,omitempty"`
+	// Error is the last error of the source reported by DM or the operator
+	// +optional
+	Error string `json:"error,omitempty"`
+	// +optional
+	// +nullable
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// GetSourceName returns the name of the source in DM
+func (s *DMSource) GetSourceName() string {
+	if s.Spec.SourceName != "" {
+		return s.Spec.SourceName
+	}
+	return s.Name
+}
+
+// GetClusterNamespace returns the namespace of the DMCluster of the source
+func (s *DMSource) GetClusterNamespace() string {
+	if s.Spec.Cluster.Namespace != "" {
+		return s.Spec.Cluster.Namespace
+	}
+	return s.Namespace
+}
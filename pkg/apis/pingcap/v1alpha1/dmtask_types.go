@@ -0,0 +1,253 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DMTask is a data migration task of a DMCluster.
+// The task is created through the OpenAPI of dm-master, which is enabled
+// for dm-master v5.3.0 or later unless `openapi` is set in the config of dm-master.
+//
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName="dmt"
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Cluster",type=string,JSONPath=`.spec.cluster.name`,description="The DMCluster running the task"
+// +kubebuilder:printcolumn:name="Mode",type=string,JSONPath=`.spec.taskMode`,description="The mode of the task"
+// +kubebuilder:printcolumn:name="Stage",type=string,JSONPath=`.status.stage`,description="The stage of the task"
+// +kubebuilder:printcolumn:name="Lag",type=string,JSONPath=`.status.lag`,description="The replication lag of the task"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type DMTask struct {
+	metav1.TypeMeta `json:",inline"`
+	// +k8s:openapi-gen=false
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec DMTaskSpec `json:"spec"`
+
+	// +k8s:openapi-gen=false
+	Status DMTaskStatus `json:"status,omitempty"`
+}
+
+// DMTaskList is DMTask list
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type DMTaskList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +k8s:openapi-gen=false
+	metav1.ListMeta `json:"metadata"`
+
+	Items []DMTask `json:"items"`
+}
+
+// DMTaskMode is the mode of the DM task
+// +kubebuilder:validation:Enum=full;incremental;all
+type DMTaskMode string
+
+const (
+	// DMTaskModeFull only migrates the full data
+	DMTaskModeFull DMTaskMode = "full"
+	// DMTaskModeIncremental only replicates the incremental data
+	DMTaskModeIncremental DMTaskMode = "incremental"
+	// DMTaskModeAll migrates the full data and then replicates the incremental data
+	DMTaskModeAll DMTaskMode = "all"
+)
+
+// DMTaskSpec describes the DM task
+//
+// +k8s:openapi-gen=true
+type DMTaskSpec struct {
+	// Cluster is the DMCluster which runs the task,
+	// the namespace defaults to the namespace of the task.
+	Cluster ClusterRef `json:"cluster"`
+
+	// TaskName is the name of the task in DM.
+	// Defaults to the name of the DMTask.
+	// +optional
+	TaskName string `json:"taskName,omitempty"`
+
+	// TaskMode is the mode of the task
+	// +kubebuilder:default=all
+	// +optional
+	TaskMode DMTaskMode `json:"taskMode,omitempty"`
+
+	// ShardMode is the mode to merge the sharded tables, it can be `pessimistic` or `optimistic`.
+	// Empty means the tables are not sharded.
+	// +kubebuilder:validation:Enum="";pessimistic;optimistic
+	// +optional
+	ShardMode string `json:"shardMode,omitempty"`
+
+	// MetaSchema is the downstream schema to store the metadata of the task
+	// +optional
+	MetaSchema string `json:"metaSchema,omitempty"`
+
+	// OnDuplicate is how to handle the conflict data when importing the full data,
+	// it can be `overwrite` or `error`.
+	// +kubebuilder:validation:Enum=overwrite;error
+	// +kubebuilder:default=overwrite
+	// +optional
+	OnDuplicate string `json:"onDuplicate,omitempty"`
+
+	// Target is the downstream database of the task
+	Target DMTaskTarget `json:"target"`
+
+	// Sources are the DM sources the task migrates data from
+	// +kubebuilder:validation:MinItems=1
+	Sources []DMTaskSource `json:"sources"`
+
+	// TableMigrateRules are the rules to route the upstream tables to the downstream
+	// +optional
+	TableMigrateRules []DMTableMigrateRule `json:"tableMigrateRules,omitempty"`
+
+	// Paused indicates whether the task is stopped
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// DMTaskTarget is the downstream database of the DM task
+//
+// +k8s:openapi-gen=true
+type DMTaskTarget struct {
+	// Host is the host of the downstream database
+	Host string `json:"host"`
+
+	// Port is the port of the downstream database
+	// +kubebuilder:validation:Minimum=1
+	Port int32 `json:"port"`
+
+	// SecretName is the name of the secret which stores the credentials of the downstream database,
+	// the secret must contain the `user` key and may contain the `password` key.
+	SecretName string `json:"secretName"`
+}
+
+// DMTaskSource is a DM source of the task and the position to start replicating binlogs from
+//
+// +k8s:openapi-gen=true
+type DMTaskSource struct {
+	// SourceName is the name of the source in DM
+	SourceName string `json:"sourceName"`
+
+	// BinlogName is the binlog file to start the incremental replication from
+	// +optional
+	BinlogName string `json:"binlogName,omitempty"`
+
+	// BinlogPos is the binlog position to start the incremental replication from
+	// +optional
+	BinlogPos *int64 `json:"binlogPos,omitempty"`
+
+	// BinlogGTID is the binlog GTID set to start the incremental replication from
+	// +optional
+	BinlogGTID string `json:"binlogGTID,omitempty"`
+}
+
+// DMTableMigrateRule routes the upstream tables to the downstream
+//
+// +k8s:openapi-gen=true
+type DMTableMigrateRule struct {
+	// Source matches the upstream tables
+	Source DMTableMigrateSource `json:"source"`
+
+	// Target is the downstream table, the upstream table name is kept if it is not set
+	// +optional
+	Target *DMTableMigrateTarget `json:"target,omitempty"`
+}
+
+// DMTableMigrateSource matches the upstream tables, the wildcards `*` and `?` are supported
+//
+// +k8s:openapi-gen=true
+type DMTableMigrateSource struct {
+	// SourceName is the name of the source in DM
+	SourceName string `json:"sourceName"`
+	// Schema is the pattern of the upstream schema
+	Schema string `json:"schema"`
+	// Table is the pattern of the upstream table
+	// +optional
+	Table string `json:"table,omitempty"`
+}
+
+// DMTableMigrateTarget is the downstream table
+//
+// +k8s:openapi-gen=true
+type DMTableMigrateTarget struct {
+	// +optional
+	Schema string `json:"schema,omitempty"`
+	// +optional
+	Table string `json:"table,omitempty"`
+}
+
+// DMTaskStage is the stage of the DM task
+type DMTaskStage string
+
+const (
+	DMTaskStageNew      DMTaskStage = "New"
+	DMTaskStageRunning  DMTaskStage = "Running"
+	DMTaskStagePaused   DMTaskStage = "Paused"
+	DMTaskStageStopped  DMTaskStage = "Stopped"
+	DMTaskStageFinished DMTaskStage = "Finished"
+)
+
+// DMTaskStatus is the status of the DM task
+type DMTaskStatus struct {
+	// ObservedGeneration is the generation of the spec applied to DM
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Stage is the stage of the task, it is the stage of the subtasks if they are in the same stage,
+	// or the stage of the subtask which needs attention.
+	// +optional
+	Stage DMTaskStage `json:"stage,omitempty"`
+	// Lag is the max replication lag of the subtasks
+	// +optional
+	Lag string `json:"lag,omitempty"`
+	// SubTasks are the status of the subtasks on each source
+	// +optional
+	SubTasks []DMSubTaskStatus `json:"subTasks,omitempty"`
+	// Errors are the unresolved errors of the task reported by DM or the operator
+	// +optional
+	Errors []string `json:"errors,omitempty"`
+	// +optional
+	// +nullable
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// DMSubTaskStatus is the status of the subtask on a source
+type DMSubTaskStatus struct {
+	SourceName string      `json:"sourceName"`
+	WorkerName string      `json:"workerName,omitempty"`
+	Stage      DMTaskStage `json:"stage,omitempty"`
+	// Unit is the processing unit of the subtask, e.g. `Dump`, `Load` and `Sync`
+	Unit string `json:"unit,omitempty"`
+	// SecondsBehindMaster is the replication lag of the subtask in the `Sync` unit
+	SecondsBehindMaster int64 `json:"secondsBehindMaster,omitempty"`
+	// UnresolvedDDLLockID is the ID of the shard DDL lock the subtask is waiting for
+	UnresolvedDDLLockID string `json:"unresolvedDDLLockID,omitempty"`
+}
+
+// GetTaskName returns the name of the task in DM
+func (t *DMTask) GetTaskName() string {
+	if t.Spec.TaskName != "" {
+		return t.Spec.TaskName
+	}
+	return t.Name
+}
+
+// GetClusterNamespace returns the namespace of the DMCluster running the task
+func (t *DMTask) GetClusterNamespace() string {
+	if t.Spec.Cluster.Namespace != "" {
+		return t.Spec.Cluster.Namespace
+	}
+	return t.Namespace
+}
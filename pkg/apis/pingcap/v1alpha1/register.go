@@ -73,6 +73,20 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&TidbNGMonitoringList{},
 		&TidbDashboard{},
 		&TidbDashboardList{},
+		&TiCDCChangefeed{},
+		&TiCDCChangefeedList{},
+		&DMSource{},
+		&DMSourceList{},
+		&DMTask{},
+		&DMTaskList{},
+		&TidbUser{},
+		&TidbUserList{},
+		&TidbPlacementPolicy{},
+		&TidbPlacementPolicyList{},
+		&TidbResourceGroup{},
+		&TidbResourceGroupList{},
+		&BackupRepository{},
+		&BackupRepositoryList{},
 	)
 
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
@@ -49,6 +49,19 @@ func (rs *Restore) GetRestorePVCName() string {
 	return fmt.Sprintf("restore-pvc-%s", rs.GetTidbEndpointHash())
 }
 
+// IsLightningLocalBackend returns whether the restore imports data by the local backend of TiDB Lightning
+func (rs *Restore) IsLightningLocalBackend() bool {
+	return rs.Spec.BR == nil && rs.Spec.Lightning != nil && rs.Spec.Lightning.Backend == LightningBackendLocal
+}
+
+// GetLightningClusterNamespace return the namespace of the tidb cluster imported by TiDB Lightning
+func (rs *Restore) GetLightningClusterNamespace() string {
+	if rs.Spec.Lightning != nil && rs.Spec.Lightning.ClusterNamespace != "" {
+		return rs.Spec.Lightning.ClusterNamespace
+	}
+	return rs.GetNamespace()
+}
+
 // GetRestoreCondition get the specify type's RestoreCondition from the given RestoreStatus
 func GetRestoreCondition(status *RestoreStatus, conditionType RestoreConditionType) (int, *RestoreCondition) {
 	if status == nil {
@@ -0,0 +1,164 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TiCDCChangefeed is a changefeed replicated by the TiCDC captures of a TidbCluster.
+//
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName="cf"
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Cluster",type=string,JSONPath=`.spec.cluster.name`,description="The TidbCluster running the changefeed"
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`,description="The state of the changefeed"
+// +kubebuilder:printcolumn:name="Checkpoint",type=string,JSONPath=`.status.checkpointTime`,description="The checkpoint time of the changefeed"
+// +kubebuilder:printcolumn:name="Lag",type=string,JSONPath=`.status.lag`,description="The replication lag of the changefeed"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type TiCDCChangefeed struct {
+	metav1.TypeMeta `json:",inline"`
+	// +k8s:openapi-gen=false
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec TiCDCChangefeedSpec `json:"spec"`
+
+	// +k8s:openapi-gen=false
+	Status TiCDCChangefeedStatus `json:"status,omitempty"`
+}
+
+// TiCDCChangefeedList is TiCDCChangefeed list
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TiCDCChangefeedList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +k8s:openapi-gen=false
+	metav1.ListMeta `json:"metadata"`
+
+	Items []TiCDCChangefeed `json:"items"`
+}
+
+// TiCDCChangefeedSpec describes the changefeed
+//
+// +k8s:openapi-gen=true
+type TiCDCChangefeedSpec struct {
+	// Cluster is the TidbCluster which runs the TiCDC captures,
+	// the namespace defaults to the namespace of the changefeed.
+	Cluster TidbClusterRef `json:"cluster"`
+
+	// ChangefeedID is the ID of the changefeed in TiCDC.
+	// Defaults to the name of the TiCDCChangefeed.
+	// +optional
+	ChangefeedID string `json:"changefeedID,omitempty"`
+
+	// SinkURI is the URI of the downstream, without the user and password.
+	SinkURI string `json:"sinkURI"`
+
+	// SinkSecretName is the name of the secret which stores the credentials of the downstream,
+	// the `user` and `password` keys are set as the user info of the sink URI.
+	// +optional
+	SinkSecretName string `json:"sinkSecretName,omitempty"`
+
+	// StartTs is the TSO the changefeed starts replicating from.
+	// Defaults to the current TSO. It only takes effect when the changefeed is created.
+	// +optional
+	StartTs uint64 `json:"startTs,omitempty"`
+
+	// TargetTs is the TSO the changefeed stops replicating at.
+	// Defaults to 0, which means the changefeed never stops.
+	// +optional
+	TargetTs uint64 `json:"targetTs,omitempty"`
+
+	// Filter is the filter rules of the changefeed
+	// +optional
+	Filter *TiCDCChangefeedFilter `json:"filter,omitempty"`
+
+	// Paused indicates whether the changefeed is paused
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// TiCDCChangefeedFilter is the filter rules of a changefeed
+//
+// +k8s:openapi-gen=true
+type TiCDCChangefeedFilter struct {
+	// Rules are the table filter rules, e.g. `test.*`, `!test.tbl`
+	// +optional
+	Rules []string `json:"rules,omitempty"`
+
+	// IgnoreTxnStartTs are the start ts of the transactions which are not replicated
+	// +optional
+	IgnoreTxnStartTs []uint64 `json:"ignoreTxnStartTs,omitempty"`
+}
+
+// TiCDCChangefeedState is the state of the changefeed in TiCDC
+type TiCDCChangefeedState string
+
+const (
+	TiCDCChangefeedStateNormal   TiCDCChangefeedState = "normal"
+	TiCDCChangefeedStateStopped  TiCDCChangefeedState = "stopped"
+	TiCDCChangefeedStateWarning  TiCDCChangefeedState = "warning"
+	TiCDCChangefeedStateError    TiCDCChangefeedState = "error"
+	TiCDCChangefeedStateFailed   TiCDCChangefeedState = "failed"
+	TiCDCChangefeedStateFinished TiCDCChangefeedState = "finished"
+)
+
+// TiCDCChangefeedStatus is the status of the changefeed
+type TiCDCChangefeedStatus struct {
+	// ObservedGeneration is the generation of the spec applied to the changefeed
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// SinkSecretHash is the hash of the sink credentials applied to the changefeed,
+	// the changefeed is updated when the credentials in the sink secret change
+	// +optional
+	SinkSecretHash string `json:"sinkSecretHash,omitempty"`
+	// State is the state of the changefeed in TiCDC
+	// +optional
+	State TiCDCChangefeedState `json:"state,omitempty"`
+	// CheckpointTs is the TSO all the data before which is replicated
+	// +optional
+	CheckpointTs uint64 `json:"checkpointTs,omitempty"`
+	// CheckpointTime is the physical time of the checkpoint ts
+	// +optional
+	// +nullable
+	CheckpointTime *metav1.Time `json:"checkpointTime,omitempty"`
+	// Lag is the duration between the checkpoint time and the time the status is updated
+	// +optional
+	Lag string `json:"lag,omitempty"`
+	// Error is the last error of the changefeed reported by TiCDC or the operator
+	// +optional
+	Error string `json:"error,omitempty"`
+	// +optional
+	// +nullable
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// GetChangefeedID returns the ID of the changefeed in TiCDC
+func (cf *TiCDCChangefeed) GetChangefeedID() string {
+	if cf.Spec.ChangefeedID != "" {
+		return cf.Spec.ChangefeedID
+	}
+	return cf.Name
+}
+
+// GetClusterNamespace returns the namespace of the TidbCluster running the changefeed
+func (cf *TiCDCChangefeed) GetClusterNamespace() string {
+	if cf.Spec.Cluster.Namespace != "" {
+		return cf.Spec.Cluster.Namespace
+	}
+	return cf.Namespace
+}
@@ -22,6 +22,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 
@@ -47,6 +48,9 @@ const (
 	defaultTiCDCGracefulShutdownTimeout = 10 * time.Minute
 	defaultPDStartTimeout               = 30
 	defaultPDInitWaitTime               = 0
+	// defaultTLSCertificateDuration and defaultTLSRenewBefore are used by the BuiltIn TLS issuer
+	defaultTLSCertificateDuration = 365 * 24 * time.Hour
+	defaultTLSRenewBefore         = 30 * 24 * time.Hour
 
 	// the latest version
 	versionLatest = "latest"
@@ -337,6 +341,22 @@ func (tc *TidbCluster) TiCDCGracefulShutdownTimeout() time.Duration {
 	return defaultTiCDCGracefulShutdownTimeout
 }
 
+// TiDBGracefulWaitBeforeShutdown returns the seconds TiDB keeps serving after it receives the shutdown signal,
+// during which TiDB fails the health check of TiProxy and TiProxy migrates the sessions away.
+func (tc *TidbCluster) TiDBGracefulWaitBeforeShutdown() int64 {
+	if tc.Spec.TiDB != nil && tc.Spec.TiDB.Config != nil {
+		if v := tc.Spec.TiDB.Config.Get("graceful-wait-before-shutdown"); v != nil {
+			wait, err := v.AsInt()
+			if err != nil {
+				klog.Warningf("'%s/%s' incorrect graceful-wait-before-shutdown type %v", tc.Namespace, tc.Name, v.Interface())
+			} else {
+				return wait
+			}
+		}
+	}
+	return 0
+}
+
 // TiDBImage return the image used by TiDB.
 //
 // If TiDB isn't specified, return empty string.
@@ -1034,6 +1054,49 @@ func (tc *TidbCluster) IsTLSClusterEnabled() bool {
 	return tc.Spec.TLSCluster != nil && tc.Spec.TLSCluster.Enabled
 }
 
+// IsPodDisruptionBudgetEnabled returns whether the PodDisruptionBudgets of the components should be created
+func (tc *TidbCluster) IsPodDisruptionBudgetEnabled() bool {
+	return tc.Spec.PodDisruptionBudget != nil && tc.Spec.PodDisruptionBudget.Enabled
+}
+
+// PodDisruptionBudgetMaxUnavailable returns the max number of unavailable pods of each component
+func (tc *TidbCluster) PodDisruptionBudgetMaxUnavailable() intstr.IntOrString {
+	if tc.Spec.PodDisruptionBudget != nil && tc.Spec.PodDisruptionBudget.MaxUnavailable != nil {
+		return *tc.Spec.PodDisruptionBudget.MaxUnavailable
+	}
+	return intstr.FromInt(1)
+}
+
+// IsTLSBuiltInIssuer returns whether the cluster certificates are issued by the operator
+func (tc *TidbCluster) IsTLSBuiltInIssuer() bool {
+	return tc.IsTLSClusterEnabled() && tc.Spec.TLSCluster.Issuer == TLSIssuerBuiltIn
+}
+
+// TLSCertificateDuration returns the validity of the certificates issued by the BuiltIn issuer
+func (tc *TidbCluster) TLSCertificateDuration() time.Duration {
+	if tc.Spec.TLSCluster != nil && tc.Spec.TLSCluster.CertificateDuration != nil {
+		return tc.Spec.TLSCluster.CertificateDuration.Duration
+	}
+	return defaultTLSCertificateDuration
+}
+
+// TLSRenewBefore returns how long before the expiry the certificates issued by the BuiltIn issuer are renewed
+func (tc *TidbCluster) TLSRenewBefore() time.Duration {
+	if tc.Spec.TLSCluster != nil && tc.Spec.TLSCluster.RenewBefore != nil {
+		return tc.Spec.TLSCluster.RenewBefore.Duration
+	}
+	return defaultTLSRenewBefore
+}
+
+// TLSCertHash returns the hash of the certificate issued by the BuiltIn issuer for the component,
+// empty string is returned if the certificates are not issued by the operator
+func (tc *TidbCluster) TLSCertHash(component string) string {
+	if !tc.IsTLSBuiltInIssuer() || tc.Status.TLSCluster == nil {
+		return ""
+	}
+	return tc.Status.TLSCluster.Certificates[component].Hash
+}
+
 func (tc *TidbCluster) IsRecoveryMode() bool {
 	return tc.Spec.RecoveryMode
 }
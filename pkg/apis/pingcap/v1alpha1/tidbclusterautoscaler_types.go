@@ -61,6 +61,14 @@ type TidbClusterAutoScalerSpec struct {
 	// TidbClusterRef describe the target TidbCluster
 	Cluster TidbClusterRef `json:"cluster"`
 
+	// Mode is the auto-scaling mode, Auto or Recommend.
+	// In the Recommend mode, the auto-scaler only records the recommendations in the status
+	// and emits events, the TidbClusters are never created, updated or deleted.
+	// If not set, the default Mode will be set to Auto
+	// +kubebuilder:validation:Enum:="";"Auto";"Recommend"
+	// +optional
+	Mode AutoScalerMode `json:"mode,omitempty"`
+
 	// TiKV represents the auto-scaling spec for tikv
 	// +optional
 	TiKV *TikvAutoScalerSpec `json:"tikv,omitempty"`
@@ -68,8 +76,74 @@ type TidbClusterAutoScalerSpec struct {
 	// TiDB represents the auto-scaling spec for tidb
 	// +optional
 	TiDB *TidbAutoScalerSpec `json:"tidb,omitempty"`
+
+	// Schedules defines the time windows in which the replicas of tidb/tikv are bounded,
+	// the bounds are combined with the auto-scaling results of the rules
+	// +optional
+	Schedules []AutoScalerSchedule `json:"schedules,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+// AutoScalerSchedule describes the replica bounds of the components in a recurring time window
+type AutoScalerSchedule struct {
+	// Name is the unique name of the schedule
+	Name string `json:"name"`
+
+	// Schedule is the cron format string of the start time of the window
+	Schedule string `json:"schedule"`
+
+	// Duration is the length of the window, e.g. 8h
+	Duration string `json:"duration"`
+
+	// TimeZone is the IANA time zone name used to interpret Schedule.
+	// If not set, the time zone of the controller manager will be used
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// TiDB represents the replica bounds of tidb in the window
+	// +optional
+	TiDB *ScheduledReplicas `json:"tidb,omitempty"`
+
+	// TiKV represents the replica bounds of tikv in the window
+	// +optional
+	TiKV *ScheduledReplicas `json:"tikv,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+// ScheduledReplicas describes the replica bounds of a component, including the replicas of
+// the target TidbCluster and all the auto-scaled TidbClusters
+type ScheduledReplicas struct {
+	// MinReplicas is the lower limit for the number of replicas in the window
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the upper limit for the number of replicas in the window
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
 }
 
+// AutoScalerMode is the auto-scaling mode of TidbClusterAutoScaler
+type AutoScalerMode string
+
+const (
+	// AutoScalerModeAuto means the auto-scaler scales the components
+	AutoScalerModeAuto AutoScalerMode = "Auto"
+	// AutoScalerModeRecommend means the auto-scaler only records the recommendations
+	AutoScalerModeRecommend AutoScalerMode = "Recommend"
+)
+
+// AutoScalerAction is the action recommended by the auto-scaler
+type AutoScalerAction string
+
+const (
+	// AutoScalerActionCreate means creating an auto-scaled TidbCluster
+	AutoScalerActionCreate AutoScalerAction = "Create"
+	// AutoScalerActionUpdate means updating the replicas of an auto-scaled TidbCluster
+	AutoScalerActionUpdate AutoScalerAction = "Update"
+	// AutoScalerActionDelete means deleting an auto-scaled TidbCluster
+	AutoScalerActionDelete AutoScalerAction = "Delete"
+)
+
 // +k8s:openapi-gen=true
 // AutoResource describes the resource type definitions
 type AutoResource struct {
@@ -104,6 +178,52 @@ type TikvAutoScalerSpec struct {
 // TidbAutoScalerSpec describes the spec for tidb auto-scaling
 type TidbAutoScalerSpec struct {
 	BasicAutoScalerSpec `json:",inline"`
+
+	// Metrics makes the auto-scaler controller able to scale TiDB according to
+	// the SQL connection count and QPS of the TiDB instances
+	// +optional
+	Metrics *TidbMetricsConfig `json:"metrics,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+// TidbMetricsConfig describes the rules for auto-scaling TiDB by its load metrics
+type TidbMetricsConfig struct {
+	// MinReplicas is the lower limit for the number of TiDB instances, including the instances of the target TidbCluster.
+	// If not set, the default MinReplicas will be set to the TiDB replicas of the target TidbCluster
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the upper limit for the number of TiDB instances, including the instances of the target TidbCluster
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// Connections defines the rule based on the SQL connection count of each TiDB instance
+	// +optional
+	Connections *TidbMetricRule `json:"connections,omitempty"`
+
+	// QPS defines the rule based on the queries per second of each TiDB instance
+	// +optional
+	QPS *TidbMetricRule `json:"qps,omitempty"`
+
+	// Monitor refers to the TidbMonitor whose Prometheus is queried for the metrics.
+	// If not set, the metrics are scraped from the status port of each TiDB instance
+	// +optional
+	Monitor *TidbMonitorRef `json:"monitor,omitempty"`
+
+	// QPSWindow is the time window used to calculate QPS from Prometheus
+	// If not set, the default QPSWindow will be set to 1m
+	// +optional
+	QPSWindow string `json:"qpsWindow,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+// TidbMetricRule describes the thresholds of a TiDB load metric
+type TidbMetricRule struct {
+	// MaxThreshold defines the average value per TiDB instance above which TiDB is scaled out
+	MaxThreshold int64 `json:"maxThreshold"`
+	// MinThreshold defines the average value per TiDB instance below which TiDB is scaled in
+	// If not set, the default MinThreshold will be set to half of MaxThreshold
+	// +optional
+	MinThreshold *int64 `json:"minThreshold,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -169,12 +289,89 @@ type TidbClusterAutoScalerStatus struct {
 	// Tidb describes the status of each group for the tidb in the last auto-scaling reconciliation
 	// +optional
 	TiDB map[string]TidbAutoScalerStatus `json:"tidb,omitempty"`
+	// ActiveSchedules describes the schedules whose windows are active in the last auto-scaling reconciliation
+	// +optional
+	ActiveSchedules []ActiveScheduleStatus `json:"activeSchedules,omitempty"`
+	// Recommendations describes the actions recommended in the last auto-scaling reconciliation in the Recommend mode
+	// +optional
+	Recommendations []AutoScalerRecommendation `json:"recommendations,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+// AutoScalerRecommendation describes an action recommended by the auto-scaler
+type AutoScalerRecommendation struct {
+	// Component is the component to scale, tidb or tikv
+	Component MemberType `json:"component"`
+	// Group is the auto-scaling group of the action
+	Group string `json:"group"`
+	// Action is the recommended action on the auto-scaled TidbCluster
+	Action AutoScalerAction `json:"action"`
+	// Cluster is the name of the auto-scaled TidbCluster
+	Cluster string `json:"cluster"`
+	// CurrentReplicas is the current replicas of the auto-scaled TidbCluster
+	CurrentReplicas int32 `json:"currentReplicas"`
+	// RecommendedReplicas is the recommended replicas of the auto-scaled TidbCluster
+	RecommendedReplicas int32 `json:"recommendedReplicas"`
+	// ResourceType is the resource type of the auto-scaled TidbCluster to create
+	// +optional
+	ResourceType string `json:"resourceType,omitempty"`
+	// Resources is the resources of the auto-scaled TidbCluster to create
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// Reason is the reason of the recommendation
+	Reason string `json:"reason"`
+}
+
+// +k8s:openapi-gen=true
+// ActiveScheduleStatus describes an active schedule window
+type ActiveScheduleStatus struct {
+	// Name is the name of the schedule
+	Name string `json:"name"`
+	// StartTime is the start time of the active window
+	StartTime metav1.Time `json:"startTime"`
+	// EndTime is the end time of the active window
+	EndTime metav1.Time `json:"endTime"`
 }
 
 // +k8s:openapi-gen=true
 // TidbAutoScalerStatus describe the auto-scaling status of tidb
 type TidbAutoScalerStatus struct {
 	BasicAutoScalerStatus `json:",inline"`
+
+	// MinReplicas is the lower limit for the number of TiDB instances used in the last reconciliation
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas is the upper limit for the number of TiDB instances used in the last reconciliation
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+	// CurrentReplicas is the number of TiDB instances observed in the last reconciliation
+	// +optional
+	CurrentReplicas *int32 `json:"currentReplicas,omitempty"`
+	// RecommendedReplicas is the number of TiDB instances recommended by the metrics rules
+	// +optional
+	RecommendedReplicas *int32 `json:"recommendedReplicas,omitempty"`
+	// Metrics describes the observed value of each metrics rule
+	// +optional
+	Metrics []TidbMetricStatus `json:"metrics,omitempty"`
+	// NextScaleOutTimestamp is the earliest time for the next auto-scaling-out
+	// +optional
+	NextScaleOutTimestamp *metav1.Time `json:"nextScaleOutTimestamp,omitempty"`
+	// NextScaleInTimestamp is the earliest time for the next auto-scaling-in
+	// +optional
+	NextScaleInTimestamp *metav1.Time `json:"nextScaleInTimestamp,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+// TidbMetricStatus describes the observed value of a TiDB load metric
+type TidbMetricStatus struct {
+	// Name is the name of the metric, connections or qps
+	Name string `json:"name"`
+	// CurrentValue is the total value of the metric over all TiDB instances
+	CurrentValue string `json:"currentValue"`
+	// AverageValue is the average value of the metric per TiDB instance
+	AverageValue string `json:"averageValue"`
+	// RecommendedReplicas is the number of TiDB instances recommended by this metric
+	RecommendedReplicas int32 `json:"recommendedReplicas"`
 }
 
 // +k8s:openapi-gen=true
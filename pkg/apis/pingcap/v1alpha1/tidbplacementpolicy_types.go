@@ -0,0 +1,284 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TidbPlacementPolicy declares the PD placement rule groups and the TiDB placement policies of a TidbCluster.
+// The rule groups are applied through the PD placement rule API and the policies through SQL,
+// the drift between the declared and the actual rules is reported in the status.
+//
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName="tpp"
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Cluster",type=string,JSONPath=`.spec.cluster.name`,description="The TidbCluster the placement is applied to"
+// +kubebuilder:printcolumn:name="Drifted",type=boolean,JSONPath=`.status.drifted`,description="Whether the actual rules drift from the declared rules"
+// +kubebuilder:printcolumn:name="Error",type=string,JSONPath=`.status.error`,description="The last error when syncing the placement",priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type TidbPlacementPolicy struct {
+	metav1.TypeMeta `json:",inline"`
+	// +k8s:openapi-gen=false
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec TidbPlacementPolicySpec `json:"spec"`
+
+	// +k8s:openapi-gen=false
+	Status TidbPlacementPolicyStatus `json:"status,omitempty"`
+}
+
+// TidbPlacementPolicyList is TidbPlacementPolicy list
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TidbPlacementPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +k8s:openapi-gen=false
+	metav1.ListMeta `json:"metadata"`
+
+	Items []TidbPlacementPolicy `json:"items"`
+}
+
+// TidbPlacementPolicySpec describes the placement rule groups and placement policies
+//
+// +k8s:openapi-gen=true
+type TidbPlacementPolicySpec struct {
+	// Cluster is the TidbCluster the placement is applied to,
+	// the namespace defaults to the namespace of the TidbPlacementPolicy.
+	Cluster TidbClusterRef `json:"cluster"`
+
+	// RuleGroups are the PD placement rule groups, all the rules of a group
+	// are replaced by the declared rules.
+	// +optional
+	RuleGroups []PlacementRuleGroup `json:"ruleGroups,omitempty"`
+
+	// Policies are the TiDB placement policies created by `CREATE PLACEMENT POLICY`
+	// +optional
+	Policies []PlacementPolicy `json:"policies,omitempty"`
+
+	// AdminSecretName is the name of the secret which stores the `user` and `password`
+	// of the account creating the placement policies. Required if policies are declared.
+	// +optional
+	AdminSecretName string `json:"adminSecretName,omitempty"`
+
+	// TLSClientSecretName is the name of secret which stores tidb server client certificate
+	// used to connect to TiDB when the TLS client is enabled.
+	// Defaults to <cluster>-tidb-client-secret.
+	// +optional
+	TLSClientSecretName *string `json:"tlsClientSecretName,omitempty"`
+
+	// CorrectDrift indicates whether the rule groups drifting from the declared rules are applied again.
+	// Defaults to false, which means the drift is only reported in the status.
+	// +optional
+	CorrectDrift bool `json:"correctDrift,omitempty"`
+}
+
+// PlacementRuleGroup is a PD placement rule group with its rules
+//
+// +k8s:openapi-gen=true
+type PlacementRuleGroup struct {
+	// ID is the ID of the rule group
+	ID string `json:"id"`
+
+	// Index is the order the rule group is applied in, the larger one is applied later
+	// +optional
+	Index int `json:"index,omitempty"`
+
+	// Override indicates whether the rules of the groups with smaller indexes are overridden
+	// +optional
+	Override bool `json:"override,omitempty"`
+
+	// Rules are the placement rules of the group
+	// +optional
+	Rules []PlacementRule `json:"rules,omitempty"`
+}
+
+// PlacementRuleRole is the role of the replicas placed by a placement rule
+// +k8s:openapi-gen=true
+// +kubebuilder:validation:Enum=voter;leader;follower;learner
+type PlacementRuleRole string
+
+const (
+	PlacementRuleRoleVoter    PlacementRuleRole = "voter"
+	PlacementRuleRoleLeader   PlacementRuleRole = "leader"
+	PlacementRuleRoleFollower PlacementRuleRole = "follower"
+	PlacementRuleRoleLearner  PlacementRuleRole = "learner"
+)
+
+// PlacementRule is a PD placement rule which decides where the replicas of a key range are placed
+//
+// +k8s:openapi-gen=true
+type PlacementRule struct {
+	// ID is the ID of the rule in the group
+	ID string `json:"id"`
+
+	// Index is the order the rule is applied in the group, the larger one is applied later
+	// +optional
+	Index int `json:"index,omitempty"`
+
+	// Override indicates whether the rules with smaller indexes in the group are overridden
+	// +optional
+	Override bool `json:"override,omitempty"`
+
+	// StartKeyHex is the hex encoded start key of the key range, empty means the first key
+	// +optional
+	StartKeyHex string `json:"startKeyHex,omitempty"`
+
+	// EndKeyHex is the hex encoded end key of the key range, empty means the last key
+	// +optional
+	EndKeyHex string `json:"endKeyHex,omitempty"`
+
+	// Role is the role of the replicas
+	Role PlacementRuleRole `json:"role"`
+
+	// Count is the number of the replicas
+	// +kubebuilder:validation:Minimum=1
+	Count int `json:"count"`
+
+	// LabelConstraints are used to filter the stores the replicas are placed on
+	// +optional
+	LabelConstraints []PlacementLabelConstraint `json:"labelConstraints,omitempty"`
+
+	// LocationLabels are the label keys used to isolate the replicas
+	// +optional
+	LocationLabels []string `json:"locationLabels,omitempty"`
+
+	// IsolationLevel is the location label the replicas must be isolated at least
+	// +optional
+	IsolationLevel string `json:"isolationLevel,omitempty"`
+}
+
+// PlacementLabelConstraint filters the stores by the store labels
+//
+// +k8s:openapi-gen=true
+type PlacementLabelConstraint struct {
+	// Key is the key of the store label
+	Key string `json:"key"`
+
+	// Op is the operator of the constraint
+	// +kubebuilder:validation:Enum=in;notIn;exists;notExists
+	Op string `json:"op"`
+
+	// Values are the values of the store label
+	// +optional
+	Values []string `json:"values,omitempty"`
+}
+
+// PlacementPolicy is a TiDB placement policy, see
+// https://docs.pingcap.com/tidb/stable/placement-rules-in-sql for the meaning of the options
+//
+// +k8s:openapi-gen=true
+type PlacementPolicy struct {
+	// Name is the name of the placement policy
+	Name string `json:"name"`
+
+	// PrimaryRegion is the region the leaders are placed in
+	// +optional
+	PrimaryRegion string `json:"primaryRegion,omitempty"`
+
+	// Regions are the regions the replicas are placed in, separated by commas
+	// +optional
+	Regions string `json:"regions,omitempty"`
+
+	// Schedule is the strategy to schedule the followers, `EVEN` or `MAJORITY_IN_PRIMARY`
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// Followers is the number of the followers
+	// +optional
+	Followers *int32 `json:"followers,omitempty"`
+
+	// Voters is the number of the voters
+	// +optional
+	Voters *int32 `json:"voters,omitempty"`
+
+	// Learners is the number of the learners
+	// +optional
+	Learners *int32 `json:"learners,omitempty"`
+
+	// Constraints are the constraints applied to all the replicas, e.g. `[+disk=ssd]`
+	// +optional
+	Constraints string `json:"constraints,omitempty"`
+
+	// LeaderConstraints are the constraints applied to the leaders
+	// +optional
+	LeaderConstraints string `json:"leaderConstraints,omitempty"`
+
+	// FollowerConstraints are the constraints applied to the followers
+	// +optional
+	FollowerConstraints string `json:"followerConstraints,omitempty"`
+
+	// VoterConstraints are the constraints applied to the voters
+	// +optional
+	VoterConstraints string `json:"voterConstraints,omitempty"`
+
+	// LearnerConstraints are the constraints applied to the learners
+	// +optional
+	LearnerConstraints string `json:"learnerConstraints,omitempty"`
+
+	// SurvivalPreferences are the location labels the replicas survive the failure of, e.g. `[region, zone]`
+	// +optional
+	SurvivalPreferences string `json:"survivalPreferences,omitempty"`
+}
+
+// TidbPlacementPolicyStatus is the status of the placement rule groups and placement policies
+type TidbPlacementPolicyStatus struct {
+	// ObservedGeneration is the generation of the spec applied to the cluster
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// RuleGroups are the IDs of the rule groups applied by the operator
+	// +optional
+	RuleGroups []string `json:"ruleGroups,omitempty"`
+	// Policies are the names of the placement policies created by the operator
+	// +optional
+	Policies []string `json:"policies,omitempty"`
+	// Drifted indicates whether the actual rules drift from the declared rules
+	// +optional
+	Drifted bool `json:"drifted,omitempty"`
+	// Drift describes the differences between the declared and the actual rules
+	// +optional
+	Drift []string `json:"drift,omitempty"`
+	// Error is the last error when syncing the placement
+	// +optional
+	Error string `json:"error,omitempty"`
+	// +optional
+	// +nullable
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
+// GetClusterNamespace returns the namespace of the TidbCluster the placement is applied to
+func (p *TidbPlacementPolicy) GetClusterNamespace() string {
+	if p.Spec.Cluster.Namespace != "" {
+		return p.Spec.Cluster.Namespace
+	}
+	return p.Namespace
+}
+
+// GetClusterName returns the name of the TidbCluster the placement is applied to
+func (p *TidbPlacementPolicy) GetClusterName() string {
+	return p.Spec.Cluster.Name
+}
+
+// GetAdminSecretName returns the name of the secret of the account which manages the placement policies
+func (p *TidbPlacementPolicy) GetAdminSecretName() string {
+	return p.Spec.AdminSecretName
+}
+
+// GetTLSClientSecretName returns the name of the secret of the TiDB client certificate
+func (p *TidbPlacementPolicy) GetTLSClientSecretName() *string {
+	return p.Spec.TLSClientSecretName
+}
@@ -0,0 +1,218 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TidbResourceGroup is a resource group of a TidbCluster used to isolate the resources of the SQL users.
+// The resource group and the users bound to it are kept in sync with the spec, the drift between
+// the declared and the actual settings and the RU consumption are reported in the status.
+//
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName="trg"
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Cluster",type=string,JSONPath=`.spec.cluster.name`,description="The TidbCluster of the resource group"
+// +kubebuilder:printcolumn:name="RU",type=integer,JSONPath=`.spec.ruPerSec`,description="The RU per second of the resource group"
+// +kubebuilder:printcolumn:name="Consumption",type=string,JSONPath=`.status.ruConsumption`,description="The RU consumed in the last statistics period"
+// +kubebuilder:printcolumn:name="Drifted",type=boolean,JSONPath=`.status.drifted`,description="Whether the actual settings drift from the declared settings"
+// +kubebuilder:printcolumn:name="Error",type=string,JSONPath=`.status.error`,description="The last error when syncing the resource group",priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type TidbResourceGroup struct {
+	metav1.TypeMeta `json:",inline"`
+	// +k8s:openapi-gen=false
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec TidbResourceGroupSpec `json:"spec"`
+
+	// +k8s:openapi-gen=false
+	Status TidbResourceGroupStatus `json:"status,omitempty"`
+}
+
+// TidbResourceGroupList is TidbResourceGroup list
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TidbResourceGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +k8s:openapi-gen=false
+	metav1.ListMeta `json:"metadata"`
+
+	Items []TidbResourceGroup `json:"items"`
+}
+
+// TidbResourceGroupSpec describes the resource group
+//
+// +k8s:openapi-gen=true
+type TidbResourceGroupSpec struct {
+	// Cluster is the TidbCluster of the resource group,
+	// the namespace defaults to the namespace of the TidbResourceGroup.
+	Cluster TidbClusterRef `json:"cluster"`
+
+	// GroupName is the name of the resource group.
+	// Defaults to the name of the TidbResourceGroup.
+	// +optional
+	GroupName string `json:"groupName,omitempty"`
+
+	// RUPerSec is the request units per second of the resource group
+	// +kubebuilder:validation:Minimum=1
+	RUPerSec int64 `json:"ruPerSec"`
+
+	// Priority is the priority of the resource group.
+	// Defaults to MEDIUM.
+	// +kubebuilder:validation:Enum=LOW;MEDIUM;HIGH
+	// +optional
+	Priority string `json:"priority,omitempty"`
+
+	// Burstable indicates whether the resource group can use the idle resources over RUPerSec
+	// +optional
+	Burstable bool `json:"burstable,omitempty"`
+
+	// QueryLimit is the limit of the runaway queries in the resource group
+	// +optional
+	QueryLimit *TidbResourceGroupQueryLimit `json:"queryLimit,omitempty"`
+
+	// Users are the SQL users bound to the resource group.
+	// The users removed from the list are bound to the `default` resource group.
+	// +optional
+	Users []TidbResourceGroupUser `json:"users,omitempty"`
+
+	// AdminSecretName is the name of the secret which stores the `user` and `password`
+	// of the account managing the resource group
+	AdminSecretName string `json:"adminSecretName"`
+
+	// TLSClientSecretName is the name of secret which stores tidb server client certificate
+	// used to connect to TiDB when the TLS client is enabled.
+	// Defaults to <cluster>-tidb-client-secret.
+	// +optional
+	TLSClientSecretName *string `json:"tlsClientSecretName,omitempty"`
+
+	// CorrectDrift indicates whether the resource group drifting from the declared settings is altered again.
+	// Defaults to false, which means the drift is only reported in the status.
+	// +optional
+	CorrectDrift bool `json:"correctDrift,omitempty"`
+}
+
+// TidbResourceGroupQueryLimit is the limit of the runaway queries, see
+// https://docs.pingcap.com/tidb/stable/tidb-resource-control#manage-queries-that-consume-more-resources-than-expected-runaway-queries
+//
+// +k8s:openapi-gen=true
+type TidbResourceGroupQueryLimit struct {
+	// ExecElapsed is the execution time after which a query is identified as a runaway query, e.g. `60s`
+	ExecElapsed string `json:"execElapsed"`
+
+	// Action is the action taken on the runaway queries
+	// +kubebuilder:validation:Enum=DRYRUN;COOLDOWN;KILL
+	Action string `json:"action"`
+
+	// Watch is used to identify the runaway queries quickly after a runaway query is found
+	// +optional
+	Watch *TidbResourceGroupQueryWatch `json:"watch,omitempty"`
+}
+
+// TidbResourceGroupQueryWatch is used to identify the runaway queries quickly
+//
+// +k8s:openapi-gen=true
+type TidbResourceGroupQueryWatch struct {
+	// Type is the way to match the queries
+	// +kubebuilder:validation:Enum=EXACT;SIMILAR;PLAN
+	Type string `json:"type"`
+
+	// Duration is the duration of the watch, e.g. `10m`. Empty means forever.
+	// +optional
+	Duration string `json:"duration,omitempty"`
+}
+
+// TidbResourceGroupUser is a SQL user bound to the resource group
+//
+// +k8s:openapi-gen=true
+type TidbResourceGroupUser struct {
+	// Name is the name of the user
+	Name string `json:"name"`
+
+	// Host is the host of the user.
+	// Defaults to `%`.
+	// +optional
+	Host string `json:"host,omitempty"`
+}
+
+// TidbResourceGroupStatus is the status of the resource group
+type TidbResourceGroupStatus struct {
+	// ObservedGeneration is the generation of the spec applied to the resource group
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Users are the users bound to the resource group by the operator
+	// +optional
+	Users []TidbResourceGroupUser `json:"users,omitempty"`
+	// Drifted indicates whether the actual settings drift from the declared settings
+	// +optional
+	Drifted bool `json:"drifted,omitempty"`
+	// Drift describes the differences between the declared and the actual settings
+	// +optional
+	Drift []string `json:"drift,omitempty"`
+	// RUConsumption is the RU consumed by the resource group in the last statistics period
+	// +optional
+	RUConsumption string `json:"ruConsumption,omitempty"`
+	// RUConsumptionPeriodEnd is the end time of the statistics period of RUConsumption
+	// +optional
+	RUConsumptionPeriodEnd string `json:"ruConsumptionPeriodEnd,omitempty"`
+	// Error is the last error when syncing the resource group
+	// +optional
+	Error string `json:"error,omitempty"`
+	// +optional
+	// +nullable
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
+// GetGroupName returns the name of the resource group
+func (rg *TidbResourceGroup) GetGroupName() string {
+	if rg.Spec.GroupName != "" {
+		return rg.Spec.GroupName
+	}
+	return rg.Name
+}
+
+// GetClusterNamespace returns the namespace of the TidbCluster of the resource group
+func (rg *TidbResourceGroup) GetClusterNamespace() string {
+	if rg.Spec.Cluster.Namespace != "" {
+		return rg.Spec.Cluster.Namespace
+	}
+	return rg.Namespace
+}
+
+// GetClusterName returns the name of the TidbCluster of the resource group
+func (rg *TidbResourceGroup) GetClusterName() string {
+	return rg.Spec.Cluster.Name
+}
+
+// GetAdminSecretName returns the name of the secret of the account which manages the resource group
+func (rg *TidbResourceGroup) GetAdminSecretName() string {
+	return rg.Spec.AdminSecretName
+}
+
+// GetTLSClientSecretName returns the name of the secret of the TiDB client certificate
+func (rg *TidbResourceGroup) GetTLSClientSecretName() *string {
+	return rg.Spec.TLSClientSecretName
+}
+
+// GetHost returns the host of the user
+func (u TidbResourceGroupUser) GetHost() string {
+	if u.Host != "" {
+		return u.Host
+	}
+	return "%"
+}
@@ -0,0 +1,186 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TidbUser is a SQL user of a TidbCluster, the user, its roles and privileges are kept in sync
+// with the spec and the user is dropped when the TidbUser is deleted.
+//
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName="tu"
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Cluster",type=string,JSONPath=`.spec.cluster.name`,description="The TidbCluster of the user"
+// +kubebuilder:printcolumn:name="User",type=string,JSONPath=`.spec.userName`,description="The name of the user"
+// +kubebuilder:printcolumn:name="Error",type=string,JSONPath=`.status.error`,description="The last error when syncing the user",priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type TidbUser struct {
+	metav1.TypeMeta `json:",inline"`
+	// +k8s:openapi-gen=false
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec TidbUserSpec `json:"spec"`
+
+	// +k8s:openapi-gen=false
+	Status TidbUserStatus `json:"status,omitempty"`
+}
+
+// TidbUserList is TidbUser list
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TidbUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +k8s:openapi-gen=false
+	metav1.ListMeta `json:"metadata"`
+
+	Items []TidbUser `json:"items"`
+}
+
+// TidbUserSpec describes the SQL user
+//
+// +k8s:openapi-gen=true
+type TidbUserSpec struct {
+	// Cluster is the TidbCluster of the user,
+	// the namespace defaults to the namespace of the TidbUser.
+	Cluster TidbClusterRef `json:"cluster"`
+
+	// UserName is the name of the user.
+	// Defaults to the name of the TidbUser.
+	// +optional
+	UserName string `json:"userName,omitempty"`
+
+	// Host is the host the user connects from.
+	// Defaults to `%`.
+	// +optional
+	Host string `json:"host,omitempty"`
+
+	// PasswordSecretName is the name of the secret which stores the password of the user in the `password` key,
+	// the password is rotated when the secret is changed.
+	PasswordSecretName string `json:"passwordSecretName"`
+
+	// AdminSecretName is the name of the secret which stores the `user` and `password` of the account
+	// used by the operator to manage the user, the account must have the privileges to create users
+	// and grant the privileges and roles.
+	AdminSecretName string `json:"adminSecretName"`
+
+	// TLSClientSecretName is the name of secret which stores tidb server client certificate,
+	// it is used if the TLS client is enabled for TiDB.
+	// Optional: Defaults to `<cluster>-tidb-client-secret`
+	// +optional
+	TLSClientSecretName *string `json:"tlsClientSecretName,omitempty"`
+
+	// Roles are the roles granted to the user, they are set as the default roles of the user
+	// +optional
+	Roles []string `json:"roles,omitempty"`
+
+	// Grants are the privileges granted to the user
+	// +optional
+	Grants []TidbUserGrant `json:"grants,omitempty"`
+
+	// ResourceGroup is the resource group bound to the user
+	// +optional
+	ResourceGroup string `json:"resourceGroup,omitempty"`
+}
+
+// TidbUserGrant is the privileges granted on a database or table
+//
+// +k8s:openapi-gen=true
+type TidbUserGrant struct {
+	// Privileges are the privileges granted, e.g. `SELECT`, `INSERT` or `ALL PRIVILEGES`
+	// +kubebuilder:validation:MinItems=1
+	Privileges []string `json:"privileges"`
+
+	// Database is the database the privileges are granted on.
+	// Defaults to `*`, which means all databases.
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// Table is the table the privileges are granted on.
+	// Defaults to `*`, which means all tables.
+	// +optional
+	Table string `json:"table,omitempty"`
+
+	// WithGrantOption indicates whether the user can grant the privileges to others
+	// +optional
+	WithGrantOption bool `json:"withGrantOption,omitempty"`
+}
+
+// TidbUserStatus is the status of the SQL user
+type TidbUserStatus struct {
+	// ObservedGeneration is the generation of the spec applied to the user
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// PasswordSecretVersion is the resource version of the password secret applied to the user
+	// +optional
+	PasswordSecretVersion string `json:"passwordSecretVersion,omitempty"`
+	// Roles are the roles granted to the user by the operator
+	// +optional
+	Roles []string `json:"roles,omitempty"`
+	// Grants are the privileges granted to the user by the operator
+	// +optional
+	Grants []TidbUserGrant `json:"grants,omitempty"`
+	// ResourceGroup is the resource group bound to the user by the operator
+	// +optional
+	ResourceGroup string `json:"resourceGroup,omitempty"`
+	// Error is the last error when syncing the user
+	// +optional
+	Error string `json:"error,omitempty"`
+	// +optional
+	// +nullable
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
+// GetUserName returns the name of the SQL user
+func (u *TidbUser) GetUserName() string {
+	if u.Spec.UserName != "" {
+		return u.Spec.UserName
+	}
+	return u.Name
+}
+
+// GetHost returns the host of the SQL user
+func (u *TidbUser) GetHost() string {
+	if u.Spec.Host != "" {
+		return u.Spec.Host
+	}
+	return "%"
+}
+
+// GetClusterNamespace returns the namespace of the TidbCluster of the user
+func (u *TidbUser) GetClusterNamespace() string {
+	if u.Spec.Cluster.Namespace != "" {
+		return u.Spec.Cluster.Namespace
+	}
+	return u.Namespace
+}
+
+// GetClusterName returns the name of the TidbCluster of the user
+func (u *TidbUser) GetClusterName() string {
+	return u.Spec.Cluster.Name
+}
+
+// GetAdminSecretName returns the name of the secret of the account which manages the user
+func (u *TidbUser) GetAdminSecretName() string {
+	return u.Spec.AdminSecretName
+}
+
+// GetTLSClientSecretName returns the name of the secret of the TiDB client certificate
+func (u *TidbUser) GetTLSClientSecretName() *string {
+	return u.Spec.TLSClientSecretName
+}
@@ -22,6 +22,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/pingcap/tidb-operator/pkg/apis/label"
 	"github.com/pingcap/tidb-operator/pkg/apis/util/config"
@@ -145,6 +146,11 @@ const (
 	// ConfigUpdateStrategyRollingUpdate generate different configmap on configuration update and
 	// try to rolling-update the pod controller (e.g. statefulset) to apply updates.
 	ConfigUpdateStrategyRollingUpdate ConfigUpdateStrategy = "RollingUpdate"
+	// ConfigUpdateStrategyOnline applies the changed config items that the component supports changing
+	// at runtime through its API and keeps the configmap name, so that the pods are not restarted.
+	// If any changed item can not be applied online, it falls back to RollingUpdate.
+	// Only PD, TiKV and TiDB support it, other components treat it as RollingUpdate.
+	ConfigUpdateStrategyOnline ConfigUpdateStrategy = "Online"
 )
 
 type StartScriptVersion string
@@ -296,6 +302,8 @@ type TidbClusterSpec struct {
 	// cluster component is needed to reload the configuration change.
 	// UpdateStrategyRollingUpdate will create a new ConfigMap with the new configuration and rolling-update the
 	// related components to use the new ConfigMap, that is, the new configuration will be applied automatically.
+	// UpdateStrategyOnline will apply the changed configuration items that can be changed at runtime through
+	// the component API without restarting, and behave as UpdateStrategyRollingUpdate for the other items.
 	ConfigUpdateStrategy ConfigUpdateStrategy `json:"configUpdateStrategy,omitempty"`
 
 	// Whether enable PVC reclaim for orphan PVC left by statefulset scale-in
@@ -427,6 +435,25 @@ type TidbClusterSpec struct {
 	// - WaitForDnsNameIpMatch indicates whether PD and TiKV has to wait until local IP address matches the one published to external DNS
 	// - PreferPDAddressesOverDiscovery advises start script to use TidbClusterSpec.PDAddresses (if supplied) as argument for pd-server, tikv-server and tidb-server commands
 	StartScriptV2FeatureFlags []StartScriptV2FeatureFlag `json:"startScriptV2FeatureFlags,omitempty"`
+
+	// PodDisruptionBudget configures the PodDisruptionBudgets of PD, TiKV, TiDB, TiFlash and TiCDC
+	// created by the operator, so that voluntary disruptions such as node drains can't evict too many
+	// pods of a component at the same time.
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+}
+
+// PodDisruptionBudgetSpec is the spec of the PodDisruptionBudgets of the components
+type PodDisruptionBudgetSpec struct {
+	// Enabled indicates whether to create the PodDisruptionBudgets,
+	// the PodDisruptionBudgets are deleted once it's disabled
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// MaxUnavailable is the max number of unavailable pods of each component
+	// Optional: Defaults to 1
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
 }
 
 // TidbClusterStatus represents the current status of a tidb cluster.
@@ -445,6 +472,50 @@ type TidbClusterStatus struct {
 	// +optional
 	// +nullable
 	Conditions []TidbClusterCondition `json:"conditions,omitempty"`
+	// ConfigDrift is the result of the last runtime config drift detection
+	// +optional
+	ConfigDrift *ConfigDriftStatus `json:"configDrift,omitempty"`
+	// TLSCluster is the status of the certificates issued by the BuiltIn issuer
+	// +optional
+	TLSCluster *TLSClusterStatus `json:"tlsCluster,omitempty"`
+}
+
+// TLSClusterStatus is the status of the CA and the certificates issued by the BuiltIn issuer
+type TLSClusterStatus struct {
+	// CANotAfter is the expiry time of the current CA
+	// +nullable
+	CANotAfter metav1.Time `json:"caNotAfter,omitempty"`
+	// Certificates are the issued certificates, the key is the component name or "client"
+	// +optional
+	Certificates map[string]TLSCertificateStatus `json:"certificates,omitempty"`
+}
+
+// TLSCertificateStatus is the status of a certificate issued by the BuiltIn issuer
+type TLSCertificateStatus struct {
+	SecretName string `json:"secretName"`
+	// +nullable
+	NotAfter metav1.Time `json:"notAfter,omitempty"`
+	// Hash is the hash of the certificate, it's added to the pod annotations to roll the pods after renewal
+	// +optional
+	Hash string `json:"hash,omitempty"`
+}
+
+// ConfigDriftStatus is the result of comparing the running config of the pods with the rendered config
+type ConfigDriftStatus struct {
+	// Pods are the pods whose running config differs from the rendered config
+	// +optional
+	Pods []PodConfigDrift `json:"pods,omitempty"`
+	// LastCheckTime is the time of the last detection
+	// +nullable
+	LastCheckTime metav1.Time `json:"lastCheckTime,omitempty"`
+}
+
+// PodConfigDrift is the config items of a pod whose running value differs from the rendered config
+type PodConfigDrift struct {
+	Component MemberType `json:"component"`
+	PodName   string     `json:"podName"`
+	// Keys are the dotted names of the drifted config items
+	Keys []string `json:"keys"`
 }
 
 // TidbClusterCondition describes the state of a tidb cluster at a certain point.
@@ -480,6 +551,9 @@ const (
 	// - All TiKV stores are up.
 	// - All TiFlash stores are up.
 	TidbClusterReady TidbClusterConditionType = "Ready"
+	// TidbClusterConfigDrift indicates that the running config of some pods differs from the rendered config,
+	// e.g. changed by `SET CONFIG` or the pod was not restarted after the config change.
+	TidbClusterConfigDrift TidbClusterConditionType = "ConfigDrift"
 )
 
 // The `Type` of the component condition
@@ -787,6 +861,10 @@ type TiKVSpec struct {
 	// +kubebuilder:validation:Minimum=0
 	// +optional
 	SpareVolReplaceReplicas *int32 `json:"spareVolReplaceReplicas,omitempty"`
+
+	// UpgradeStrategy defines how the TiKV pods are upgraded in stages.
+	// +optional
+	UpgradeStrategy *UpgradeStrategy `json:"upgradeStrategy,omitempty"`
 }
 
 // TiFlashSpec contains details of TiFlash members
@@ -847,6 +925,31 @@ type TiFlashSpec struct {
 	// ScalePolicy is the scale configuration for TiFlash
 	// +optional
 	ScalePolicy ScalePolicy `json:"scalePolicy,omitempty"`
+
+	// TableReplicas are the TiFlash replicas of the tables kept in sync by the operator,
+	// the replicas of the tables removed from the list are kept as they are.
+	// TiFlash can not be scaled in to fewer stores than the replicas of the tables.
+	// +optional
+	TableReplicas []TiFlashTableReplica `json:"tableReplicas,omitempty"`
+
+	// TableReplicaSecretName is the name of the secret which stores the `user` and `password` of the account
+	// setting the TiFlash replicas of the tables, required if tableReplicas is set.
+	// +optional
+	TableReplicaSecretName string `json:"tableReplicaSecretName,omitempty"`
+}
+
+// TiFlashTableReplica is the desired TiFlash replicas of a table
+// +k8s:openapi-gen=true
+type TiFlashTableReplica struct {
+	// Database is the database of the table
+	Database string `json:"database"`
+
+	// Table is the name of the table
+	Table string `json:"table"`
+
+	// Replicas is the number of the TiFlash replicas, 0 removes the TiFlash replicas of the table
+	// +kubebuilder:validation:Minimum=0
+	Replicas int32 `json:"replicas"`
 }
 
 // TiCDCSpec contains details of TiCDC members
@@ -1102,6 +1205,10 @@ type TiDBSpec struct {
 	// Arguments is the extra command line arguments for TiDB server.
 	// +optional
 	Arguments []string `json:"arguments,omitempty"`
+
+	// UpgradeStrategy defines how the TiDB pods are upgraded in stages.
+	// +optional
+	UpgradeStrategy *UpgradeStrategy `json:"upgradeStrategy,omitempty"`
 }
 
 type CustomizedProbe struct {
@@ -1498,6 +1605,63 @@ type SuspendAction struct {
 	SuspendStatefulSet bool `json:"suspendStatefulSet,omitempty"`
 }
 
+// UpgradePromotionPolicy is the way to continue an upgrade after the canary pods are upgraded
+type UpgradePromotionPolicy string
+
+const (
+	// UpgradePromotionManual continues the upgrade after the promote annotation is set
+	UpgradePromotionManual UpgradePromotionPolicy = "Manual"
+	// UpgradePromotionAuto continues the upgrade after the cluster passes the health check
+	UpgradePromotionAuto UpgradePromotionPolicy = "Auto"
+)
+
+// UpgradeStrategy defines how the pods of a component are upgraded in stages.
+//
+// +k8s:openapi-gen=true
+type UpgradeStrategy struct {
+	// CanaryReplicas is the number of pods upgraded first when the component is upgraded.
+	// The upgrade pauses after the canary pods are upgraded until it is promoted.
+	// Defaults to 0, which means the upgrade is not paused.
+	// +optional
+	CanaryReplicas int32 `json:"canaryReplicas,omitempty"`
+
+	// Promotion is the way to continue the upgrade after the canary pods are upgraded.
+	// Manual: the upgrade continues after the TidbCluster annotation
+	// `tidb.pingcap.com/promote-upgrade-<component>` is set to the revision in the upgrade stage status.
+	// Auto: the upgrade continues after there are no down stores in PD and the region leaders are
+	// balanced between the TiKV stores.
+	// Defaults to Manual
+	// +kubebuilder:validation:Enum=Manual;Auto
+	// +optional
+	Promotion UpgradePromotionPolicy `json:"promotion,omitempty"`
+}
+
+// UpgradeStage is the stage of a staged upgrade
+type UpgradeStage string
+
+const (
+	// UpgradeStageCanary means the canary pods are being upgraded
+	UpgradeStageCanary UpgradeStage = "Canary"
+	// UpgradeStagePaused means the canary pods are upgraded and the upgrade waits for the promotion
+	UpgradeStagePaused UpgradeStage = "Paused"
+	// UpgradeStagePromoted means the upgrade is promoted and the rest pods are being upgraded
+	UpgradeStagePromoted UpgradeStage = "Promoted"
+)
+
+// UpgradeStageStatus is the status of a staged upgrade
+type UpgradeStageStatus struct {
+	Stage UpgradeStage `json:"stage,omitempty"`
+	// Revision is the update revision of the StatefulSet in this upgrade
+	Revision string `json:"revision,omitempty"`
+	// CanaryReplicas is the number of the canary pods
+	CanaryReplicas int32 `json:"canaryReplicas,omitempty"`
+	// Message is the reason why the upgrade is paused
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +nullable
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
 // PDStatus is PD status
 type PDStatus struct {
 	// +optional
@@ -1520,6 +1684,25 @@ type PDStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 	// Indicates that a Volume replace using VolumeReplacing feature is in progress.
 	VolReplaceInProgress bool `json:"volReplaceInProgress,omitempty"`
+	// OnlineConfig is the status of the last config change applied online
+	// +optional
+	OnlineConfig *OnlineConfigStatus `json:"onlineConfig,omitempty"`
+}
+
+// OnlineConfigStatus is the status of the config change applied by the Online config update strategy
+type OnlineConfigStatus struct {
+	// AppliedKeys are the config items applied online without restarting the pods
+	// +optional
+	AppliedKeys []string `json:"appliedKeys,omitempty"`
+	// RestartKeys are the config items that can not be applied online and cause a rolling update
+	// +optional
+	RestartKeys []string `json:"restartKeys,omitempty"`
+	// Error is the error occurred when applying the config online
+	// +optional
+	Error string `json:"error,omitempty"`
+	// LastAppliedTime is the time when the config change was applied
+	// +nullable
+	LastAppliedTime metav1.Time `json:"lastAppliedTime,omitempty"`
 }
 
 // PDMSStatus is PD microservice status
@@ -1596,6 +1779,12 @@ type TiDBStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 	// Indicates that a Volume replace using VolumeReplacing feature is in progress.
 	VolReplaceInProgress bool `json:"volReplaceInProgress,omitempty"`
+	// UpgradeStage is the stage of the upgrade when the upgrade strategy is set
+	// +optional
+	UpgradeStage *UpgradeStageStatus `json:"upgradeStage,omitempty"`
+	// OnlineConfig is the status of the last config change applied online
+	// +optional
+	OnlineConfig *OnlineConfigStatus `json:"onlineConfig,omitempty"`
 }
 
 // TiDBMember is TiDB member
@@ -1607,6 +1796,35 @@ type TiDBMember struct {
 	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
 	// Node hosting pod of this TiDB member.
 	NodeName string `json:"node,omitempty"`
+	// Drain is the progress of draining the connections of this member through TiProxy during upgrade.
+	// TiProxy migrates the sessions away from the member during the graceful wait before shutdown of TiDB,
+	// so it's only drained if `graceful-wait-before-shutdown` is set in the config of TiDB.
+	// +optional
+	Drain *TiDBDrainStatus `json:"drain,omitempty"`
+}
+
+// TiDBDrainPhase is the phase of draining the connections of a TiDB member
+type TiDBDrainPhase string
+
+const (
+	// TiDBDrainPhaseDraining means the member is upgraded and TiProxy is migrating the sessions away
+	// from it during the graceful wait before shutdown
+	TiDBDrainPhaseDraining TiDBDrainPhase = "Draining"
+	// TiDBDrainPhaseDrained means the member is shut down and started with the update revision
+	TiDBDrainPhaseDrained TiDBDrainPhase = "Drained"
+)
+
+// TiDBDrainStatus is the progress of draining the connections of a TiDB member
+type TiDBDrainStatus struct {
+	Phase TiDBDrainPhase `json:"phase,omitempty"`
+	// Revision is the update revision of the StatefulSet the member is drained for
+	Revision string `json:"revision,omitempty"`
+	// StartTime is the time the drain started
+	// +nullable
+	StartTime metav1.Time `json:"startTime,omitempty"`
+	// LastTransitionTime is the time the phase transitioned last time
+	// +nullable
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
 }
 
 // TiDBFailureMember is the tidb failure member information
@@ -1704,6 +1922,12 @@ type TiKVStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 	// Indicates that a Volume replace using VolumeReplacing feature is in progress.
 	VolReplaceInProgress bool `json:"volReplaceInProgress,omitempty"`
+	// UpgradeStage is the stage of the upgrade when the upgrade strategy is set
+	// +optional
+	UpgradeStage *UpgradeStageStatus `json:"upgradeStage,omitempty"`
+	// OnlineConfig is the status of the last config change applied online
+	// +optional
+	OnlineConfig *OnlineConfigStatus `json:"onlineConfig,omitempty"`
 }
 
 // TiFlashStatus is TiFlash status
@@ -1725,6 +1949,22 @@ type TiFlashStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 	// Indicates that a Volume replace using VolumeReplacing feature is in progress.
 	VolReplaceInProgress bool `json:"volReplaceInProgress,omitempty"`
+	// TableReplicas are the replication status of the tables declared in spec.tiflash.tableReplicas
+	// +optional
+	TableReplicas []TiFlashTableReplicaStatus `json:"tableReplicas,omitempty"`
+}
+
+// TiFlashTableReplicaStatus is the replication status of the TiFlash replicas of a table
+type TiFlashTableReplicaStatus struct {
+	Database string `json:"database"`
+	Table    string `json:"table"`
+	// Replicas is the number of the TiFlash replicas set to the table
+	Replicas int32 `json:"replicas"`
+	// Available indicates whether the TiFlash replicas of the table can serve queries
+	Available bool `json:"available"`
+	// Progress is the replication progress of the TiFlash replicas, from 0 to 1
+	// +optional
+	Progress string `json:"progress,omitempty"`
 }
 
 // TiProxyMember is TiProxy member
@@ -1884,8 +2124,39 @@ type TLSCluster struct {
 	//        Same for other components.
 	// +optional
 	Enabled bool `json:"enabled,omitempty"`
+
+	// Issuer is the way to issue the certificates of the cluster components.
+	// External means the secrets above are created by the user,
+	// BuiltIn means the operator creates a CA and issues, renews the certificates by itself,
+	// the CA is stored in the secret <clusterName>-tls-ca.
+	// The BuiltIn issuer is only supported by TidbCluster.
+	// Optional: Defaults to External
+	// +kubebuilder:validation:Enum=External;BuiltIn
+	// +optional
+	Issuer TLSIssuer `json:"issuer,omitempty"`
+
+	// CertificateDuration is the validity of the certificates issued by the BuiltIn issuer.
+	// Optional: Defaults to 8760h (1 year)
+	// +optional
+	CertificateDuration *metav1.Duration `json:"certificateDuration,omitempty"`
+
+	// RenewBefore is how long before the expiry the certificates issued by the BuiltIn issuer are renewed,
+	// the pods are rolling updated to load the renewed certificates.
+	// Optional: Defaults to 720h (30 days)
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
 }
 
+// TLSIssuer is the issuer of the certificates of the cluster components
+type TLSIssuer string
+
+const (
+	// TLSIssuerExternal means the certificates are issued and provided by the user
+	TLSIssuerExternal TLSIssuer = "External"
+	// TLSIssuerBuiltIn means the certificates are issued and renewed by the operator
+	TLSIssuerBuiltIn TLSIssuer = "BuiltIn"
+)
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -1998,6 +2269,10 @@ type S3StorageProvider struct {
 	SSE string `json:"sse,omitempty"`
 	// Options Rclone options for backup and restore with dumpling and lightning.
 	Options []string `json:"options,omitempty"`
+	// ObjectLock is the retention applied to every object written by the backup, the bucket must be created
+	// with S3 Object Lock enabled and a default retention which retains the objects at write time.
+	// +optional
+	ObjectLock *ObjectLock `json:"objectLock,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -2023,6 +2298,10 @@ type GcsStorageProvider struct {
 	SecretName string `json:"secretName,omitempty"`
 	// Prefix of the data path.
 	Prefix string `json:"prefix,omitempty"`
+	// ObjectLock is the retention applied to every object written by the backup, the bucket must have
+	// a retention policy which retains the objects at write time, and the policy must be locked in Compliance mode.
+	// +optional
+	ObjectLock *ObjectLock `json:"objectLock,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -2046,6 +2325,36 @@ type AzblobStorageProvider struct {
 	SasToken string `json:"sasToken,omitempty"`
 	// Prefix of the data path.
 	Prefix string `json:"prefix,omitempty"`
+	// ObjectLock is the retention applied to every object written by the backup, the container must have
+	// version-level immutability enabled with a default policy which retains the objects at write time.
+	// +optional
+	ObjectLock *ObjectLock `json:"objectLock,omitempty"`
+}
+
+// ObjectLockMode represents the retention mode of the objects written by backup
+type ObjectLockMode string
+
+const (
+	// ObjectLockModeGovernance represents that the retention can be removed by the users with special permissions
+	ObjectLockModeGovernance ObjectLockMode = "Governance"
+	// ObjectLockModeCompliance represents that the retention can not be removed by anyone before the retain date
+	ObjectLockModeCompliance ObjectLockMode = "Compliance"
+)
+
+// ObjectLock represents the retention of the objects written by backup, the objects are retained by
+// the storage at write time and verified before the backup is complete, they can not be deleted or
+// overwritten before the retain date, even by the operator.
+// +k8s:openapi-gen=true
+type ObjectLock struct {
+	// Mode of the retention, the retention of the storage must be at least as strict as it, i.e. the mode of
+	// S3 Object Lock, the locked retention policy of gcs or the locked immutability policy of azblob.
+	// +kubebuilder:validation:Enum:=Governance;Compliance
+	// +kubebuilder:default=Compliance
+	// +optional
+	Mode ObjectLockMode `json:"mode,omitempty"`
+	// RetainDays is the number of days the objects are retained after they are written
+	// +kubebuilder:validation:Minimum=1
+	RetainDays int32 `json:"retainDays"`
 }
 
 // BackupType represents the backup type.
@@ -2227,6 +2536,17 @@ type BackupSpec struct {
 	// FederalVolumeBackupPhase indicates which phase to execute in federal volume backup
 	// +optional
 	FederalVolumeBackupPhase FederalVolumeBackupPhase `json:"federalVolumeBackupPhase,omitempty"`
+	// VolumeSnapshotCloud is the cloud provider of the TiKV volumes, aws is used if it is empty.
+	// It is only valid for mode of volume-snapshot, and ignored if volumeSnapshotClassName is set.
+	// +optional
+	// +kubebuilder:validation:Enum:="";"aws";"azure"
+	VolumeSnapshotCloud VolumeSnapshotCloud `json:"volumeSnapshotCloud,omitempty"`
+	// VolumeSnapshotClassName is the VolumeSnapshotClass used to take snapshots of TiKV volumes through the
+	// Kubernetes VolumeSnapshot API, which supports any CSI driver with the snapshot capability.
+	// The default VolumeSnapshotClass is used if it is empty. If it is not set, the snapshots are
+	// taken through the cloud provider API. It is only valid for mode of volume-snapshot.
+	// +optional
+	VolumeSnapshotClassName *string `json:"volumeSnapshotClassName,omitempty"`
 	// ResumeGcSchedule indicates whether resume gc and pd scheduler for EBS volume snapshot backup
 	// +optional
 	ResumeGcSchedule bool `json:"resumeGcSchedule,omitempty"`
@@ -2258,6 +2578,11 @@ type BackupSpec struct {
 	CleanPolicy CleanPolicyType `json:"cleanPolicy,omitempty"`
 	// CleanOption controls the behavior of clean.
 	CleanOption *CleanOption `json:"cleanOption,omitempty"`
+	// Replicas are the secondary storages which the backup data is copied to after the backup completes,
+	// e.g. buckets in other regions for disaster recovery. CleanPolicy also applies to every replica.
+	// Only snapshot backup with BR supports replicas now.
+	// +optional
+	Replicas []StorageProvider `json:"replicas,omitempty"`
 
 	// PodSecurityContext of the component
 	// +optional
@@ -2292,6 +2617,17 @@ const (
 	FederalVolumeBackupTeardown FederalVolumeBackupPhase = "teardown"
 )
 
+// VolumeSnapshotCloud is the cloud provider of the TiKV volumes in volume-snapshot backup and restore
+type VolumeSnapshotCloud string
+
+const (
+	// VolumeSnapshotCloudAWS means the volumes are AWS EBS volumes, the snapshots are taken and restored by BR
+	VolumeSnapshotCloudAWS VolumeSnapshotCloud = "aws"
+	// VolumeSnapshotCloudAzure means the volumes are Azure managed disks, the snapshots are taken and
+	// restored by the backup and restore jobs through the Azure API
+	VolumeSnapshotCloudAzure VolumeSnapshotCloud = "azure"
+)
+
 // +k8s:openapi-gen=true
 // DumplingConfig contains config for dumpling
 type DumplingConfig struct {
@@ -2299,8 +2635,41 @@ type DumplingConfig struct {
 	Options []string `json:"options,omitempty"`
 	// Deprecated. Please use `Spec.TableFilter` instead. TableFilter means Table filter expression for 'db.table' matching
 	TableFilter []string `json:"tableFilter,omitempty"`
+	// OutputMode is how the output of dumpling is stored, such as archive or stream.
+	// The archive mode dumps data into the backup volume, archives it and uploads the archive to the storage.
+	// The stream mode writes the files to the storage directly, so no backup volume is required.
+	// +kubebuilder:validation:Enum=archive;stream
+	// +optional
+	OutputMode DumplingOutputMode `json:"outputMode,omitempty"`
+	// FileType is the type of the exported files, such as sql or csv, which can be imported by TiDB Lightning.
+	// +kubebuilder:validation:Enum=sql;csv
+	// +optional
+	FileType DumplingFileType `json:"fileType,omitempty"`
+	// FileSize is the size of the files each table is split into, such as 256MiB.
+	// +optional
+	FileSize string `json:"fileSize,omitempty"`
 }
 
+// DumplingOutputMode represents how the output of dumpling is stored
+type DumplingOutputMode string
+
+const (
+	// DumplingOutputModeArchive means dumping data into the backup volume and uploading the archive
+	DumplingOutputModeArchive DumplingOutputMode = "archive"
+	// DumplingOutputModeStream means writing the files to the storage directly
+	DumplingOutputModeStream DumplingOutputMode = "stream"
+)
+
+// DumplingFileType represents the type of the files exported by dumpling
+type DumplingFileType string
+
+const (
+	// DumplingFileTypeSQL means exporting data as SQL statements
+	DumplingFileTypeSQL DumplingFileType = "sql"
+	// DumplingFileTypeCSV means exporting data as CSV files
+	DumplingFileTypeCSV DumplingFileType = "csv"
+)
+
 // +k8s:openapi-gen=true
 // BRConfig contains config for BR
 type BRConfig struct {
@@ -2405,6 +2774,9 @@ const (
 	BackupStopped BackupConditionType = "Stopped"
 	// BackupRestart means the backup was restarted, now just support snapshot backup
 	BackupRestart BackupConditionType = "Restart"
+	// BackupVerified means the backup has been verified by restoring it into a scratch cluster,
+	// the status of the condition is False if the verification failed
+	BackupVerified BackupConditionType = "Verified"
 	// VolumeBackupInitialized means the volume backup has stopped GC and PD scheduler
 	VolumeBackupInitialized BackupConditionType = "VolumeBackupInitialized"
 	// VolumeBackupInitializeFailed means the volume backup initialize job failed
@@ -2509,6 +2881,44 @@ type BackupStatus struct {
 	Progresses []Progress `json:"progresses,omitempty"`
 	// BackoffRetryStatus is status of the backoff retry, it will be used when backup pod or job exited unexpectedly
 	BackoffRetryStatus []BackoffRetryRecord `json:"backoffRetryStatus,omitempty"`
+	// Replicas is the status of copying the backup data to each replica storage.
+	// +nullable
+	Replicas []BackupReplicaStatus `json:"replicas,omitempty"`
+	// RetainUntil is the date until which some objects of the backup are retained by the object lock,
+	// the backup data is cleaned again after the date.
+	// +nullable
+	RetainUntil *metav1.Time `json:"retainUntil,omitempty"`
+}
+
+// BackupReplicaPhase represents the phase of copying the backup data to a replica storage.
+type BackupReplicaPhase string
+
+const (
+	// BackupReplicaCopying means the backup data is being copied to the replica storage
+	BackupReplicaCopying BackupReplicaPhase = "Copying"
+	// BackupReplicaComplete means the backup data has been copied and verified
+	BackupReplicaComplete BackupReplicaPhase = "Complete"
+	// BackupReplicaFailed means the backup data failed to be copied or verified
+	BackupReplicaFailed BackupReplicaPhase = "Failed"
+)
+
+// BackupReplicaStatus is the status of a replica of the backup.
+type BackupReplicaStatus struct {
+	// Index is the index of the replica in spec.replicas.
+	Index int `json:"index"`
+	// Path is the location of the replica.
+	Path string `json:"path,omitempty"`
+	// Phase is the phase of copying the backup data to the replica.
+	Phase BackupReplicaPhase `json:"phase,omitempty"`
+	// ObjectCount is the number of objects copied to the replica.
+	ObjectCount int64 `json:"objectCount,omitempty"`
+	// Size is the total size of objects copied to the replica.
+	Size int64 `json:"size,omitempty"`
+	// TimeCompleted is the time at which the replica was verified.
+	// +nullable
+	TimeCompleted metav1.Time `json:"timeCompleted,omitempty"`
+	// Message is the detail of the failure.
+	Message string `json:"message,omitempty"`
 }
 
 // +genclient
@@ -2559,6 +2969,10 @@ type BackupScheduleSpec struct {
 	MaxBackups *int32 `json:"maxBackups,omitempty"`
 	// MaxReservedTime is to specify how long backups we want to keep.
 	MaxReservedTime *string `json:"maxReservedTime,omitempty"`
+	// RetentionPolicy is to specify the tiered retention of the snapshot backups.
+	// if RetentionPolicy is set, MaxBackups and MaxReservedTime are ignored.
+	// +optional
+	RetentionPolicy *BackupRetentionPolicy `json:"retentionPolicy,omitempty"`
 	// CompactSpan is to specify how long backups we want to compact.
 	CompactSpan *string `json:"compactSpan,omitempty"`
 	// BackupTemplate is the specification of the backup structure to get scheduled.
@@ -2584,6 +2998,123 @@ type BackupScheduleSpec struct {
 	// StorageProvider configures where and how backups should be stored.
 	// +optional
 	StorageProvider `json:",inline"`
+	// Replicas are the secondary storages which the scheduled snapshot backups are copied to,
+	// if no replicas are set in BackupTemplate.
+	// +optional
+	Replicas []StorageProvider `json:"replicas,omitempty"`
+	// Verify configures to verify the snapshot backups by restoring them into a short-lived TidbCluster.
+	// +optional
+	Verify *BackupVerifySpec `json:"verify,omitempty"`
+}
+
+// BackupRetentionPolicy is the grandfather-father-son retention policy of a BackupSchedule.
+// The completed snapshot backups are classified into daily, weekly, monthly and yearly buckets
+// by their commit ts in UTC, and the latest backup in each of the latest N buckets of a tier is kept.
+// The latest completed backup is always kept, and the failed backups are deleted once there is
+// a later completed backup.
+// If there is a log backup, it is truncated to the oldest backup kept by the finest tier,
+// so that any time after that backup can be restored by PiTR.
+//
+// +k8s:openapi-gen=true
+type BackupRetentionPolicy struct {
+	// Daily is the number of days to keep a daily backup for.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Daily int32 `json:"daily,omitempty"`
+	// Weekly is the number of ISO weeks to keep a weekly backup for.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Weekly int32 `json:"weekly,omitempty"`
+	// Monthly is the number of months to keep a monthly backup for.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Monthly int32 `json:"monthly,omitempty"`
+	// Yearly is the number of years to keep a yearly backup for.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Yearly int32 `json:"yearly,omitempty"`
+}
+
+// BackupVerifySpec describes how to verify the snapshot backups of a BackupSchedule.
+// A TidbCluster is created from ClusterTemplate, the latest completed backup is restored into it,
+// then the SQL assertions are run against it, and all of them are deleted after the verification.
+type BackupVerifySpec struct {
+	// Every is to verify one in every N completed backups.
+	// Defaults to 1, which means every backup is verified.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Every int32 `json:"every,omitempty"`
+	// ClusterTemplate is the spec of the short-lived TidbCluster which the backup is restored into.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:XPreserveUnknownFields
+	// +kubebuilder:validation:Type=object
+	ClusterTemplate *TidbClusterSpec `json:"clusterTemplate"`
+	// Timeout is the max duration of a verification, in the format of Go Duration.
+	// The verification is failed if it is not finished in time. Defaults to 2h.
+	// +optional
+	Timeout string `json:"timeout,omitempty"`
+	// Checksum specifies whether to run checksum after the backup is restored.
+	// Defaults to true.
+	// +optional
+	Checksum *bool `json:"checksum,omitempty"`
+	// ToolImage specifies the BR image used to restore the backup.
+	// Defaults to the ToolImage of the BackupTemplate.
+	// +optional
+	ToolImage string `json:"toolImage,omitempty"`
+	// SQLAssertions are the SQL statements run against the restored cluster.
+	// +optional
+	SQLAssertions []BackupVerifySQLAssertion `json:"sqlAssertions,omitempty"`
+	// SQLImage is the image with mysql client used to run the SQL assertions.
+	// Defaults to mysql:8.0.
+	// +optional
+	SQLImage string `json:"sqlImage,omitempty"`
+	// SecretName is the name of the secret which stores the `user` and `password` used to run the SQL assertions.
+	// Defaults to user root without password.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+	// TLSClientSecretName is the name of secret which stores tidb server client certificate
+	// used to run the SQL assertions when the TLS client is enabled in ClusterTemplate.
+	// Defaults to <cluster>-tidb-client-secret.
+	// +optional
+	TLSClientSecretName *string `json:"tlsClientSecretName,omitempty"`
+}
+
+// BackupVerifySQLAssertion is a SQL statement run against the restored cluster
+type BackupVerifySQLAssertion struct {
+	// Name is the name of the assertion
+	Name string `json:"name"`
+	// Query is the SQL statement to run
+	Query string `json:"query"`
+	// Expected is the expected output of the query in the batch mode of mysql client without column names.
+	// The query only needs to succeed if it is empty.
+	// +optional
+	Expected string `json:"expected,omitempty"`
+}
+
+// BackupVerifyPhase is the phase of a backup verification
+type BackupVerifyPhase string
+
+const (
+	// BackupVerifyRunning means the backup is being verified
+	BackupVerifyRunning BackupVerifyPhase = "Running"
+	// BackupVerifySucceeded means the backup has been verified successfully
+	BackupVerifySucceeded BackupVerifyPhase = "Succeeded"
+	// BackupVerifyFailed means the verification of the backup failed
+	BackupVerifyFailed BackupVerifyPhase = "Failed"
+)
+
+// BackupVerifyStatus represents the status of a backup verification
+type BackupVerifyStatus struct {
+	// Backup is the name of the backup being verified
+	Backup string `json:"backup"`
+	// Phase is the phase of the verification
+	Phase BackupVerifyPhase `json:"phase,omitempty"`
+	// StartTime is the time at which the verification was started
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time at which the verification was finished
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Message is the reason why the verification failed
+	Message string `json:"message,omitempty"`
 }
 
 // BackupScheduleStatus represents the current state of a BackupSchedule.
@@ -2604,6 +3135,12 @@ type BackupScheduleStatus struct {
 	NextCompactEndTs *metav1.Time `json:"nextCompactEndTs,omitempty"`
 	// AllBackupCleanTime represents the time when all backup entries are cleaned up
 	AllBackupCleanTime *metav1.Time `json:"allBackupCleanTime,omitempty"`
+	// Verification represents the running or the last finished backup verification.
+	// +optional
+	Verification *BackupVerifyStatus `json:"verification,omitempty"`
+	// LastVerifiedBackup represents the last backup verified successfully.
+	// +optional
+	LastVerifiedBackup string `json:"lastVerifiedBackup,omitempty"`
 }
 
 // +genclient
@@ -2734,13 +3271,29 @@ type RestoreSpec struct {
 	// LogRestoreStartTs is the start timestamp which log restore from.
 	// +optional
 	LogRestoreStartTs string `json:"logRestoreStartTs,omitempty"`
+	// BackupScheduleRef references a BackupSchedule in the same namespace to do pitr restore from.
+	// The full backup and the log backup covering PitrRestoredTs are resolved from the backups of
+	// the BackupSchedule, and the storage and pitrFullBackupStorageProvider are filled by the controller.
+	// It is only valid for mode of pitr.
+	// +optional
+	BackupScheduleRef *corev1.LocalObjectReference `json:"backupScheduleRef,omitempty"`
 	// FederalVolumeRestorePhase indicates which phase to execute in federal volume restore
 	// +optional
 	FederalVolumeRestorePhase FederalVolumeRestorePhase `json:"federalVolumeRestorePhase,omitempty"`
 	// VolumeAZ indicates which AZ the volume snapshots restore to.
-	// it is only valid for mode of volume-snapshot
+	// it is only valid for mode of volume-snapshot, on azure it is the zone label of the nodes, such as eastus2-1
 	// +optional
 	VolumeAZ string `json:"volumeAZ,omitempty"`
+	// VolumeSnapshotCloud is the cloud provider of the TiKV volumes, it should be the same as the backup.
+	// It is only valid for mode of volume-snapshot, and ignored if volumeSnapshotClassName is set.
+	// +optional
+	// +kubebuilder:validation:Enum:="";"aws";"azure"
+	VolumeSnapshotCloud VolumeSnapshotCloud `json:"volumeSnapshotCloud,omitempty"`
+	// VolumeSnapshotClassName is the VolumeSnapshotClass of the snapshots restored through the Kubernetes
+	// VolumeSnapshot API, it should be set if the backup is taken with volumeSnapshotClassName.
+	// It is only valid for mode of volume-snapshot.
+	// +optional
+	VolumeSnapshotClassName *string `json:"volumeSnapshotClassName,omitempty"`
 	// TikvGCLifeTime is to specify the safe gc life time for restore.
 	// The time limit during which data is retained for each GC, in the format of Go Duration.
 	// When a GC happens, the current time minus this value is the safe point.
@@ -2758,6 +3311,9 @@ type RestoreSpec struct {
 	StorageSize string `json:"storageSize,omitempty"`
 	// BR is the configs for BR.
 	BR *BRConfig `json:"br,omitempty"`
+	// Lightning is the configs for TiDB Lightning, it is only used when BR is not set.
+	// +optional
+	Lightning *LightningConfig `json:"lightning,omitempty"`
 	// Base tolerations of restore Pods, components may add more tolerations upon this respectively
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
@@ -2842,6 +3398,41 @@ const (
 	RestoreWarmupStrategyCheckOnly RestoreWarmupStrategy = "check-wal-only"
 )
 
+// LightningBackend represents the import backend of TiDB Lightning
+type LightningBackend string
+
+const (
+	// LightningBackendTiDB means importing data by executing SQL statements through TiDB
+	LightningBackendTiDB LightningBackend = "tidb"
+	// LightningBackendLocal means encoding data to sorted KV pairs locally and ingesting them into TiKV directly
+	LightningBackendLocal LightningBackend = "local"
+)
+
+// +k8s:openapi-gen=true
+// LightningConfig contains config for TiDB Lightning
+type LightningConfig struct {
+	// Backend is the import backend of TiDB Lightning, such as tidb or local.
+	// The local backend is the physical import mode, the sorted KV pairs are stored in
+	// the restore volume which is sized by StorageClassName and StorageSize.
+	// +kubebuilder:validation:Enum=tidb;local
+	// +kubebuilder:default=tidb
+	Backend LightningBackend `json:"backend,omitempty"`
+	// Cluster is the name of the tidb cluster to import, it is required by the local backend
+	// to connect to PD and TiKV.
+	// +optional
+	Cluster string `json:"cluster,omitempty"`
+	// ClusterNamespace is the namespace of the tidb cluster, defaults to the namespace of the restore.
+	// +optional
+	ClusterNamespace string `json:"clusterNamespace,omitempty"`
+	// DiskQuota is the maximum disk space used by the sorted KV pairs of the local backend, such as 100Gi.
+	// Defaults to the available space of the restore volume.
+	// +optional
+	DiskQuota string `json:"diskQuota,omitempty"`
+	// Options means options for TiDB Lightning
+	// +optional
+	Options []string `json:"options,omitempty"`
+}
+
 // RestoreStatus represents the current status of a tidb cluster restore.
 type RestoreStatus struct {
 	// TimeStarted is the time at which the restore was started.
@@ -2986,6 +3577,8 @@ type DMClusterSpec struct {
 	// cluster component is needed to reload the configuration change.
 	// UpdateStrategyRollingUpdate will create a new ConfigMap with the new configuration and rolling-update the
 	// related components to use the new ConfigMap, that is, the new configuration will be applied automatically.
+	// UpdateStrategyOnline will apply the changed configuration items that can be changed at runtime through
+	// the component API without restarting, and behave as UpdateStrategyRollingUpdate for the other items.
 	ConfigUpdateStrategy ConfigUpdateStrategy `json:"configUpdateStrategy,omitempty"`
 
 	// Whether enable PVC reclaim for orphan PVC left by statefulset scale-in
@@ -104,6 +104,64 @@ func ValidateTidbMonitor(monitor *v1alpha1.TidbMonitor) field.ErrorList {
 	return allErrs
 }
 
+// reservedPlacementRuleGroups are the placement rule groups managed by PD and TiDB, the rules
+// of them are overwritten by PD or TiDB and must not be declared in a TidbPlacementPolicy
+var reservedPlacementRuleGroups = []string{
+	// the default rule group of PD
+	"pd",
+	// the rule group of the TiFlash replicas set by `ALTER TABLE ... SET TIFLASH REPLICA`
+	"tiflash",
+}
+
+// tidbPlacementRuleGroupPrefix is the prefix of the rule groups created by the placement policies in SQL
+const tidbPlacementRuleGroupPrefix = "TiDB_DDL_"
+
+// ValidateTidbPlacementPolicy validates a TidbPlacementPolicy
+func ValidateTidbPlacementPolicy(policy *v1alpha1.TidbPlacementPolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+
+	groupIDs := map[string]struct{}{}
+	for i, group := range policy.Spec.RuleGroups {
+		idPath := specPath.Child("ruleGroups").Index(i).Child("id")
+		if group.ID == "" {
+			allErrs = append(allErrs, field.Required(idPath, "rule group id is required"))
+			continue
+		}
+		for _, reserved := range reservedPlacementRuleGroups {
+			if strings.EqualFold(group.ID, reserved) {
+				allErrs = append(allErrs, field.Invalid(idPath, group.ID, "rule group is managed by PD or TiDB"))
+			}
+		}
+		if strings.HasPrefix(strings.ToLower(group.ID), strings.ToLower(tidbPlacementRuleGroupPrefix)) {
+			allErrs = append(allErrs, field.Invalid(idPath, group.ID,
+				fmt.Sprintf("rule groups with the prefix %s are managed by the placement policies of TiDB", tidbPlacementRuleGroupPrefix)))
+		}
+		if _, ok := groupIDs[group.ID]; ok {
+			allErrs = append(allErrs, field.Duplicate(idPath, group.ID))
+		}
+		groupIDs[group.ID] = struct{}{}
+	}
+
+	policyNames := map[string]struct{}{}
+	for i, p := range policy.Spec.Policies {
+		namePath := specPath.Child("policies").Index(i).Child("name")
+		if p.Name == "" {
+			allErrs = append(allErrs, field.Required(namePath, "placement policy name is required"))
+			continue
+		}
+		if _, ok := policyNames[p.Name]; ok {
+			allErrs = append(allErrs, field.Duplicate(namePath, p.Name))
+		}
+		policyNames[p.Name] = struct{}{}
+	}
+	if len(policy.Spec.Policies) > 0 && policy.Spec.AdminSecretName == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("adminSecretName"), "adminSecretName is required to manage the placement policies"))
+	}
+
+	return allErrs
+}
+
 func validateAnnotations(anns map[string]string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, apivalidation.ValidateAnnotations(anns, fldPath)...)
@@ -156,6 +214,9 @@ func validateTiDBClusterSpec(spec *v1alpha1.TidbClusterSpec, fldPath *field.Path
 	if spec.StartScriptV2FeatureFlags != nil {
 		allErrs = append(allErrs, validateStartScriptFeatureFlags(spec.StartScriptV2FeatureFlags, fldPath.Child("startScriptV2FeatureFlags"))...)
 	}
+	if spec.TLSCluster != nil {
+		allErrs = append(allErrs, validateTLSCluster(spec.TLSCluster, fldPath.Child("tlsCluster"))...)
+	}
 	return allErrs
 }
 
@@ -204,6 +265,23 @@ func validatePDAddresses(arrayOfAddresses []string, fldPath *field.Path) field.E
 	return allErrs
 }
 
+func validateTLSCluster(tlsCluster *v1alpha1.TLSCluster, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if tlsCluster.Issuer != v1alpha1.TLSIssuerBuiltIn {
+		return allErrs
+	}
+	// use the defaults of the durations not set
+	tc := &v1alpha1.TidbCluster{Spec: v1alpha1.TidbClusterSpec{TLSCluster: tlsCluster}}
+	duration, renewBefore := tc.TLSCertificateDuration(), tc.TLSRenewBefore()
+	if duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("certificateDuration"), duration.String(), "must be positive"))
+	}
+	if renewBefore <= 0 || renewBefore >= duration {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("renewBefore"), renewBefore.String(), "must be positive and less than certificateDuration"))
+	}
+	return allErrs
+}
+
 func validateStartScriptFeatureFlags(featureFlags []v1alpha1.StartScriptV2FeatureFlag, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	for i, ff := range featureFlags {
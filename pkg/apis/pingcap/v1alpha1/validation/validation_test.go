@@ -16,6 +16,7 @@ package validation
 import (
 	"strings"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	"github.com/pingcap/tidb-operator/pkg/apis/label"
@@ -749,6 +750,49 @@ func TestValidateStartScriptFeatureFlags(t *testing.T) {
 	}
 }
 
+func TestValidateTLSCluster(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tests := []struct {
+		name        string
+		tlsCluster  v1alpha1.TLSCluster
+		expectedErr int
+	}{
+		{
+			name:       "external issuer",
+			tlsCluster: v1alpha1.TLSCluster{Enabled: true, RenewBefore: &metav1.Duration{Duration: -time.Hour}},
+		},
+		{
+			name:       "builtin issuer with defaults",
+			tlsCluster: v1alpha1.TLSCluster{Enabled: true, Issuer: v1alpha1.TLSIssuerBuiltIn},
+		},
+		{
+			name: "renewBefore is longer than certificateDuration",
+			tlsCluster: v1alpha1.TLSCluster{
+				Enabled:             true,
+				Issuer:              v1alpha1.TLSIssuerBuiltIn,
+				CertificateDuration: &metav1.Duration{Duration: 24 * time.Hour},
+			},
+			expectedErr: 1,
+		},
+		{
+			name: "negative durations",
+			tlsCluster: v1alpha1.TLSCluster{
+				Enabled:             true,
+				Issuer:              v1alpha1.TLSIssuerBuiltIn,
+				CertificateDuration: &metav1.Duration{Duration: -time.Hour},
+				RenewBefore:         &metav1.Duration{Duration: -time.Hour},
+			},
+			expectedErr: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateTLSCluster(&tt.tlsCluster, field.NewPath("spec", "tlsCluster"))
+			g.Expect(errs).To(HaveLen(tt.expectedErr))
+		})
+	}
+}
+
 func TestValidatePDSpec(t *testing.T) {
 	g := NewGomegaWithT(t)
 	tests := []struct {
@@ -852,6 +896,50 @@ func TestValidateTiFlashSpec(t *testing.T) {
 	}
 }
 
+func TestValidateTidbPlacementPolicy(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tests := []struct {
+		name           string
+		ruleGroups     []string
+		policies       []string
+		expectedErrors int
+	}{
+		{
+			name:       "valid",
+			ruleGroups: []string{"zone-a", "zone-b"},
+			policies:   []string{"multi_region"},
+		},
+		{
+			name:           "pd rule group",
+			ruleGroups:     []string{"pd"},
+			expectedErrors: 1,
+		},
+		{
+			name:           "tidb rule groups",
+			ruleGroups:     []string{"tiflash", "TiDB_DDL_100"},
+			expectedErrors: 2,
+		},
+		{
+			name:           "duplicated",
+			ruleGroups:     []string{"zone-a", "zone-a"},
+			policies:       []string{"multi_region", "multi_region"},
+			expectedErrors: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &v1alpha1.TidbPlacementPolicy{Spec: v1alpha1.TidbPlacementPolicySpec{AdminSecretName: "admin"}}
+			for _, id := range tt.ruleGroups {
+				policy.Spec.RuleGroups = append(policy.Spec.RuleGroups, v1alpha1.PlacementRuleGroup{ID: id})
+			}
+			for _, name := range tt.policies {
+				policy.Spec.Policies = append(policy.Spec.Policies, v1alpha1.PlacementPolicy{Name: name})
+			}
+			g.Expect(ValidateTidbPlacementPolicy(policy)).Should(HaveLen(tt.expectedErrors))
+		})
+	}
+}
+
 func Test_disallowMutateBootstrapSQLConfigMapName(t *testing.T) {
 	g := NewGomegaWithT(t)
 	tests := []struct {
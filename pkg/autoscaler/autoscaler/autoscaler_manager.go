@@ -16,11 +16,13 @@ package autoscaler
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/autoscaler/autoscaler/query"
 	"github.com/pingcap/tidb-operator/pkg/controller"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	errorutils "k8s.io/apimachinery/pkg/util/errors"
@@ -29,13 +31,21 @@ import (
 	"k8s.io/klog/v2"
 )
 
+const recommendEventReason = "AutoScalingRecommended"
+
 type autoScalerManager struct {
 	deps *controller.Dependencies
+
+	// querySamples keeps the query counter of each TiDB instance sampled in the last
+	// reconciliation, which is used to calculate QPS when scraping the status port
+	sampleLock   sync.Mutex
+	querySamples map[string]querySample
 }
 
 func NewAutoScalerManager(deps *controller.Dependencies) *autoScalerManager {
 	return &autoScalerManager{
-		deps: deps,
+		deps:         deps,
+		querySamples: map[string]querySample{},
 	}
 }
 
@@ -67,6 +77,12 @@ func (am *autoScalerManager) Sync(tac *v1alpha1.TidbClusterAutoScaler) error {
 
 	updatedTac := tac.DeepCopy()
 
+	// recommendations are recorded from scratch in every reconciliation
+	updatedTac.Status.Recommendations = nil
+	if err := syncSchedules(updatedTac, time.Now()); err != nil {
+		return err
+	}
+
 	if err := am.syncAutoScaling(tc, updatedTac); err != nil {
 		return err
 	}
@@ -93,7 +109,14 @@ func (am *autoScalerManager) syncExternal(tc *v1alpha1.TidbCluster, tac *v1alpha
 		targetReplicas = cfg.MaxReplicas
 	}
 
-	return am.syncExternalResult(tc, tac, component, targetReplicas)
+	// the active schedules bound the total replicas, including the replicas of the target TidbCluster
+	base := getReplicas(tc, component)
+	targetReplicas = boundReplicas(tac, component, base+max(targetReplicas, 0)) - base
+	if targetReplicas < 0 {
+		targetReplicas = 0
+	}
+
+	return am.syncExternalResult(tc, tac, component, externalStatusKey, targetReplicas)
 }
 
 func (am *autoScalerManager) syncPD(tc *v1alpha1.TidbCluster, tac *v1alpha1.TidbClusterAutoScaler, component v1alpha1.MemberType) error {
@@ -104,6 +127,7 @@ func (am *autoScalerManager) syncPD(tc *v1alpha1.TidbCluster, tac *v1alpha1.Tidb
 		klog.Errorf("tac[%s/%s] cannot get auto-scaling plans for component %v err:%v", tac.Namespace, tac.Name, component, err)
 		return err
 	}
+	plans = boundPlans(tc, tac, component, plans)
 
 	// Apply auto-scaling plans
 	if err := am.syncPlans(tc, tac, plans, component); err != nil {
@@ -120,6 +144,10 @@ func (am *autoScalerManager) syncAutoScaling(tc *v1alpha1.TidbCluster, tac *v1al
 			if err := am.syncExternal(tc, tac, v1alpha1.TiDBMemberType); err != nil {
 				errs = append(errs, err)
 			}
+		} else if tac.Spec.TiDB.Metrics != nil {
+			if err := am.syncTidbMetrics(tc, tac); err != nil {
+				errs = append(errs, err)
+			}
 		} else {
 			if err := am.syncPD(tc, tac, v1alpha1.TiDBMemberType); err != nil {
 				errs = append(errs, err)
@@ -196,6 +224,31 @@ func (am *autoScalerManager) updateTidbClusterAutoScaler(tac *v1alpha1.TidbClust
 	return err
 }
 
+func isRecommendMode(tac *v1alpha1.TidbClusterAutoScaler) bool {
+	return tac.Spec.Mode == v1alpha1.AutoScalerModeRecommend
+}
+
+// recommend records the action in the status and emits an event instead of applying it in the Recommend mode
+func (am *autoScalerManager) recommend(tac *v1alpha1.TidbClusterAutoScaler, recommendation v1alpha1.AutoScalerRecommendation) {
+	tac.Status.Recommendations = append(tac.Status.Recommendations, recommendation)
+	am.deps.Recorder.Eventf(tac, corev1.EventTypeNormal, recommendEventReason, "%s %s tc[%s/%s] of group %s, replicas %d -> %d, %s",
+		recommendation.Action, recommendation.Component, tac.Spec.Cluster.Namespace, recommendation.Cluster,
+		recommendation.Group, recommendation.CurrentReplicas, recommendation.RecommendedReplicas, recommendation.Reason)
+}
+
+// recommendationReason returns the reason of the recommendation for the group
+func recommendationReason(group string) string {
+	switch group {
+	case externalStatusKey:
+		return "recommended by the external endpoint"
+	case metricsStatusKey:
+		return "recommended by the metrics rules"
+	case scheduleGroup:
+		return "required by the active schedules"
+	}
+	return "recommended by the PD auto-scaling plan"
+}
+
 func updateLastAutoScalingTimestamp(tac *v1alpha1.TidbClusterAutoScaler, memberType string, group string) {
 	switch memberType {
 	case v1alpha1.TiKVMemberType.String():
@@ -0,0 +1,68 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoscaler
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRecommendMode(t *testing.T) {
+	g := NewGomegaWithT(t)
+	deps := controller.NewFakeDependencies()
+	am := NewAutoScalerManager(deps)
+
+	tc := newTidbCluster()
+	tc.Spec.TiDB.Replicas = 2
+	tac := newTidbClusterAutoScaler()
+	tac.Spec.Mode = v1alpha1.AutoScalerModeRecommend
+	defaultTAC(tac, tc)
+
+	// Case 1: recommend creating the external TidbCluster
+	err := am.syncExternalResult(tc, tac, v1alpha1.TiDBMemberType, externalStatusKey, 3)
+	g.Expect(err).Should(BeNil())
+	g.Expect(tac.Status.Recommendations).Should(HaveLen(1))
+	g.Expect(tac.Status.Recommendations[0].Action).Should(Equal(v1alpha1.AutoScalerActionCreate))
+	g.Expect(tac.Status.Recommendations[0].RecommendedReplicas).Should(Equal(int32(3)))
+	_, err = deps.Clientset.PingcapV1alpha1().TidbClusters(tc.Namespace).Get(context.TODO(), "tc-tidb-external", metav1.GetOptions{})
+	g.Expect(errors.IsNotFound(err)).Should(BeTrue())
+	g.Expect(tac.Status.TiDB).Should(BeEmpty())
+
+	// Case 2: recommend updating the external TidbCluster
+	externalTc := newTidbCluster()
+	externalTc.Name = "tc-tidb-external"
+	externalTc.Spec.TiDB.Replicas = 3
+	deps.InformerFactory.Pingcap().V1alpha1().TidbClusters().Informer().GetIndexer().Add(externalTc)
+	tac.Status.Recommendations = nil
+	err = am.syncExternalResult(tc, tac, v1alpha1.TiDBMemberType, externalStatusKey, 5)
+	g.Expect(err).Should(BeNil())
+	g.Expect(tac.Status.Recommendations).Should(HaveLen(1))
+	g.Expect(tac.Status.Recommendations[0].Action).Should(Equal(v1alpha1.AutoScalerActionUpdate))
+	g.Expect(tac.Status.Recommendations[0].CurrentReplicas).Should(Equal(int32(3)))
+	g.Expect(tac.Status.Recommendations[0].RecommendedReplicas).Should(Equal(int32(5)))
+
+	// Case 3: recommend deleting the external TidbCluster
+	tac.Status.Recommendations = nil
+	err = am.syncExternalResult(tc, tac, v1alpha1.TiDBMemberType, externalStatusKey, 0)
+	g.Expect(err).Should(BeNil())
+	g.Expect(tac.Status.Recommendations).Should(HaveLen(1))
+	g.Expect(tac.Status.Recommendations[0].Action).Should(Equal(v1alpha1.AutoScalerActionDelete))
+	g.Expect(externalTc.Spec.TiDB.Replicas).Should(Equal(int32(3)))
+}
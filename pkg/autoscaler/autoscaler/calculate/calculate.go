@@ -18,6 +18,8 @@ const (
 	TidbSumCPUUsageMetricsPattern = `sum(increase(process_cpu_seconds_total{job="tidb"}[%s])) by (instance, kubernetes_namespace)`
 	TikvCPUQuotaMetricsPattern    = `tikv_server_cpu_cores_quota`
 	TidbCPUQuotaMetricsPattern    = `tidb_server_maxprocs`
+	TidbConnectionsMetricsPattern = `sum(tidb_server_connections{kubernetes_namespace="%s",cluster=~"%s",component="tidb"})`
+	TidbQPSMetricsPattern         = `sum(rate(tidb_server_query_total{kubernetes_namespace="%s",cluster=~"%s",component="tidb"}[%s]))`
 	InvalidTacMetricConfigureMsg  = "tac[%s/%s] metric configuration invalid"
 )
 
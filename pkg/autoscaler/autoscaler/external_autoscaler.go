@@ -24,24 +24,28 @@ import (
 )
 
 const (
-	// The TidbCluster for the external query will be "<original-tcname>-<component>-external"
-	externalTcNamePattern = "%s-%s-external"
-	externalStatusKey     = "external"
-	specialUseLabelKey    = "specialUse"
-	specialUseHotRegion   = "hotRegion"
+	// The TidbCluster for the external query will be "<original-tcname>-<component>-external",
+	// and the TidbCluster for the metrics rules will be "<original-tcname>-<component>-metrics"
+	autoTcNamePattern   = "%s-%s-%s"
+	externalStatusKey   = "external"
+	metricsStatusKey    = "metrics"
+	specialUseLabelKey  = "specialUse"
+	specialUseHotRegion = "hotRegion"
 )
 
-func (am *autoScalerManager) syncExternalResult(tc *v1alpha1.TidbCluster, tac *v1alpha1.TidbClusterAutoScaler, component v1alpha1.MemberType, targetReplicas int32) error {
+// syncExternalResult scales the TidbCluster identified by key to the target replicas,
+// key is either externalStatusKey or metricsStatusKey
+func (am *autoScalerManager) syncExternalResult(tc *v1alpha1.TidbCluster, tac *v1alpha1.TidbClusterAutoScaler, component v1alpha1.MemberType, key string, targetReplicas int32) error {
 	// `ClusterName` has been removed in `ObjectMeta`, use `Name` instead
 	// ref https://github.com/kubernetes/kubernetes/pull/108717
-	externalTcName := fmt.Sprintf(externalTcNamePattern, tc.Name, component.String())
+	externalTcName := fmt.Sprintf(autoTcNamePattern, tc.Name, component.String(), key)
 	externalTc, err := am.deps.TiDBClusterLister.TidbClusters(tc.Namespace).Get(externalTcName)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			if targetReplicas <= 0 {
 				return nil
 			}
-			return am.createExternalAutoCluster(tc, externalTcName, tac, component, targetReplicas)
+			return am.createExternalAutoCluster(tc, externalTcName, tac, component, key, targetReplicas)
 		}
 
 		klog.Errorf("tac[%s/%s] failed to get external tc[%s/%s], err: %v", tac.Namespace, tac.Name, tc.Namespace, externalTcName, err)
@@ -49,6 +53,19 @@ func (am *autoScalerManager) syncExternalResult(tc *v1alpha1.TidbCluster, tac *v
 	}
 
 	if targetReplicas <= 0 {
+		if isRecommendMode(tac) {
+			am.recommend(tac, v1alpha1.AutoScalerRecommendation{
+				Component:           component,
+				Group:               key,
+				Action:              v1alpha1.AutoScalerActionDelete,
+				Cluster:             externalTcName,
+				CurrentReplicas:     getReplicas(externalTc, component),
+				RecommendedReplicas: 0,
+				Reason:              recommendationReason(key),
+			})
+			return nil
+		}
+
 		err := am.gracefullyDeleteTidbCluster(externalTc)
 		if err != nil {
 			klog.Errorf("tac[%s/%s] failed to delete external tc[%s/%s], err: %v", tac.Namespace, tac.Name, tc.Namespace, externalTcName, err)
@@ -57,18 +74,18 @@ func (am *autoScalerManager) syncExternalResult(tc *v1alpha1.TidbCluster, tac *v
 
 		switch component {
 		case v1alpha1.TiDBMemberType:
-			delete(tac.Status.TiDB, externalStatusKey)
+			delete(tac.Status.TiDB, key)
 		case v1alpha1.TiKVMemberType:
-			delete(tac.Status.TiKV, externalStatusKey)
+			delete(tac.Status.TiKV, key)
 		}
 
 		return nil
 	}
 
-	return am.updateExternalAutoCluster(externalTc, tac, component, targetReplicas)
+	return am.updateExternalAutoCluster(externalTc, tac, component, key, targetReplicas)
 }
 
-func (am *autoScalerManager) createExternalAutoCluster(tc *v1alpha1.TidbCluster, externalTcName string, tac *v1alpha1.TidbClusterAutoScaler, component v1alpha1.MemberType, targetReplicas int32) error {
+func (am *autoScalerManager) createExternalAutoCluster(tc *v1alpha1.TidbCluster, externalTcName string, tac *v1alpha1.TidbClusterAutoScaler, component v1alpha1.MemberType, key string, targetReplicas int32) error {
 	autoTc := newAutoScalingCluster(tc, tac, externalTcName, component.String())
 
 	switch component {
@@ -79,17 +96,29 @@ func (am *autoScalerManager) createExternalAutoCluster(tc *v1alpha1.TidbCluster,
 		autoTc.Spec.TiKV.Config.Set("server.labels."+specialUseLabelKey, specialUseHotRegion)
 	}
 
+	if isRecommendMode(tac) {
+		am.recommend(tac, v1alpha1.AutoScalerRecommendation{
+			Component:           component,
+			Group:               key,
+			Action:              v1alpha1.AutoScalerActionCreate,
+			Cluster:             externalTcName,
+			RecommendedReplicas: targetReplicas,
+			Reason:              recommendationReason(key),
+		})
+		return nil
+	}
+
 	_, err := am.deps.Clientset.PingcapV1alpha1().TidbClusters(tc.Namespace).Create(context.TODO(), autoTc, metav1.CreateOptions{})
 	if err != nil {
 		klog.Errorf("tac[%s/%s] failed to create external tc[%s/%s], err: %v", tac.Namespace, tac.Name, tc.Namespace, externalTcName, err)
 		return err
 	}
 
-	updateLastAutoScalingTimestamp(tac, component.String(), externalStatusKey)
+	updateLastAutoScalingTimestamp(tac, component.String(), key)
 	return nil
 }
 
-func (am *autoScalerManager) updateExternalAutoCluster(externalTc *v1alpha1.TidbCluster, tac *v1alpha1.TidbClusterAutoScaler, component v1alpha1.MemberType, targetReplicas int32) error {
+func (am *autoScalerManager) updateExternalAutoCluster(externalTc *v1alpha1.TidbCluster, tac *v1alpha1.TidbClusterAutoScaler, component v1alpha1.MemberType, key string, targetReplicas int32) error {
 	updated := externalTc.DeepCopy()
 	switch component {
 	case v1alpha1.TiDBMemberType:
@@ -97,7 +126,7 @@ func (am *autoScalerManager) updateExternalAutoCluster(externalTc *v1alpha1.Tidb
 			return nil
 		}
 
-		if !checkAutoScaling(tac, component, externalStatusKey, updated.Spec.TiDB.Replicas, targetReplicas) {
+		if !checkAutoScaling(tac, component, key, updated.Spec.TiDB.Replicas, targetReplicas) {
 			return nil
 		}
 		updated.Spec.TiDB.Replicas = targetReplicas
@@ -106,18 +135,31 @@ func (am *autoScalerManager) updateExternalAutoCluster(externalTc *v1alpha1.Tidb
 			return nil
 		}
 
-		if !checkAutoScaling(tac, component, externalStatusKey, updated.Spec.TiKV.Replicas, targetReplicas) {
+		if !checkAutoScaling(tac, component, key, updated.Spec.TiKV.Replicas, targetReplicas) {
 			return nil
 		}
 		updated.Spec.TiKV.Replicas = targetReplicas
 	}
 
+	if isRecommendMode(tac) {
+		am.recommend(tac, v1alpha1.AutoScalerRecommendation{
+			Component:           component,
+			Group:               key,
+			Action:              v1alpha1.AutoScalerActionUpdate,
+			Cluster:             externalTc.Name,
+			CurrentReplicas:     getReplicas(externalTc, component),
+			RecommendedReplicas: targetReplicas,
+			Reason:              recommendationReason(key),
+		})
+		return nil
+	}
+
 	_, err := am.deps.TiDBClusterControl.UpdateTidbCluster(updated, &updated.Status, &externalTc.Status)
 	if err != nil {
 		klog.Errorf("tac[%s/%s] failed to update external tc[%s/%s], err: %v", tac.Namespace, tac.Name, externalTc.Namespace, externalTc.Name, err)
 		return err
 	}
 
-	updateLastAutoScalingTimestamp(tac, component.String(), externalStatusKey)
+	updateLastAutoScalingTimestamp(tac, component.String(), key)
 	return nil
 }
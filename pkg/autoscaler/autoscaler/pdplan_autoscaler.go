@@ -110,6 +110,23 @@ func (am *autoScalerManager) deleteAutoscalingClusters(tac *v1alpha1.TidbCluster
 	for _, group := range groupsToDelete {
 		deleteTc := groupTcMap[group]
 
+		if isRecommendMode(tac) {
+			component := v1alpha1.TiKVMemberType
+			if deleteTc.Spec.TiDB != nil {
+				component = v1alpha1.TiDBMemberType
+			}
+			am.recommend(tac, v1alpha1.AutoScalerRecommendation{
+				Component:           component,
+				Group:               group,
+				Action:              v1alpha1.AutoScalerActionDelete,
+				Cluster:             deleteTc.Name,
+				CurrentReplicas:     getReplicas(deleteTc, component),
+				RecommendedReplicas: 0,
+				Reason:              recommendationReason(group),
+			})
+			continue
+		}
+
 		err := am.gracefullyDeleteTidbCluster(deleteTc)
 		if err != nil {
 			errs = append(errs, err)
@@ -152,6 +169,21 @@ func (am *autoScalerManager) updateAutoscalingClusters(tac *v1alpha1.TidbCluster
 			continue
 		}
 
+		if isRecommendMode(tac) {
+			component := v1alpha1.MemberType(plan.Component)
+			am.recommend(tac, v1alpha1.AutoScalerRecommendation{
+				Component:           component,
+				Group:               group,
+				Action:              v1alpha1.AutoScalerActionUpdate,
+				Cluster:             oldTc.Name,
+				CurrentReplicas:     getReplicas(oldTc, component),
+				RecommendedReplicas: int32(plan.Count),
+				ResourceType:        plan.ResourceType,
+				Reason:              recommendationReason(group),
+			})
+			continue
+		}
+
 		_, err := am.deps.TiDBClusterControl.UpdateTidbCluster(actual, &actual.Status, &oldTc.Status)
 		if err != nil {
 			klog.Errorf("tac[%s/%s] failed to update tc[%s/%s] for group %s, err: %v", tac.Namespace, tac.Name, actual.Namespace, actual.Name, group, err)
@@ -226,6 +258,23 @@ func (am *autoScalerManager) createAutoscalingClusters(tc *v1alpha1.TidbCluster,
 			}
 		}
 
+		if isRecommendMode(tac) {
+			am.recommend(tac, v1alpha1.AutoScalerRecommendation{
+				Component:           v1alpha1.MemberType(component),
+				Group:               group,
+				Action:              v1alpha1.AutoScalerActionCreate,
+				Cluster:             autoTcName,
+				RecommendedReplicas: int32(plan.Count),
+				ResourceType:        plan.ResourceType,
+				Resources: &corev1.ResourceRequirements{
+					Limits:   limitsResourceList,
+					Requests: requestsResourceList,
+				},
+				Reason: recommendationReason(group),
+			})
+			continue
+		}
+
 		_, err = am.deps.Clientset.PingcapV1alpha1().TidbClusters(tc.Namespace).Create(context.TODO(), autoTc, metav1.CreateOptions{})
 		if err != nil {
 			klog.Errorf("tac[%s/%s] failed to create autoscaling tc for group %s, err: %v", tac.Namespace, tac.Name, group, err)
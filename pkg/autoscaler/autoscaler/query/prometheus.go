@@ -0,0 +1,72 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/pingcap/tidb-operator/pkg/autoscaler/autoscaler/calculate"
+)
+
+const statusSuccess = "success"
+
+// PrometheusSum sends an instant query to the Prometheus endpoint and returns
+// the sum of all the values in the result vector
+func PrometheusSum(endpoint, query string) (float64, error) {
+	client := &http.Client{Timeout: defaultTimeout}
+	u := fmt.Sprintf("%s/api/v1/query?query=%s", endpoint, url.QueryEscape(query))
+	r, err := client.Get(u)
+	if err != nil {
+		return 0, err
+	}
+	defer r.Body.Close()
+	bytes, err := io.ReadAll(r.Body)
+	if err != nil {
+		return 0, err
+	}
+	if r.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("query from prometheus [%s] failed, response: %v, status code: %v", u, string(bytes), r.StatusCode)
+	}
+
+	resp := &calculate.Response{}
+	if err := json.Unmarshal(bytes, resp); err != nil {
+		return 0, err
+	}
+	if resp.Status != statusSuccess {
+		return 0, fmt.Errorf("query from prometheus [%s] failed, status: %s", u, resp.Status)
+	}
+
+	var sum float64
+	for _, result := range resp.Data.Result {
+		// the value of an instant vector is in the format of [timestamp, "value"]
+		if len(result.Value) != 2 {
+			return 0, fmt.Errorf("query from prometheus [%s] returns unexpected value %v", u, result.Value)
+		}
+		s, ok := result.Value[1].(string)
+		if !ok {
+			return 0, fmt.Errorf("query from prometheus [%s] returns unexpected value %v", u, result.Value)
+		}
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return 0, err
+		}
+		sum += v
+	}
+	return sum, nil
+}
@@ -0,0 +1,246 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoscaler
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	"github.com/robfig/cron"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// The group of the plan created to satisfy the min replicas of the active schedules
+// when PD does not return any plan for the component
+const scheduleGroup = "schedule"
+
+// maxScheduleStarts is the max number of the start times of a schedule in its duration,
+// it prevents walking through a frequent schedule with a long duration
+const maxScheduleStarts = 10000
+
+// scheduleWindow returns the start and end time of the latest window of the schedule started before now
+func scheduleWindow(schedule *v1alpha1.AutoScalerSchedule, now time.Time) (start, end time.Time, err error) {
+	sched, err := cron.ParseStandard(schedule.Schedule)
+	if err != nil {
+		return start, end, fmt.Errorf("parse schedule %s cron format %s failed, err: %v", schedule.Name, schedule.Schedule, err)
+	}
+	duration, err := time.ParseDuration(schedule.Duration)
+	if err != nil {
+		return start, end, fmt.Errorf("parse schedule %s duration %s failed, err: %v", schedule.Name, schedule.Duration, err)
+	}
+	if len(schedule.TimeZone) > 0 {
+		loc, err := time.LoadLocation(schedule.TimeZone)
+		if err != nil {
+			return start, end, fmt.Errorf("load schedule %s time zone %s failed, err: %v", schedule.Name, schedule.TimeZone, err)
+		}
+		now = now.In(loc)
+	}
+
+	// a valid cron format may never fire, such as "0 0 30 2 *", Next returns the zero time for it
+	if sched.Next(now).IsZero() {
+		return start, end, fmt.Errorf("schedule %s cron format %s never fires", schedule.Name, schedule.Schedule)
+	}
+
+	// find the latest start time in (now - duration, now]
+	next := sched.Next(now.Add(-duration))
+	for i := 0; !next.IsZero() && !next.After(now); i++ {
+		if i >= maxScheduleStarts {
+			return time.Time{}, end, fmt.Errorf("schedule %s starts more than %d times in duration %s", schedule.Name, maxScheduleStarts, schedule.Duration)
+		}
+		start = next
+		next = sched.Next(next)
+	}
+	if start.IsZero() {
+		return start, end, nil
+	}
+	return start, start.Add(duration), nil
+}
+
+// syncSchedules records the schedules whose windows cover now in the status
+func syncSchedules(tac *v1alpha1.TidbClusterAutoScaler, now time.Time) error {
+	var active []v1alpha1.ActiveScheduleStatus
+	for i := range tac.Spec.Schedules {
+		schedule := &tac.Spec.Schedules[i]
+		start, end, err := scheduleWindow(schedule, now)
+		if err != nil {
+			return err
+		}
+		if start.IsZero() {
+			continue
+		}
+		active = append(active, v1alpha1.ActiveScheduleStatus{
+			Name:      schedule.Name,
+			StartTime: metav1.Time{Time: start},
+			EndTime:   metav1.Time{Time: end},
+		})
+	}
+	tac.Status.ActiveSchedules = active
+	return nil
+}
+
+// scheduledReplicaBounds returns the replica bounds of the component combined from all the active schedules,
+// the lower limit is the largest MinReplicas and the upper limit is the smallest MaxReplicas
+func scheduledReplicaBounds(tac *v1alpha1.TidbClusterAutoScaler, component v1alpha1.MemberType) (minReplicas, maxReplicas *int32) {
+	active := sets.NewString()
+	for _, status := range tac.Status.ActiveSchedules {
+		active.Insert(status.Name)
+	}
+
+	for _, schedule := range tac.Spec.Schedules {
+		if !active.Has(schedule.Name) {
+			continue
+		}
+		var replicas *v1alpha1.ScheduledReplicas
+		switch component {
+		case v1alpha1.TiDBMemberType:
+			replicas = schedule.TiDB
+		case v1alpha1.TiKVMemberType:
+			replicas = schedule.TiKV
+		}
+		if replicas == nil {
+			continue
+		}
+		if replicas.MinReplicas != nil && (minReplicas == nil || *replicas.MinReplicas > *minReplicas) {
+			v := *replicas.MinReplicas
+			minReplicas = &v
+		}
+		if replicas.MaxReplicas != nil && (maxReplicas == nil || *replicas.MaxReplicas < *maxReplicas) {
+			v := *replicas.MaxReplicas
+			maxReplicas = &v
+		}
+	}
+	return
+}
+
+// boundReplicas limits the total replicas of the component by the active schedules
+func boundReplicas(tac *v1alpha1.TidbClusterAutoScaler, component v1alpha1.MemberType, replicas int32) int32 {
+	minReplicas, maxReplicas := scheduledReplicaBounds(tac, component)
+	if minReplicas != nil && replicas < *minReplicas {
+		replicas = *minReplicas
+	}
+	if maxReplicas != nil && replicas > *maxReplicas {
+		replicas = *maxReplicas
+	}
+	return replicas
+}
+
+// boundPlans adjusts the auto-scaling plans from PD so that the total replicas of the component
+// satisfies the active schedules. Replicas are added to the first group or a new schedule group,
+// and removed from the last groups.
+func boundPlans(tc *v1alpha1.TidbCluster, tac *v1alpha1.TidbClusterAutoScaler, component v1alpha1.MemberType, plans []pdapi.Plan) []pdapi.Plan {
+	base := getReplicas(tc, component)
+	total := base
+	for _, plan := range plans {
+		total += int32(plan.Count)
+	}
+	bounded := boundReplicas(tac, component, total)
+	if bounded == total {
+		return plans
+	}
+
+	result := make([]pdapi.Plan, len(plans))
+	copy(result, plans)
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Labels[groupLabelKey] < result[j].Labels[groupLabelKey]
+	})
+
+	if bounded > total {
+		if len(result) > 0 {
+			result[0].Count += uint64(bounded - total)
+			return result
+		}
+		resourceType := scheduleResourceType(tac, component)
+		if len(resourceType) == 0 {
+			return result
+		}
+		return append(result, pdapi.Plan{
+			Component:    component.String(),
+			Count:        uint64(bounded - total),
+			ResourceType: resourceType,
+			Labels:       map[string]string{groupLabelKey: scheduleGroup},
+		})
+	}
+
+	excess := uint64(total - bounded)
+	for i := len(result) - 1; i >= 0 && excess > 0; i-- {
+		if result[i].Count > excess {
+			result[i].Count -= excess
+			excess = 0
+			break
+		}
+		excess -= result[i].Count
+		result = result[:i]
+	}
+	return result
+}
+
+// scheduleResourceType returns the resource type used by the schedule group
+func scheduleResourceType(tac *v1alpha1.TidbClusterAutoScaler, component v1alpha1.MemberType) string {
+	spec := getBasicAutoScalerSpec(tac, component)
+	if rule, ok := spec.Rules[corev1.ResourceCPU]; ok && len(rule.ResourceTypes) > 0 {
+		return rule.ResourceTypes[0]
+	}
+	for _, rule := range spec.Rules {
+		if len(rule.ResourceTypes) > 0 {
+			return rule.ResourceTypes[0]
+		}
+	}
+	return ""
+}
+
+func validateSchedules(tac *v1alpha1.TidbClusterAutoScaler) error {
+	names := sets.NewString()
+	for i := range tac.Spec.Schedules {
+		schedule := &tac.Spec.Schedules[i]
+		if len(schedule.Name) == 0 {
+			return fmt.Errorf("no name provided for schedule %d in %s/%s", i, tac.Namespace, tac.Name)
+		}
+		if names.Has(schedule.Name) {
+			return fmt.Errorf("duplicated schedule %s in %s/%s", schedule.Name, tac.Namespace, tac.Name)
+		}
+		names.Insert(schedule.Name)
+
+		if _, _, err := scheduleWindow(schedule, time.Now()); err != nil {
+			return fmt.Errorf("%v in %s/%s", err, tac.Namespace, tac.Name)
+		}
+		if duration, _ := time.ParseDuration(schedule.Duration); duration <= 0 {
+			return fmt.Errorf("duration (%s) should be positive for schedule %s in %s/%s", schedule.Duration, schedule.Name, tac.Namespace, tac.Name)
+		}
+		if schedule.TiDB == nil && schedule.TiKV == nil {
+			return fmt.Errorf("no replicas provided for schedule %s in %s/%s", schedule.Name, tac.Namespace, tac.Name)
+		}
+
+		components := map[v1alpha1.MemberType]*v1alpha1.ScheduledReplicas{
+			v1alpha1.TiDBMemberType: schedule.TiDB,
+			v1alpha1.TiKVMemberType: schedule.TiKV,
+		}
+		for component, replicas := range components {
+			if replicas == nil {
+				continue
+			}
+			if (component == v1alpha1.TiDBMemberType && tac.Spec.TiDB == nil) || (component == v1alpha1.TiKVMemberType && tac.Spec.TiKV == nil) {
+				return fmt.Errorf("schedule %s sets replicas for %s which is not auto-scaled in %s/%s", schedule.Name, component.String(), tac.Namespace, tac.Name)
+			}
+			if replicas.MinReplicas != nil && replicas.MaxReplicas != nil && *replicas.MinReplicas > *replicas.MaxReplicas {
+				return fmt.Errorf("minReplicas (%v) > maxReplicas (%v) for %s of schedule %s in %s/%s", *replicas.MinReplicas, *replicas.MaxReplicas, component.String(), schedule.Name, tac.Namespace, tac.Name)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoscaler
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+)
+
+func TestScheduleWindow(t *testing.T) {
+	g := NewGomegaWithT(t)
+	schedule := &v1alpha1.AutoScalerSchedule{
+		Name:     "daytime",
+		Schedule: "0 8 * * *",
+		Duration: "10h",
+		TimeZone: "UTC",
+	}
+
+	// inside the window
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	start, end, err := scheduleWindow(schedule, now)
+	g.Expect(err).Should(BeNil())
+	g.Expect(start.Equal(time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC))).Should(BeTrue())
+	g.Expect(end.Equal(time.Date(2024, 5, 1, 18, 0, 0, 0, time.UTC))).Should(BeTrue())
+
+	// outside the window
+	now = time.Date(2024, 5, 1, 19, 0, 0, 0, time.UTC)
+	start, _, err = scheduleWindow(schedule, now)
+	g.Expect(err).Should(BeNil())
+	g.Expect(start.IsZero()).Should(BeTrue())
+
+	// the time zone is used to interpret the schedule
+	schedule.TimeZone = "Asia/Shanghai"
+	now = time.Date(2024, 5, 1, 1, 0, 0, 0, time.UTC)
+	start, _, err = scheduleWindow(schedule, now)
+	g.Expect(err).Should(BeNil())
+	g.Expect(start.Equal(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC))).Should(BeTrue())
+
+	// invalid duration
+	schedule.Duration = "10"
+	_, _, err = scheduleWindow(schedule, now)
+	g.Expect(err).ShouldNot(BeNil())
+
+	// the schedule never fires
+	schedule.Duration = "10h"
+	schedule.Schedule = "0 0 30 2 *"
+	_, _, err = scheduleWindow(schedule, now)
+	g.Expect(err).Should(MatchError(ContainSubstring("never fires")))
+
+	// the schedule starts too many times in its duration
+	schedule.Schedule = "* * * * *"
+	schedule.Duration = "720h"
+	_, _, err = scheduleWindow(schedule, now)
+	g.Expect(err).Should(MatchError(ContainSubstring("starts more than")))
+}
+
+func TestBoundPlans(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tc := newTidbCluster()
+	tc.Spec.TiDB.Replicas = 2
+
+	tac := newTidbClusterAutoScaler()
+	tac.Spec.TiDB.Rules = map[corev1.ResourceName]v1alpha1.AutoRule{
+		corev1.ResourceCPU: {
+			MaxThreshold:  0.8,
+			ResourceTypes: []string{"compute"},
+		},
+	}
+	tac.Spec.Schedules = []v1alpha1.AutoScalerSchedule{
+		{
+			Name: "peak",
+			TiDB: &v1alpha1.ScheduledReplicas{
+				MinReplicas: pointer.Int32Ptr(5),
+				MaxReplicas: pointer.Int32Ptr(6),
+			},
+		},
+	}
+
+	// no active schedule
+	plans := boundPlans(tc, tac, v1alpha1.TiDBMemberType, nil)
+	g.Expect(plans).Should(BeEmpty())
+
+	tac.Status.ActiveSchedules = []v1alpha1.ActiveScheduleStatus{{Name: "peak"}}
+
+	// a schedule group is created to satisfy the min replicas
+	plans = boundPlans(tc, tac, v1alpha1.TiDBMemberType, nil)
+	g.Expect(plans).Should(Equal([]pdapi.Plan{
+		{
+			Component:    v1alpha1.TiDBMemberType.String(),
+			Count:        3,
+			ResourceType: "compute",
+			Labels:       map[string]string{groupLabelKey: scheduleGroup},
+		},
+	}))
+
+	// replicas are added to the first group
+	plans = boundPlans(tc, tac, v1alpha1.TiDBMemberType, []pdapi.Plan{
+		{Count: 1, Labels: map[string]string{groupLabelKey: "b"}},
+		{Count: 1, Labels: map[string]string{groupLabelKey: "a"}},
+	})
+	g.Expect(plans[0].Labels[groupLabelKey]).Should(Equal("a"))
+	g.Expect(plans[0].Count).Should(Equal(uint64(2)))
+	g.Expect(plans[1].Count).Should(Equal(uint64(1)))
+
+	// replicas are removed from the last groups
+	plans = boundPlans(tc, tac, v1alpha1.TiDBMemberType, []pdapi.Plan{
+		{Count: 4, Labels: map[string]string{groupLabelKey: "a"}},
+		{Count: 1, Labels: map[string]string{groupLabelKey: "b"}},
+	})
+	g.Expect(plans).Should(HaveLen(1))
+	g.Expect(plans[0].Labels[groupLabelKey]).Should(Equal("a"))
+	g.Expect(plans[0].Count).Should(Equal(uint64(4)))
+}
+
+func TestValidateSchedules(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tac := newTidbClusterAutoScaler()
+	tac.Spec.TiKV = nil
+	tac.Spec.Schedules = []v1alpha1.AutoScalerSchedule{
+		{
+			Name:     "peak",
+			Schedule: "0 8 * * 1-5",
+			Duration: "10h",
+			TiDB:     &v1alpha1.ScheduledReplicas{MinReplicas: pointer.Int32Ptr(3)},
+		},
+	}
+	g.Expect(validateSchedules(tac)).Should(BeNil())
+
+	tac.Spec.Schedules[0].TiKV = &v1alpha1.ScheduledReplicas{MinReplicas: pointer.Int32Ptr(3)}
+	g.Expect(validateSchedules(tac)).ShouldNot(BeNil())
+
+	tac.Spec.Schedules[0].TiKV = nil
+	tac.Spec.Schedules[0].Schedule = "invalid"
+	g.Expect(validateSchedules(tac)).ShouldNot(BeNil())
+
+	tac.Spec.Schedules[0].Schedule = "0 0 30 2 *"
+	g.Expect(validateSchedules(tac)).ShouldNot(BeNil())
+
+	tac.Spec.Schedules[0].Schedule = "0 8 * * 1-5"
+	tac.Spec.Schedules = append(tac.Spec.Schedules, tac.Spec.Schedules[0])
+	g.Expect(validateSchedules(tac)).ShouldNot(BeNil())
+}
@@ -0,0 +1,289 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoscaler
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/autoscaler/autoscaler/calculate"
+	"github.com/pingcap/tidb-operator/pkg/autoscaler/autoscaler/query"
+	"github.com/pingcap/tidb-operator/pkg/monitor/monitor"
+	"github.com/pingcap/tidb-operator/pkg/util"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/pointer"
+)
+
+const (
+	metricConnections = "connections"
+	metricQPS         = "qps"
+
+	defaultQPSWindow      = "1m"
+	defaultPrometheusPort = 9090
+)
+
+// tidbLoad is the load of all the TiDB instances observed in a reconciliation
+type tidbLoad struct {
+	// instances is the number of TiDB instances whose metrics are observed
+	instances   int32
+	connections float64
+	// qps is nil if it can not be calculated in this reconciliation
+	qps *float64
+}
+
+// querySample is a sample of the query counter of a TiDB instance
+type querySample struct {
+	total     float64
+	timestamp time.Time
+}
+
+// syncTidbMetrics scales TiDB according to the connection count and QPS of the TiDB instances.
+// The instances out of the target TidbCluster are placed in the "<tc>-tidb-metrics" TidbCluster.
+func (am *autoScalerManager) syncTidbMetrics(tc *v1alpha1.TidbCluster, tac *v1alpha1.TidbClusterAutoScaler) error {
+	cfg := tac.Spec.TiDB.Metrics
+	clusters := []*v1alpha1.TidbCluster{tc}
+	currentReplicas := tc.Spec.TiDB.Replicas
+
+	metricsTcName := fmt.Sprintf(autoTcNamePattern, tc.Name, v1alpha1.TiDBMemberType.String(), metricsStatusKey)
+	metricsTc, err := am.deps.TiDBClusterLister.TidbClusters(tc.Namespace).Get(metricsTcName)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	if metricsTc != nil && metricsTc.Spec.TiDB != nil {
+		clusters = append(clusters, metricsTc)
+		currentReplicas += metricsTc.Spec.TiDB.Replicas
+	}
+
+	var load *tidbLoad
+	if cfg.Monitor != nil {
+		load, err = am.queryTidbLoadFromPrometheus(tac, clusters)
+	} else {
+		load, err = am.queryTidbLoadFromStatusPort(clusters)
+	}
+	if err != nil {
+		klog.Errorf("tac[%s/%s] failed to query the metrics of tidb, err: %v", tac.Namespace, tac.Name, err)
+		return err
+	}
+	if load.instances == 0 {
+		klog.Infof("tac[%s/%s] found no tidb instance with metrics, skip auto-scaling", tac.Namespace, tac.Name)
+		return nil
+	}
+
+	metricStatuses := []v1alpha1.TidbMetricStatus{}
+	recommendedReplicas := int32(0)
+	if cfg.Connections != nil {
+		replicas := calculateRecommendedReplicas(cfg.Connections, load.connections, load.instances, currentReplicas)
+		metricStatuses = append(metricStatuses, newTidbMetricStatus(metricConnections, load.connections, load.instances, replicas))
+		recommendedReplicas = max(recommendedReplicas, replicas)
+	}
+	if cfg.QPS != nil {
+		if load.qps != nil {
+			replicas := calculateRecommendedReplicas(cfg.QPS, *load.qps, load.instances, currentReplicas)
+			metricStatuses = append(metricStatuses, newTidbMetricStatus(metricQPS, *load.qps, load.instances, replicas))
+			recommendedReplicas = max(recommendedReplicas, replicas)
+		} else {
+			// the load of QPS is unknown in this round, do not scale in by the other rules
+			recommendedReplicas = max(recommendedReplicas, currentReplicas)
+		}
+	}
+	if len(metricStatuses) == 0 {
+		// no metric is available in this round, keep the current replicas
+		recommendedReplicas = currentReplicas
+	}
+	recommendedReplicas = limitReplicas(recommendedReplicas, *cfg.MinReplicas, cfg.MaxReplicas)
+	recommendedReplicas = boundReplicas(tac, v1alpha1.TiDBMemberType, recommendedReplicas)
+
+	targetReplicas := recommendedReplicas - tc.Spec.TiDB.Replicas
+	if targetReplicas < 0 {
+		targetReplicas = 0
+	}
+	if err := am.syncExternalResult(tc, tac, v1alpha1.TiDBMemberType, metricsStatusKey, targetReplicas); err != nil {
+		return err
+	}
+
+	updateTidbMetricsStatus(tac, currentReplicas, recommendedReplicas, metricStatuses)
+	return nil
+}
+
+// queryTidbLoadFromStatusPort scrapes the status port of each healthy TiDB instance.
+// As the query counter is cumulative, QPS is calculated from the samples of the last reconciliation,
+// the instances without a previous sample, such as the new ones, are assumed to serve the average QPS.
+// The instances failed to be scraped are skipped, and the samples of the instances gone are pruned.
+func (am *autoScalerManager) queryTidbLoadFromStatusPort(clusters []*v1alpha1.TidbCluster) (*tidbLoad, error) {
+	load := &tidbLoad{}
+	qps := float64(0)
+	qpsInstances := int32(0)
+	now := time.Now()
+
+	am.sampleLock.Lock()
+	defer am.sampleLock.Unlock()
+
+	for _, tc := range clusters {
+		prefix := querySamplePrefix(tc)
+		seen := map[string]struct{}{}
+		for name, member := range tc.Status.TiDB.Members {
+			if !member.Health {
+				continue
+			}
+			key := prefix + name
+			ordinal, err := util.GetOrdinalFromPodName(name)
+			if err != nil {
+				klog.Warningf("tc[%s/%s] skip tidb %s when scraping the metrics, err: %v", tc.Namespace, tc.Name, name, err)
+				continue
+			}
+			// keep the last sample of the instance failed to be scraped,
+			// so that QPS can be calculated from it in the next reconciliation
+			seen[key] = struct{}{}
+			status, err := am.deps.TiDBControl.GetStatus(tc, ordinal)
+			if err != nil {
+				klog.Warningf("tc[%s/%s] skip tidb %s as failed to get its status, err: %v", tc.Namespace, tc.Name, name, err)
+				continue
+			}
+			total, err := am.deps.TiDBControl.GetQueryTotal(tc, ordinal)
+			if err != nil {
+				klog.Warningf("tc[%s/%s] skip tidb %s as failed to get its query total, err: %v", tc.Namespace, tc.Name, name, err)
+				continue
+			}
+			load.instances++
+			load.connections += float64(status.Connections)
+
+			last, ok := am.querySamples[key]
+			am.querySamples[key] = querySample{total: total, timestamp: now}
+			// the counter is reset when TiDB restarts
+			if !ok || total < last.total || !now.After(last.timestamp) {
+				continue
+			}
+			qps += (total - last.total) / now.Sub(last.timestamp).Seconds()
+			qpsInstances++
+		}
+
+		for key := range am.querySamples {
+			if _, ok := seen[key]; !ok && strings.HasPrefix(key, prefix) {
+				delete(am.querySamples, key)
+			}
+		}
+	}
+
+	if qpsInstances > 0 {
+		qps = qps / float64(qpsInstances) * float64(load.instances)
+		load.qps = &qps
+	}
+	return load, nil
+}
+
+// querySamplePrefix returns the prefix of the keys of the query samples of the TiDB instances in tc
+func querySamplePrefix(tc *v1alpha1.TidbCluster) string {
+	return fmt.Sprintf("%s/%s/", tc.Namespace, tc.Name)
+}
+
+// queryTidbLoadFromPrometheus queries the Prometheus of the referenced TidbMonitor
+func (am *autoScalerManager) queryTidbLoadFromPrometheus(tac *v1alpha1.TidbClusterAutoScaler, clusters []*v1alpha1.TidbCluster) (*tidbLoad, error) {
+	cfg := tac.Spec.TiDB.Metrics
+	ns := cfg.Monitor.Namespace
+	if len(ns) < 1 {
+		ns = tac.Namespace
+	}
+	endpoint := fmt.Sprintf("http://%s.%s:%d", monitor.PrometheusName(cfg.Monitor.Name, 0), ns, defaultPrometheusPort)
+
+	load := &tidbLoad{}
+	names := make([]string, 0, len(clusters))
+	for _, tc := range clusters {
+		names = append(names, tc.Name)
+		for _, member := range tc.Status.TiDB.Members {
+			if member.Health {
+				load.instances++
+			}
+		}
+	}
+	clusterPattern := strings.Join(names, "|")
+	namespace := clusters[0].Namespace
+
+	connections, err := query.PrometheusSum(endpoint, fmt.Sprintf(calculate.TidbConnectionsMetricsPattern, namespace, clusterPattern))
+	if err != nil {
+		return nil, err
+	}
+	load.connections = connections
+
+	qps, err := query.PrometheusSum(endpoint, fmt.Sprintf(calculate.TidbQPSMetricsPattern, namespace, clusterPattern, cfg.QPSWindow))
+	if err != nil {
+		return nil, err
+	}
+	load.qps = &qps
+	return load, nil
+}
+
+// calculateRecommendedReplicas returns the number of TiDB instances recommended by a rule.
+// TiDB is scaled out to keep the average value under MaxThreshold when it is exceeded,
+// and scaled in to bring the average value to the middle of the thresholds when it drops under MinThreshold.
+func calculateRecommendedReplicas(rule *v1alpha1.TidbMetricRule, total float64, instances, currentReplicas int32) int32 {
+	average := total / float64(instances)
+	maxThreshold := float64(rule.MaxThreshold)
+	minThreshold := float64(*rule.MinThreshold)
+
+	switch {
+	case average > maxThreshold:
+		replicas := int32(math.Ceil(total / maxThreshold))
+		return max(replicas, currentReplicas)
+	case average < minThreshold:
+		replicas := int32(math.Ceil(total / ((maxThreshold + minThreshold) / 2)))
+		return max(min(replicas, currentReplicas), 1)
+	}
+	return currentReplicas
+}
+
+func limitReplicas(replicas, minReplicas, maxReplicas int32) int32 {
+	if replicas < minReplicas {
+		return minReplicas
+	}
+	if replicas > maxReplicas {
+		return maxReplicas
+	}
+	return replicas
+}
+
+func newTidbMetricStatus(name string, total float64, instances, recommendedReplicas int32) v1alpha1.TidbMetricStatus {
+	return v1alpha1.TidbMetricStatus{
+		Name:                name,
+		CurrentValue:        strconv.FormatFloat(total, 'f', 2, 64),
+		AverageValue:        strconv.FormatFloat(total/float64(instances), 'f', 2, 64),
+		RecommendedReplicas: recommendedReplicas,
+	}
+}
+
+func updateTidbMetricsStatus(tac *v1alpha1.TidbClusterAutoScaler, currentReplicas, recommendedReplicas int32, metrics []v1alpha1.TidbMetricStatus) {
+	if tac.Status.TiDB == nil {
+		tac.Status.TiDB = map[string]v1alpha1.TidbAutoScalerStatus{}
+	}
+	cfg := tac.Spec.TiDB.Metrics
+	status := tac.Status.TiDB[metricsStatusKey]
+	// the active schedules take precedence over the bounds of the metrics rules
+	status.MinReplicas = pointer.Int32Ptr(boundReplicas(tac, v1alpha1.TiDBMemberType, *cfg.MinReplicas))
+	status.MaxReplicas = pointer.Int32Ptr(boundReplicas(tac, v1alpha1.TiDBMemberType, cfg.MaxReplicas))
+	status.CurrentReplicas = pointer.Int32Ptr(currentReplicas)
+	status.RecommendedReplicas = pointer.Int32Ptr(recommendedReplicas)
+	status.Metrics = metrics
+	status.NextScaleOutTimestamp = nil
+	status.NextScaleInTimestamp = nil
+	if last := status.LastAutoScalingTimestamp; last != nil {
+		status.NextScaleOutTimestamp = &metav1.Time{Time: last.Add(time.Duration(*tac.Spec.TiDB.ScaleOutIntervalSeconds) * time.Second)}
+		status.NextScaleInTimestamp = &metav1.Time{Time: last.Add(time.Duration(*tac.Spec.TiDB.ScaleInIntervalSeconds) * time.Second)}
+	}
+	tac.Status.TiDB[metricsStatusKey] = status
+}
@@ -0,0 +1,226 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoscaler
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"k8s.io/utils/pointer"
+)
+
+func TestCalculateRecommendedReplicas(t *testing.T) {
+	g := NewGomegaWithT(t)
+	rule := &v1alpha1.TidbMetricRule{
+		MaxThreshold: 100,
+		MinThreshold: pointer.Int64Ptr(40),
+	}
+	tests := []struct {
+		name             string
+		total            float64
+		instances        int32
+		currentReplicas  int32
+		expectedReplicas int32
+	}{
+		{
+			name:             "average exceeds max threshold",
+			total:            450,
+			instances:        3,
+			currentReplicas:  3,
+			expectedReplicas: 5,
+		},
+		{
+			name:             "average between thresholds",
+			total:            240,
+			instances:        3,
+			currentReplicas:  3,
+			expectedReplicas: 3,
+		},
+		{
+			name:             "average under min threshold",
+			total:            60,
+			instances:        3,
+			currentReplicas:  3,
+			expectedReplicas: 1,
+		},
+		{
+			name:             "no load",
+			total:            0,
+			instances:        3,
+			currentReplicas:  3,
+			expectedReplicas: 1,
+		},
+		{
+			name:             "part of the instances observed",
+			total:            330,
+			instances:        3,
+			currentReplicas:  5,
+			expectedReplicas: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			replicas := calculateRecommendedReplicas(rule, tt.total, tt.instances, tt.currentReplicas)
+			g.Expect(replicas).Should(Equal(tt.expectedReplicas))
+		})
+	}
+}
+
+func TestValidateTidbMetrics(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tc := newTidbCluster()
+	tc.Spec.TiDB.Replicas = 2
+
+	tac := newTidbClusterAutoScaler()
+	tac.Spec.TiKV = nil
+	tac.Spec.TiDB.Metrics = &v1alpha1.TidbMetricsConfig{
+		MaxReplicas: 5,
+	}
+	defaultTAC(tac, tc)
+	g.Expect(*tac.Spec.TiDB.Metrics.MinReplicas).Should(Equal(int32(2)))
+	g.Expect(tac.Spec.TiDB.Metrics.QPSWindow).Should(Equal(defaultQPSWindow))
+	g.Expect(tac.Spec.TiDB.Resources).Should(BeEmpty())
+
+	// Case 1: No rules
+	err := validateTAC(tac)
+	g.Expect(err).Should(MatchError(fmt.Errorf("no metrics rules defined for tidb in %s/%s", tac.Namespace, tac.Name)))
+
+	// Case 2: Default min threshold
+	tac.Spec.TiDB.Metrics.Connections = &v1alpha1.TidbMetricRule{MaxThreshold: 200}
+	defaultTAC(tac, tc)
+	g.Expect(*tac.Spec.TiDB.Metrics.Connections.MinThreshold).Should(Equal(int64(100)))
+	err = validateTAC(tac)
+	g.Expect(err).Should(BeNil())
+
+	// Case 3: Invalid min threshold
+	tac.Spec.TiDB.Metrics.QPS = &v1alpha1.TidbMetricRule{MaxThreshold: 1000, MinThreshold: pointer.Int64Ptr(2000)}
+	err = validateTAC(tac)
+	g.Expect(err).Should(MatchError(fmt.Errorf("minThreshold (%v) should be between 0 and maxThreshold (%v) for metric %s of tidb in %s/%s", 2000, 1000, metricQPS, tac.Namespace, tac.Name)))
+
+	// Case 4: minReplicas > maxReplicas
+	tac.Spec.TiDB.Metrics.QPS = nil
+	tac.Spec.TiDB.Metrics.MinReplicas = pointer.Int32Ptr(6)
+	err = validateTAC(tac)
+	g.Expect(err).Should(MatchError(fmt.Errorf("minReplicas (%v) > maxReplicas (%v) for tidb in %s/%s", 6, 5, tac.Namespace, tac.Name)))
+}
+
+func TestQueryTidbLoadFromStatusPort(t *testing.T) {
+	g := NewGomegaWithT(t)
+	deps := controller.NewFakeDependencies()
+	am := NewAutoScalerManager(deps)
+	tidbControl := deps.TiDBControl.(*controller.FakeTiDBControl)
+
+	tc := newTidbCluster()
+	tc.Status.TiDB.Members = map[string]v1alpha1.TiDBMember{
+		"tc-tidb-0": {Name: "tc-tidb-0", Health: true},
+		"tc-tidb-1": {Name: "tc-tidb-1", Health: true},
+		"tc-tidb-2": {Name: "tc-tidb-2", Health: false},
+	}
+	tidbControl.SetServerStatus(map[string]*controller.ServerStatus{
+		"tc-tidb-0": {Connections: 10},
+		"tc-tidb-1": {Connections: 20},
+	})
+	tidbControl.SetQueryTotal(map[string]float64{
+		"tc-tidb-0": 100,
+		"tc-tidb-1": 200,
+	})
+
+	// QPS can not be calculated from the first samples
+	load, err := am.queryTidbLoadFromStatusPort([]*v1alpha1.TidbCluster{tc})
+	g.Expect(err).Should(BeNil())
+	g.Expect(load.instances).Should(Equal(int32(2)))
+	g.Expect(load.connections).Should(Equal(float64(30)))
+	g.Expect(load.qps).Should(BeNil())
+
+	for key, sample := range am.querySamples {
+		sample.timestamp = sample.timestamp.Add(-10 * time.Second)
+		am.querySamples[key] = sample
+	}
+	tidbControl.SetQueryTotal(map[string]float64{
+		"tc-tidb-0": 200,
+		"tc-tidb-1": 400,
+	})
+	load, err = am.queryTidbLoadFromStatusPort([]*v1alpha1.TidbCluster{tc})
+	g.Expect(err).Should(BeNil())
+	g.Expect(load.qps).ShouldNot(BeNil())
+	g.Expect(*load.qps).Should(BeNumerically("~", 30, 0.1))
+
+	// QPS of the new instance without a previous sample is estimated from the average of the others
+	tc.Status.TiDB.Members["tc-tidb-2"] = v1alpha1.TiDBMember{Name: "tc-tidb-2", Health: true}
+	tidbControl.SetServerStatus(map[string]*controller.ServerStatus{
+		"tc-tidb-0": {Connections: 10},
+		"tc-tidb-1": {Connections: 20},
+		"tc-tidb-2": {Connections: 0},
+	})
+	for key, sample := range am.querySamples {
+		sample.timestamp = sample.timestamp.Add(-10 * time.Second)
+		am.querySamples[key] = sample
+	}
+	tidbControl.SetQueryTotal(map[string]float64{
+		"tc-tidb-0": 300,
+		"tc-tidb-1": 600,
+		"tc-tidb-2": 0,
+	})
+	load, err = am.queryTidbLoadFromStatusPort([]*v1alpha1.TidbCluster{tc})
+	g.Expect(err).Should(BeNil())
+	g.Expect(load.instances).Should(Equal(int32(3)))
+	g.Expect(load.qps).ShouldNot(BeNil())
+	g.Expect(*load.qps).Should(BeNumerically("~", 45, 0.1))
+}
+
+func TestQueryTidbLoadSkipFailedAndPruneStale(t *testing.T) {
+	g := NewGomegaWithT(t)
+	deps := controller.NewFakeDependencies()
+	am := NewAutoScalerManager(deps)
+	tidbControl := deps.TiDBControl.(*controller.FakeTiDBControl)
+
+	tc := newTidbCluster()
+	tc.Status.TiDB.Members = map[string]v1alpha1.TiDBMember{
+		"tc-tidb-0": {Name: "tc-tidb-0", Health: true},
+		"tc-tidb-1": {Name: "tc-tidb-1", Health: true},
+	}
+	tidbControl.SetServerStatus(map[string]*controller.ServerStatus{
+		"tc-tidb-0": {Connections: 10},
+		"tc-tidb-1": {Connections: 20},
+	})
+	tidbControl.SetQueryTotal(map[string]float64{
+		"tc-tidb-0": 100,
+		"tc-tidb-1": 200,
+	})
+	load, err := am.queryTidbLoadFromStatusPort([]*v1alpha1.TidbCluster{tc})
+	g.Expect(err).Should(BeNil())
+	g.Expect(am.querySamples).Should(HaveLen(2))
+
+	// the instance failed to be scraped is skipped and its last sample is kept
+	tidbControl.SetServerStatus(map[string]*controller.ServerStatus{
+		"tc-tidb-0": {Connections: 10},
+	})
+	load, err = am.queryTidbLoadFromStatusPort([]*v1alpha1.TidbCluster{tc})
+	g.Expect(err).Should(BeNil())
+	g.Expect(load.instances).Should(Equal(int32(1)))
+	g.Expect(load.connections).Should(Equal(float64(10)))
+	g.Expect(am.querySamples).Should(HaveKey(querySamplePrefix(tc) + "tc-tidb-1"))
+
+	// the samples of the instances gone are pruned
+	delete(tc.Status.TiDB.Members, "tc-tidb-1")
+	_, err = am.queryTidbLoadFromStatusPort([]*v1alpha1.TidbCluster{tc})
+	g.Expect(err).Should(BeNil())
+	g.Expect(am.querySamples).Should(HaveLen(1))
+	g.Expect(am.querySamples).Should(HaveKey(querySamplePrefix(tc) + "tc-tidb-0"))
+}
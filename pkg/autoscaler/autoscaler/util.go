@@ -138,6 +138,21 @@ func getBasicAutoScalerSpec(tac *v1alpha1.TidbClusterAutoScaler, component v1alp
 	return nil
 }
 
+// getReplicas returns the replicas of the component in the TidbCluster
+func getReplicas(tc *v1alpha1.TidbCluster, component v1alpha1.MemberType) int32 {
+	switch component {
+	case v1alpha1.TiDBMemberType:
+		if tc.Spec.TiDB != nil {
+			return tc.Spec.TiDB.Replicas
+		}
+	case v1alpha1.TiKVMemberType:
+		if tc.Spec.TiKV != nil {
+			return tc.Spec.TiKV.Replicas
+		}
+	}
+	return 0
+}
+
 func getSpecResources(tac *v1alpha1.TidbClusterAutoScaler, component v1alpha1.MemberType) map[string]v1alpha1.AutoResource {
 	switch component {
 	case v1alpha1.TiDBMemberType:
@@ -179,6 +194,25 @@ func defaultBasicAutoScaler(tac *v1alpha1.TidbClusterAutoScaler, component v1alp
 	}
 }
 
+func defaultTidbMetrics(tac *v1alpha1.TidbClusterAutoScaler, tc *v1alpha1.TidbCluster) {
+	cfg := tac.Spec.TiDB.Metrics
+	if cfg.MinReplicas == nil {
+		replicas := int32(0)
+		if tc.Spec.TiDB != nil {
+			replicas = tc.Spec.TiDB.Replicas
+		}
+		cfg.MinReplicas = pointer.Int32Ptr(replicas)
+	}
+	if len(cfg.QPSWindow) == 0 {
+		cfg.QPSWindow = defaultQPSWindow
+	}
+	for _, rule := range []*v1alpha1.TidbMetricRule{cfg.Connections, cfg.QPS} {
+		if rule != nil && rule.MinThreshold == nil {
+			rule.MinThreshold = pointer.Int64Ptr(rule.MaxThreshold / 2)
+		}
+	}
+}
+
 // If the minReplicas not set, the default value would be 1
 // If the Metrics not set, the default metric will be set to 80% average CPU utilization.
 // defaultTAC would default the omitted value
@@ -187,17 +221,24 @@ func defaultTAC(tac *v1alpha1.TidbClusterAutoScaler, tc *v1alpha1.TidbCluster) {
 		tac.Annotations = map[string]string{}
 	}
 
+	if len(tac.Spec.Mode) == 0 {
+		tac.Spec.Mode = v1alpha1.AutoScalerModeAuto
+	}
+
 	// Construct default resource
 	if tac.Spec.TiKV != nil && tac.Spec.TiKV.External == nil && len(tac.Spec.TiKV.Resources) == 0 {
 		defaultResources(tc, tac, v1alpha1.TiKVMemberType)
 	}
 
-	if tac.Spec.TiDB != nil && tac.Spec.TiDB.External == nil && len(tac.Spec.TiDB.Resources) == 0 {
+	if tac.Spec.TiDB != nil && tac.Spec.TiDB.External == nil && tac.Spec.TiDB.Metrics == nil && len(tac.Spec.TiDB.Resources) == 0 {
 		defaultResources(tc, tac, v1alpha1.TiDBMemberType)
 	}
 
 	if tidb := tac.Spec.TiDB; tidb != nil {
 		defaultBasicAutoScaler(tac, v1alpha1.TiDBMemberType)
+		if tidb.Metrics != nil {
+			defaultTidbMetrics(tac, tc)
+		}
 	}
 
 	if tikv := tac.Spec.TiKV; tikv != nil {
@@ -206,6 +247,41 @@ func defaultTAC(tac *v1alpha1.TidbClusterAutoScaler, tc *v1alpha1.TidbCluster) {
 
 }
 
+func validateTidbMetrics(tac *v1alpha1.TidbClusterAutoScaler) error {
+	cfg := tac.Spec.TiDB.Metrics
+	if tac.Spec.TiDB.External != nil {
+		return fmt.Errorf("external and metrics can not be both set for tidb in %s/%s", tac.Namespace, tac.Name)
+	}
+	if cfg.Connections == nil && cfg.QPS == nil {
+		return fmt.Errorf("no metrics rules defined for tidb in %s/%s", tac.Namespace, tac.Name)
+	}
+	if cfg.MaxReplicas < 1 {
+		return fmt.Errorf("maxReplicas (%v) should be at least 1 for tidb in %s/%s", cfg.MaxReplicas, tac.Namespace, tac.Name)
+	}
+	if *cfg.MinReplicas > cfg.MaxReplicas {
+		return fmt.Errorf("minReplicas (%v) > maxReplicas (%v) for tidb in %s/%s", *cfg.MinReplicas, cfg.MaxReplicas, tac.Namespace, tac.Name)
+	}
+	if cfg.Monitor != nil && len(cfg.Monitor.Name) == 0 {
+		return fmt.Errorf("no name provided for the monitor of tidb in %s/%s", tac.Namespace, tac.Name)
+	}
+	rules := map[string]*v1alpha1.TidbMetricRule{
+		metricConnections: cfg.Connections,
+		metricQPS:         cfg.QPS,
+	}
+	for name, rule := range rules {
+		if rule == nil {
+			continue
+		}
+		if rule.MaxThreshold <= 0 {
+			return fmt.Errorf("maxThreshold (%v) should be positive for metric %s of tidb in %s/%s", rule.MaxThreshold, name, tac.Namespace, tac.Name)
+		}
+		if *rule.MinThreshold < 0 || *rule.MinThreshold > rule.MaxThreshold {
+			return fmt.Errorf("minThreshold (%v) should be between 0 and maxThreshold (%v) for metric %s of tidb in %s/%s", *rule.MinThreshold, rule.MaxThreshold, name, tac.Namespace, tac.Name)
+		}
+	}
+	return nil
+}
+
 func validateBasicAutoScalerSpec(tac *v1alpha1.TidbClusterAutoScaler, component v1alpha1.MemberType) error {
 	spec := getBasicAutoScalerSpec(tac, component)
 
@@ -268,7 +344,13 @@ func validateBasicAutoScalerSpec(tac *v1alpha1.TidbClusterAutoScaler, component
 }
 
 func validateTAC(tac *v1alpha1.TidbClusterAutoScaler) error {
-	if tac.Spec.TiDB != nil && tac.Spec.TiDB.External == nil && len(tac.Spec.TiDB.Resources) == 0 {
+	switch tac.Spec.Mode {
+	case "", v1alpha1.AutoScalerModeAuto, v1alpha1.AutoScalerModeRecommend:
+	default:
+		return fmt.Errorf("unknown mode %s in %s/%s", tac.Spec.Mode, tac.Namespace, tac.Name)
+	}
+
+	if tac.Spec.TiDB != nil && tac.Spec.TiDB.External == nil && tac.Spec.TiDB.Metrics == nil && len(tac.Spec.TiDB.Resources) == 0 {
 		return fmt.Errorf("no resources provided for tidb in %s/%s", tac.Namespace, tac.Name)
 	}
 
@@ -276,7 +358,11 @@ func validateTAC(tac *v1alpha1.TidbClusterAutoScaler) error {
 		return fmt.Errorf("no resources provided for tikv in %s/%s", tac.Namespace, tac.Name)
 	}
 
-	if tidb := tac.Spec.TiDB; tidb != nil {
+	if tidb := tac.Spec.TiDB; tidb != nil && tidb.Metrics != nil {
+		if err := validateTidbMetrics(tac); err != nil {
+			return err
+		}
+	} else if tidb != nil {
 		err := validateBasicAutoScalerSpec(tac, v1alpha1.TiDBMemberType)
 		if err != nil {
 			return err
@@ -290,7 +376,7 @@ func validateTAC(tac *v1alpha1.TidbClusterAutoScaler) error {
 		}
 	}
 
-	return nil
+	return validateSchedules(tac)
 }
 
 func autoscalerToStrategy(tac *v1alpha1.TidbClusterAutoScaler, component v1alpha1.MemberType) *pdapi.Strategy {
@@ -16,6 +16,7 @@ package backup
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pingcap/tidb-operator/pkg/apis/label"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
@@ -152,8 +153,11 @@ func (bc *backupCleaner) CleanData(backup *v1alpha1.Backup) error {
 	klog.Infof("start to clean backup %s/%s", ns, name)
 
 	cleanJobName := backup.GetCleanJobName()
-	_, err = bc.deps.JobLister.Jobs(ns).Get(cleanJobName)
+	job, err := bc.deps.JobLister.Jobs(ns).Get(cleanJobName)
 	if err == nil {
+		if v1alpha1.IsBackupUnderRetention(backup) {
+			return bc.retryCleanAfterRetention(backup, job)
+		}
 		// already have a clean job running，return directly
 		return nil
 	} else if !errors.IsNotFound(err) {
@@ -204,6 +208,25 @@ func (bc *backupCleaner) CleanData(backup *v1alpha1.Backup) error {
 	}, nil)
 }
 
+// retryCleanAfterRetention deletes the finished clean job after the retain date of the backup, so that the clean job
+// is created again to delete the objects skipped by it. The backup is requeued until then, and its finalizer is kept.
+func (bc *backupCleaner) retryCleanAfterRetention(backup *v1alpha1.Backup, job *batchv1.Job) error {
+	ns := backup.GetNamespace()
+	name := backup.GetName()
+
+	retainUntil := backup.Status.RetainUntil.Time
+	if time.Now().Before(retainUntil) {
+		return controller.RequeueErrorf("backup %s/%s is retained until %s", ns, name, retainUntil.Format(time.RFC3339))
+	}
+	if !bc.isJobDoneOrFailed(job) {
+		return nil
+	}
+	if err := bc.deps.JobControl.DeleteJob(backup, job); err != nil {
+		return fmt.Errorf("delete clean job %s of backup %s/%s failed, err: %v", job.Name, ns, name, err)
+	}
+	return controller.RequeueErrorf("backup %s/%s is not retained since %s, clean it again", ns, name, retainUntil.Format(time.RFC3339))
+}
+
 func (bc *backupCleaner) makeCleanJob(backup *v1alpha1.Backup) (*batchv1.Job, string, error) {
 	ns := backup.GetNamespace()
 	name := backup.GetName()
@@ -212,6 +235,11 @@ func (bc *backupCleaner) makeCleanJob(backup *v1alpha1.Backup) (*batchv1.Job, st
 	if err != nil {
 		return nil, reason, err
 	}
+	replicaEnv, reason, err := backuputil.GenerateReplicaStorageCertEnv(ns, backup.Spec.Replicas, bc.deps.SecretLister)
+	if err != nil {
+		return nil, reason, err
+	}
+	envVars = append(envVars, replicaEnv...)
 
 	// set env vars specified in backup.Spec.Env
 	envVars = util.AppendOverwriteEnv(envVars, backup.Spec.Env)
@@ -234,6 +262,8 @@ func (bc *backupCleaner) makeCleanJob(backup *v1alpha1.Backup) (*batchv1.Job, st
 		volumeMounts = append(volumeMounts, localVolumeMount)
 	}
 
+	volumes, volumeMounts = backuputil.AppendReplicaLocalVolumes(volumes, volumeMounts, backup.Spec.Replicas)
+
 	if len(backup.Spec.AdditionalVolumes) > 0 {
 		volumes = append(volumes, backup.Spec.AdditionalVolumes...)
 	}
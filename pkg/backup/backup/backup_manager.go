@@ -505,22 +505,10 @@ func (bm *backupManager) makeExportJob(backup *v1alpha1.Backup) (*batchv1.Job, s
 	// set env vars specified in backup.Spec.Env
 	envVars = util.AppendOverwriteEnv(envVars, backup.Spec.Env)
 
-	// TODO: make pvc request storage size configurable
-	reason, err = bm.ensureBackupPVCExist(backup)
-	if err != nil {
-		return nil, reason, err
-	}
-
-	bucketName, reason, err := backuputil.GetBackupBucketName(backup)
-	if err != nil {
-		return nil, reason, err
-	}
-
 	args := []string{
 		"export",
 		fmt.Sprintf("--namespace=%s", ns),
 		fmt.Sprintf("--backupName=%s", name),
-		fmt.Sprintf("--bucket=%s", bucketName),
 		fmt.Sprintf("--storageType=%s", backuputil.GetStorageType(backup.Spec.StorageProvider)),
 	}
 
@@ -528,6 +516,34 @@ func (bm *backupManager) makeExportJob(backup *v1alpha1.Backup) (*batchv1.Job, s
 	volumes := []corev1.Volume{}
 	initContainers := []corev1.Container{}
 
+	// the stream mode of dumpling writes files to the storage directly, so no pvc is needed
+	if !backup.IsDumplingStreaming() {
+		// TODO: make pvc request storage size configurable
+		reason, err = bm.ensureBackupPVCExist(backup)
+		if err != nil {
+			return nil, reason, err
+		}
+
+		bucketName, reason, err := backuputil.GetBackupBucketName(backup)
+		if err != nil {
+			return nil, reason, err
+		}
+		args = append(args, fmt.Sprintf("--bucket=%s", bucketName))
+
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      label.BackupJobLabelVal,
+			MountPath: constants.BackupRootPath,
+		})
+		volumes = append(volumes, corev1.Volume{
+			Name: label.BackupJobLabelVal,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: backup.GetBackupPVCName(),
+				},
+			},
+		})
+	}
+
 	if len(backup.Spec.AdditionalVolumes) > 0 {
 		volumes = append(volumes, backup.Spec.AdditionalVolumes...)
 	}
@@ -603,27 +619,16 @@ func (bm *backupManager) makeExportJob(backup *v1alpha1.Backup) (*batchv1.Job, s
 					Image:           bm.deps.CLIConfig.TiDBBackupManagerImage,
 					Args:            args,
 					ImagePullPolicy: corev1.PullIfNotPresent,
-					VolumeMounts: append([]corev1.VolumeMount{
-						{Name: label.BackupJobLabelVal, MountPath: constants.BackupRootPath},
-					}, volumeMounts...),
-					Env:       util.AppendEnvIfPresent(envVars, "TZ"),
-					Resources: backup.Spec.ResourceRequirements,
+					VolumeMounts:    volumeMounts,
+					Env:             util.AppendEnvIfPresent(envVars, "TZ"),
+					Resources:       backup.Spec.ResourceRequirements,
 				},
 			},
-			RestartPolicy:    corev1.RestartPolicyNever,
-			Tolerations:      backup.Spec.Tolerations,
-			ImagePullSecrets: backup.Spec.ImagePullSecrets,
-			Affinity:         backup.Spec.Affinity,
-			Volumes: append([]corev1.Volume{
-				{
-					Name: label.BackupJobLabelVal,
-					VolumeSource: corev1.VolumeSource{
-						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
-							ClaimName: backup.GetBackupPVCName(),
-						},
-					},
-				},
-			}, volumes...),
+			RestartPolicy:     corev1.RestartPolicyNever,
+			Tolerations:       backup.Spec.Tolerations,
+			ImagePullSecrets:  backup.Spec.ImagePullSecrets,
+			Affinity:          backup.Spec.Affinity,
+			Volumes:           volumes,
 			PriorityClassName: backup.Spec.PriorityClassName,
 		},
 	}
@@ -679,6 +684,11 @@ func (bm *backupManager) makeBRBackupJob(backup *v1alpha1.Backup) (*batchv1.Job,
 	}
 
 	envVars = append(envVars, storageEnv...)
+	replicaEnv, reason, err := backuputil.GenerateReplicaStorageCertEnv(ns, backup.Spec.Replicas, bm.deps.SecretLister)
+	if err != nil {
+		return nil, reason, fmt.Errorf("backup %s/%s, %v", ns, name, err)
+	}
+	envVars = append(envVars, replicaEnv...)
 	envVars = append(envVars, corev1.EnvVar{
 		Name:  "BR_LOG_TO_TERM",
 		Value: string(rune(1)),
@@ -800,6 +810,7 @@ func (bm *backupManager) makeBRBackupJob(backup *v1alpha1.Backup) (*batchv1.Job,
 		volumes = append(volumes, backup.Spec.Local.Volume)
 		volumeMounts = append(volumeMounts, backup.Spec.Local.VolumeMount)
 	}
+	volumes, volumeMounts = backuputil.AppendReplicaLocalVolumes(volumes, volumeMounts, backup.Spec.Replicas)
 
 	serviceAccount := constants.DefaultServiceAccountName
 	if backup.Spec.ServiceAccount != "" {
@@ -935,7 +946,7 @@ func (bm *backupManager) volumeSnapshotBackup(b *v1alpha1.Backup, tc *v1alpha1.T
 		return "BackupManifestsFailed", err
 	}
 
-	s, reason, err := snapshotter.NewSnapshotterForBackup(b.Spec.Mode, bm.deps)
+	s, reason, err := snapshotter.NewSnapshotterForBackup(b, bm.deps)
 	if err != nil {
 		return reason, err
 	}
@@ -246,6 +246,45 @@ func TestBackupManagerDumpling(t *testing.T) {
 	g.Expect(job.Spec.Template.Spec.Containers[0].Env).NotTo(gomega.ContainElement(env2No))
 }
 
+func TestBackupManagerDumplingStream(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	helper := newHelper(t)
+	defer helper.Close()
+	deps := helper.Deps
+	var err error
+
+	bm := NewBackupManager(deps).(*backupManager)
+
+	// create backup
+	backup := validDumplingBackup()
+	backup.Spec.StorageSize = ""
+	backup.Spec.Dumpling = &v1alpha1.DumplingConfig{
+		OutputMode: v1alpha1.DumplingOutputModeStream,
+	}
+	_, err = deps.Clientset.PingcapV1alpha1().Backups(backup.Namespace).Create(context.TODO(), backup, metav1.CreateOptions{})
+	g.Expect(err).Should(BeNil())
+
+	// create relate secret
+	helper.CreateSecret(backup)
+
+	err = bm.syncBackupJob(backup)
+	g.Expect(err).Should(BeNil())
+	helper.hasCondition(backup.Namespace, backup.Name, v1alpha1.BackupScheduled, "")
+	job, err := deps.KubeClientset.BatchV1().Jobs(backup.Namespace).Get(context.TODO(), backup.GetBackupJobName(), metav1.GetOptions{})
+	g.Expect(err).Should(BeNil())
+
+	// the stream mode writes files to the storage directly without a pvc
+	_, err = deps.KubeClientset.CoreV1().PersistentVolumeClaims(backup.Namespace).Get(context.TODO(), backup.GetBackupPVCName(), metav1.GetOptions{})
+	g.Expect(errors.IsNotFound(err)).Should(BeTrue())
+	for _, vol := range job.Spec.Template.Spec.Volumes {
+		g.Expect(vol.PersistentVolumeClaim).Should(BeNil())
+	}
+	for _, arg := range job.Spec.Template.Spec.Containers[0].Args {
+		g.Expect(arg).ShouldNot(HavePrefix("--bucket="))
+	}
+}
+
 func TestBackupManagerBR(t *testing.T) {
 	g := NewGomegaWithT(t)
 	helper := newHelper(t)
@@ -399,6 +438,58 @@ func TestClean(t *testing.T) {
 
 }
 
+func TestCleanAfterRetention(t *testing.T) {
+	g := NewGomegaWithT(t)
+	helper := newHelper(t)
+	defer helper.Close()
+	deps := helper.Deps
+
+	backup := genValidBRBackups()[0]
+	backup.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	backup.Spec.CleanPolicy = v1alpha1.CleanPolicyTypeDelete
+	backup.Status.BackupPath = "/path"
+	backup.Status.Conditions = []v1alpha1.BackupCondition{{
+		Type:   v1alpha1.BackupClean,
+		Status: corev1.ConditionFalse,
+		Reason: "ObjectsUnderRetention",
+	}}
+	backup.Status.RetainUntil = &metav1.Time{Time: time.Now().Add(time.Hour)}
+	_, err := deps.Clientset.PingcapV1alpha1().Backups(backup.Namespace).Create(context.TODO(), backup, metav1.CreateOptions{})
+	g.Expect(err).Should(BeNil())
+
+	cleanJob := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      backup.GetCleanJobName(),
+			Namespace: backup.Namespace,
+		},
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{
+				{
+					Type:   batchv1.JobComplete,
+					Status: corev1.ConditionTrue,
+				},
+			},
+		},
+	}
+	helper.createJob(cleanJob)
+
+	statusUpdater := controller.NewRealBackupConditionUpdater(deps.Clientset, deps.BackupLister, deps.Recorder)
+	bc := NewBackupCleaner(deps, statusUpdater)
+
+	// the backup is requeued until the retain date
+	err = bc.Clean(backup)
+	g.Expect(controller.IsRequeueError(err)).Should(BeTrue())
+	_, err = deps.KubeClientset.BatchV1().Jobs(backup.Namespace).Get(context.TODO(), backup.GetCleanJobName(), metav1.GetOptions{})
+	g.Expect(err).Should(BeNil())
+
+	// the finished clean job is deleted after the retain date, so that it can be created again
+	backup.Status.RetainUntil = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	err = bc.Clean(backup)
+	g.Expect(controller.IsRequeueError(err)).Should(BeTrue())
+	_, err = deps.KubeClientset.BatchV1().Jobs(backup.Namespace).Get(context.TODO(), backup.GetCleanJobName(), metav1.GetOptions{})
+	g.Expect(errors.IsNotFound(err)).Should(BeTrue())
+}
+
 func genVolumeBackup() *v1alpha1.Backup {
 	b := &v1alpha1.Backup{
 		Spec: v1alpha1.BackupSpec{
@@ -0,0 +1,287 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupschedule
+
+import (
+	"fmt"
+	"time"
+
+	perrors "github.com/pingcap/errors"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/apis/util/config"
+	"k8s.io/klog/v2"
+)
+
+// retentionTier is a tier of the grandfather-father-son retention policy
+type retentionTier struct {
+	name  string
+	count int32
+	// bucket returns the key of the bucket which the time belongs to in this tier
+	bucket func(t time.Time) string
+}
+
+// retentionTiers returns the tiers of the retention policy ordered from the finest to the coarsest
+func retentionTiers(policy *v1alpha1.BackupRetentionPolicy) []retentionTier {
+	return []retentionTier{
+		{
+			name:  "daily",
+			count: policy.Daily,
+			bucket: func(t time.Time) string {
+				return t.Format("2006-01-02")
+			},
+		},
+		{
+			name:  "weekly",
+			count: policy.Weekly,
+			bucket: func(t time.Time) string {
+				year, week := t.ISOWeek()
+				return fmt.Sprintf("%d-W%02d", year, week)
+			},
+		},
+		{
+			name:  "monthly",
+			count: policy.Monthly,
+			bucket: func(t time.Time) string {
+				return t.Format("2006-01")
+			},
+		},
+		{
+			name:  "yearly",
+			count: policy.Yearly,
+			bucket: func(t time.Time) string {
+				return t.Format("2006")
+			},
+		},
+	}
+}
+
+func validateRetentionPolicy(bs *v1alpha1.BackupSchedule) error {
+	policy := bs.Spec.RetentionPolicy
+	if policy.Daily < 0 || policy.Weekly < 0 || policy.Monthly < 0 || policy.Yearly < 0 {
+		return fmt.Errorf("retention policy should not be negative in spec of %s/%s", bs.GetNamespace(), bs.GetName())
+	}
+	if policy.Daily == 0 && policy.Weekly == 0 && policy.Monthly == 0 && policy.Yearly == 0 {
+		return fmt.Errorf("at least one tier of retention policy should be set in spec of %s/%s", bs.GetNamespace(), bs.GetName())
+	}
+	return nil
+}
+
+// backupGCByRetentionPolicy deletes the snapshot backups which are not kept by the retention policy,
+// and truncates the log backup to the oldest backup kept by the finest tier.
+func (bm *backupScheduleManager) backupGCByRetentionPolicy(bs *v1alpha1.BackupSchedule) {
+	ns := bs.GetNamespace()
+	bsName := bs.GetName()
+
+	if err := validateRetentionPolicy(bs); err != nil {
+		klog.Errorf("backup schedule %s/%s, invalid retention policy, err: %s", ns, bsName, err)
+		return
+	}
+
+	backupsList, err := bm.getBackupList(bs)
+	if err != nil {
+		klog.Errorf("backupGCByRetentionPolicy, err: %s", err)
+		return
+	}
+
+	ascBackups, logBackup := separateSnapshotBackupsAndLogBackup(backupsList)
+	if len(ascBackups) == 0 {
+		return
+	}
+
+	expiredBackups, pitrBackup, err := calExpiredBackupsByRetentionPolicy(ascBackups, bs.Spec.RetentionPolicy)
+	if err != nil {
+		klog.Errorf("caculate expired backups by retention policy, err: %s", err)
+		return
+	}
+
+	for _, backup := range expiredBackups {
+		// the backup being verified is deleted after the verification
+		if verify := bs.Status.Verification; verify != nil && verify.Phase == v1alpha1.BackupVerifyRunning && verify.Backup == backup.GetName() {
+			continue
+		}
+		if err = bm.deps.BackupControl.DeleteBackup(backup); err != nil {
+			klog.Errorf("backup schedule %s/%s gc backup %s failed, err %v", ns, bsName, backup.GetName(), err)
+			return
+		}
+		klog.Infof("backup schedule %s/%s gc backup %s success", ns, bsName, backup.GetName())
+	}
+
+	if logBackup == nil || pitrBackup == nil {
+		return
+	}
+
+	truncateTSO, err := calLogBackupTruncateTSOByRetentionPolicy(pitrBackup, logBackup)
+	if err != nil {
+		klog.Errorf("caculate log backup truncate tso by retention policy, err: %s", err)
+		return
+	}
+	if compactProgress := getCompactProgress(bs); truncateTSO > compactProgress {
+		truncateTSO = compactProgress
+	}
+	if truncateTSO == 0 {
+		return
+	}
+
+	if truncateTSO > getLogTruncateUntil(logBackup) {
+		if err = bm.deps.BackupControl.TruncateLogBackup(logBackup, truncateTSO); err != nil {
+			klog.Errorf("backup schedule %s/%s truncate log backup %s failed, truncateTSO %d, err %v", ns, bsName, logBackup.GetName(), truncateTSO, err)
+			return
+		}
+		klog.Infof("backup schedule %s/%s truncate log backup %s success, truncateTSO %d", ns, bsName, logBackup.GetName(), truncateTSO)
+	}
+
+	bm.compactGCByTruncateTSO(bs, truncateTSO)
+}
+
+// compactGCByTruncateTSO deletes the finished compacts which only cover the truncated logs
+func (bm *backupScheduleManager) compactGCByTruncateTSO(bs *v1alpha1.BackupSchedule, truncateTSO uint64) {
+	ns := bs.GetNamespace()
+	bsName := bs.GetName()
+
+	compactList, err := bm.getCompactList(bs)
+	if err != nil {
+		klog.Errorf("compactGCByTruncateTSO, err: %s", err)
+		return
+	}
+
+	var deleteCount int
+	for _, compact := range compactList {
+		state := compact.Status.State
+		if state != string(v1alpha1.BackupComplete) && state != string(v1alpha1.BackupFailed) {
+			continue
+		}
+		endTs, err := config.ParseTSString(compact.Spec.EndTs)
+		if err != nil {
+			klog.Errorf("backup schedule %s/%s parse end ts %s of compact %s failed, err %v", ns, bsName, compact.Spec.EndTs, compact.GetName(), err)
+			continue
+		}
+		if endTs > truncateTSO {
+			continue
+		}
+		if err = bm.deps.CompactControl.DeleteCompactBackup(compact); err != nil {
+			klog.Errorf("backup schedule %s/%s gc compact %s failed, err %v", ns, bsName, compact.GetName(), err)
+			return
+		}
+		deleteCount++
+		klog.Infof("backup schedule %s/%s gc compact %s success", ns, bsName, compact.GetName())
+	}
+
+	if deleteCount > 0 && deleteCount == len(compactList) {
+		bs.Status.LastCompact = ""
+	}
+}
+
+// calExpiredBackupsByRetentionPolicy calculates what backups should be deleted by the retention policy,
+// backupsList should be ordered by create time asc.
+// It also returns the oldest backup kept by the finest tier, which is the start point of PiTR.
+//
+// In each tier, the latest backup in each of the latest N buckets is kept. For example, with Daily 2 and Weekly 2,
+// bk1 and bk2 are taken in the last week, bk3 and bk4 are taken yesterday and bk5 and bk6 today in this week,
+// then bk6 and bk4 are kept as the daily backups, bk6 and bk2 are kept as the weekly backups,
+// bk1, bk3 and bk5 are deleted, and bk4 is returned as the start point of PiTR.
+func calExpiredBackupsByRetentionPolicy(backupsList []*v1alpha1.Backup, policy *v1alpha1.BackupRetentionPolicy) ([]*v1alpha1.Backup, *v1alpha1.Backup, error) {
+	var (
+		completed     []*v1alpha1.Backup
+		completedTime []time.Time
+	)
+	for _, backup := range backupsList {
+		if !v1alpha1.IsBackupComplete(backup) {
+			continue
+		}
+		tso, err := config.ParseTSString(backup.Status.CommitTs)
+		if err != nil {
+			return nil, nil, perrors.Annotatef(err, "parse backup ts of backup %s/%s", backup.Namespace, backup.Name)
+		}
+		completed = append(completed, backup)
+		completedTime = append(completedTime, time.Unix(config.TSOToTS(tso), 0).UTC())
+	}
+	if len(completed) == 0 {
+		// the failed backups are kept until a backup is completed, so that the failures can be inspected
+		return nil, nil, nil
+	}
+
+	kept := make(map[*v1alpha1.Backup]bool, len(completed))
+	// the latest completed backup is always kept
+	latest := completed[len(completed)-1]
+	kept[latest] = true
+	pitrBackup := latest
+	finestTier := true
+	for _, tier := range retentionTiers(policy) {
+		if tier.count <= 0 {
+			continue
+		}
+		var (
+			lastBucket string
+			buckets    int32
+		)
+		for i := len(completed) - 1; i >= 0; i-- {
+			bucket := tier.bucket(completedTime[i])
+			if bucket == lastBucket {
+				continue
+			}
+			lastBucket = bucket
+			buckets++
+			if buckets > tier.count {
+				break
+			}
+			kept[completed[i]] = true
+			if finestTier {
+				pitrBackup = completed[i]
+			}
+		}
+		finestTier = false
+	}
+
+	var expiredBackups []*v1alpha1.Backup
+	for _, backup := range backupsList {
+		if kept[backup] {
+			continue
+		}
+		// the failed backups after the latest completed backup are kept
+		if !v1alpha1.IsBackupComplete(backup) && !backup.CreationTimestamp.Before(&latest.CreationTimestamp) {
+			continue
+		}
+		expiredBackups = append(expiredBackups, backup)
+	}
+	return expiredBackups, pitrBackup, nil
+}
+
+// calLogBackupTruncateTSOByRetentionPolicy returns the commit ts of the start point of PiTR
+// if it is within the range of the log backup, otherwise 0.
+func calLogBackupTruncateTSOByRetentionPolicy(pitrBackup, logBackup *v1alpha1.Backup) (uint64, error) {
+	truncateTSO, err := config.ParseTSString(pitrBackup.Status.CommitTs)
+	if err != nil {
+		return 0, perrors.Annotatef(err, "parse backup ts of backup %s/%s", pitrBackup.Namespace, pitrBackup.Name)
+	}
+	isTruncateTSOInLogBackup, err := checkTruncateTSOWithinLogBackupRange(logBackup, truncateTSO)
+	if err != nil {
+		return 0, perrors.Annotate(err, "check truncate ts in log backup")
+	}
+	if isTruncateTSOInLogBackup {
+		return truncateTSO, nil
+	}
+	return 0, nil
+}
+
+// getLogTruncateUntil returns the ts which the log backup is requested to be truncated to, 0 if it is not set
+func getLogTruncateUntil(logBackup *v1alpha1.Backup) uint64 {
+	if logBackup.Spec.LogTruncateUntil == "" {
+		return 0
+	}
+	tso, err := config.ParseTSString(logBackup.Spec.LogTruncateUntil)
+	if err != nil {
+		return 0
+	}
+	return tso
+}
@@ -0,0 +1,154 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupschedule
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newRetentionBackup(name string, committed time.Time, complete bool) *v1alpha1.Backup {
+	bk := &v1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "ns",
+			Name:              name,
+			CreationTimestamp: metav1.Time{Time: committed},
+		},
+	}
+	condType := v1alpha1.BackupFailed
+	if complete {
+		condType = v1alpha1.BackupComplete
+		bk.Status.CommitTs = getTSOStr(committed.Unix())
+	}
+	bk.Status.Conditions = append(bk.Status.Conditions, v1alpha1.BackupCondition{
+		Type:   condType,
+		Status: v1.ConditionTrue,
+	})
+	return bk
+}
+
+func backupNames(backups []*v1alpha1.Backup) []string {
+	names := make([]string, 0, len(backups))
+	for _, bk := range backups {
+		names = append(names, bk.Name)
+	}
+	return names
+}
+
+func TestCalExpiredBackupsByRetentionPolicy(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	// a Wednesday
+	now := time.Date(2024, 3, 13, 12, 0, 0, 0, time.UTC)
+	// a backup at 1:00 every day in the last 400 days
+	var backups []*v1alpha1.Backup
+	for i := 399; i >= 0; i-- {
+		committed := time.Date(2024, 3, 13, 1, 0, 0, 0, time.UTC).AddDate(0, 0, -i)
+		backups = append(backups, newRetentionBackup(committed.Format("2006-01-02"), committed, true))
+	}
+
+	type testcase struct {
+		name         string
+		policy       *v1alpha1.BackupRetentionPolicy
+		expectedKept []string
+		expectedPITR string
+	}
+	cases := []testcase{
+		{
+			name:         "daily",
+			policy:       &v1alpha1.BackupRetentionPolicy{Daily: 3},
+			expectedKept: []string{"2024-03-11", "2024-03-12", "2024-03-13"},
+			expectedPITR: "2024-03-11",
+		},
+		{
+			name:         "daily and weekly",
+			policy:       &v1alpha1.BackupRetentionPolicy{Daily: 2, Weekly: 3},
+			expectedKept: []string{"2024-03-03", "2024-03-10", "2024-03-12", "2024-03-13"},
+			expectedPITR: "2024-03-12",
+		},
+		{
+			name:         "monthly and yearly",
+			policy:       &v1alpha1.BackupRetentionPolicy{Monthly: 2, Yearly: 2},
+			expectedKept: []string{"2023-12-31", "2024-02-29", "2024-03-13"},
+			expectedPITR: "2024-02-29",
+		},
+	}
+
+	for _, tcase := range cases {
+		t.Log(tcase.name)
+		expired, pitrBackup, err := calExpiredBackupsByRetentionPolicy(backups, tcase.policy)
+		g.Expect(err).Should(BeNil())
+		g.Expect(len(expired)).Should(Equal(len(backups) - len(tcase.expectedKept)))
+		expiredNames := backupNames(expired)
+		for _, name := range tcase.expectedKept {
+			g.Expect(expiredNames).ShouldNot(ContainElement(name))
+		}
+		g.Expect(pitrBackup.Name).Should(Equal(tcase.expectedPITR))
+	}
+
+	// failed backups are deleted only if there is a later completed backup
+	failedBefore := newRetentionBackup("failed-before", now.Add(-2*time.Hour), false)
+	completed := newRetentionBackup("completed", now.Add(-time.Hour), true)
+	failedAfter := newRetentionBackup("failed-after", now, false)
+	expired, pitrBackup, err := calExpiredBackupsByRetentionPolicy([]*v1alpha1.Backup{failedBefore, completed, failedAfter}, &v1alpha1.BackupRetentionPolicy{Daily: 1})
+	g.Expect(err).Should(BeNil())
+	g.Expect(backupNames(expired)).Should(Equal([]string{"failed-before"}))
+	g.Expect(pitrBackup.Name).Should(Equal("completed"))
+
+	expired, pitrBackup, err = calExpiredBackupsByRetentionPolicy([]*v1alpha1.Backup{failedBefore, failedAfter}, &v1alpha1.BackupRetentionPolicy{Daily: 1})
+	g.Expect(err).Should(BeNil())
+	g.Expect(expired).Should(BeEmpty())
+	g.Expect(pitrBackup).Should(BeNil())
+}
+
+func TestCalLogBackupTruncateTSOByRetentionPolicy(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var (
+		now      = time.Now()
+		last1Day = now.Add(-time.Hour * 24 * 1).Unix()
+		last2Day = now.Add(-time.Hour * 24 * 2).Unix()
+		last3Day = now.Add(-time.Hour * 24 * 3).Unix()
+	)
+
+	pitrBackup := fakeBackup(&last2Day)
+	truncateTSO, err := calLogBackupTruncateTSOByRetentionPolicy(pitrBackup, fakeLogBackup(&last3Day, &last1Day))
+	g.Expect(err).Should(BeNil())
+	g.Expect(truncateTSO).Should(Equal(getTSO(last2Day)))
+
+	// the log backup starts after the backup, nothing to truncate
+	truncateTSO, err = calLogBackupTruncateTSOByRetentionPolicy(pitrBackup, fakeLogBackup(&last1Day, &last1Day))
+	g.Expect(err).Should(BeNil())
+	g.Expect(truncateTSO).Should(BeZero())
+}
+
+func TestValidateRetentionPolicy(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	bs := &v1alpha1.BackupSchedule{}
+	bs.Namespace = "ns"
+	bs.Name = "bs"
+	for _, policy := range []v1alpha1.BackupRetentionPolicy{{}, {Daily: -1, Weekly: 1}} {
+		bs.Spec.RetentionPolicy = &policy
+		g.Expect(validateRetentionPolicy(bs)).ShouldNot(BeNil(), fmt.Sprintf("%+v", policy))
+	}
+	bs.Spec.RetentionPolicy = &v1alpha1.BackupRetentionPolicy{Weekly: 4}
+	g.Expect(validateRetentionPolicy(bs)).Should(BeNil())
+}
@@ -38,15 +38,17 @@ import (
 type nowFn func() time.Time
 
 type backupScheduleManager struct {
-	deps *controller.Dependencies
-	now  nowFn
+	deps                *controller.Dependencies
+	now                 nowFn
+	backupStatusUpdater controller.BackupConditionUpdaterInterface
 }
 
 // NewBackupScheduleManager return a *backupScheduleManager
 func NewBackupScheduleManager(deps *controller.Dependencies) backup.BackupScheduleManager {
 	return &backupScheduleManager{
-		deps: deps,
-		now:  time.Now,
+		deps:                deps,
+		now:                 time.Now,
+		backupStatusUpdater: controller.NewRealBackupConditionUpdater(deps.Clientset, deps.BackupLister, deps.Recorder),
 	}
 }
 
@@ -150,6 +152,16 @@ func (bm *backupScheduleManager) Sync(bs *v1alpha1.BackupSchedule) (err error) {
 		}
 	}
 
+	// verify
+	defer func() {
+		if bs.Spec.Verify == nil {
+			return
+		}
+		if err := bm.syncVerify(bs); err != nil {
+			klog.Errorf("backupSchedule %s/%s verify backup failed, err: %v", bs.GetNamespace(), bs.GetName(), err)
+		}
+	}()
+
 	// compact
 	defer func() {
 		if bs.Spec.LogBackupTemplate == nil || bs.Spec.CompactBackupTemplate == nil {
@@ -459,6 +471,12 @@ func buildBackup(bs *v1alpha1.BackupSchedule, timestamp time.Time) *v1alpha1.Bac
 	bsName := bs.GetName()
 
 	backupSpec := *bs.Spec.BackupTemplate.DeepCopy()
+	if len(backupSpec.Replicas) == 0 && len(bs.Spec.Replicas) > 0 {
+		backupSpec.Replicas = make([]v1alpha1.StorageProvider, len(bs.Spec.Replicas))
+		for i := range bs.Spec.Replicas {
+			backupSpec.Replicas[i] = *bs.Spec.Replicas[i].DeepCopy()
+		}
+	}
 	if backupSpec.BR == nil {
 		if backupSpec.StorageClassName == nil || *backupSpec.StorageClassName == "" {
 			backupSpec.StorageClassName = bs.Spec.StorageClassName
@@ -480,14 +498,9 @@ func buildBackup(bs *v1alpha1.BackupSchedule, timestamp time.Time) *v1alpha1.Bac
 		pdAddress = fmt.Sprintf("%s-pd.%s:%d", backupSpec.BR.Cluster, clusterNamespace, v1alpha1.DefaultPDClientPort)
 
 		backupPrefix := strings.ReplaceAll(pdAddress, ":", "-") + "-" + timestamp.UTC().Format(v1alpha1.BackupNameTimeFormat)
-		if backupSpec.S3 != nil {
-			backupSpec.S3.Prefix = path.Join(backupSpec.S3.Prefix, backupPrefix)
-		} else if backupSpec.Gcs != nil {
-			backupSpec.Gcs.Prefix = path.Join(backupSpec.Gcs.Prefix, backupPrefix)
-		} else if backupSpec.Azblob != nil {
-			backupSpec.Azblob.Prefix = path.Join(backupSpec.Azblob.Prefix, backupPrefix)
-		} else if backupSpec.Local != nil {
-			backupSpec.Local.Prefix = path.Join(backupSpec.Local.Prefix, backupPrefix)
+		joinStoragePrefix(&backupSpec.StorageProvider, backupPrefix)
+		for i := range backupSpec.Replicas {
+			joinStoragePrefix(&backupSpec.Replicas[i], backupPrefix)
 		}
 	}
 
@@ -512,6 +525,19 @@ func buildBackup(bs *v1alpha1.BackupSchedule, timestamp time.Time) *v1alpha1.Bac
 	return backup
 }
 
+// joinStoragePrefix appends the sub path to the prefix of the storage
+func joinStoragePrefix(provider *v1alpha1.StorageProvider, subPath string) {
+	if provider.S3 != nil {
+		provider.S3.Prefix = path.Join(provider.S3.Prefix, subPath)
+	} else if provider.Gcs != nil {
+		provider.Gcs.Prefix = path.Join(provider.Gcs.Prefix, subPath)
+	} else if provider.Azblob != nil {
+		provider.Azblob.Prefix = path.Join(provider.Azblob.Prefix, subPath)
+	} else if provider.Local != nil {
+		provider.Local.Prefix = path.Join(provider.Local.Prefix, subPath)
+	}
+}
+
 func buildLogBackup(bs *v1alpha1.BackupSchedule, timestamp time.Time) *v1alpha1.Backup {
 	ns := bs.GetNamespace()
 	bsName := bs.GetName()
@@ -607,6 +633,12 @@ func (bm *backupScheduleManager) backupGC(bs *v1alpha1.BackupSchedule) {
 	ns := bs.GetNamespace()
 	bsName := bs.GetName()
 
+	// RetentionPolicy is preferred to MaxBackups and MaxReservedTime.
+	if bs.Spec.RetentionPolicy != nil {
+		bm.backupGCByRetentionPolicy(bs)
+		return
+	}
+
 	// if MaxBackups and MaxReservedTime are set at the same time, MaxReservedTime is preferred.
 	if bs.Spec.MaxReservedTime != nil {
 		bm.backupGCByMaxReservedTime(bs)
@@ -672,15 +704,7 @@ func (bm *backupScheduleManager) backupGCByMaxReservedTime(bs *v1alpha1.BackupSc
 		klog.Infof("backup schedule %s/%s gc backup %s success", ns, bsName, backup.GetName())
 	}
 
-	var compactProgress uint64
-	if bs.Spec.CompactBackupTemplate == nil {
-		compactProgress = math.MaxUint64
-	} else if bs.Status.LastCompactTs == nil {
-		compactProgress = 0
-	} else {
-		compactProgress = config.GoTimeToTS(bs.Status.LastCompactTs.Time)
-	}
-
+	compactProgress := getCompactProgress(bs)
 	if truncateTSO > compactProgress {
 		truncateTSO = compactProgress
 	}
@@ -700,6 +724,18 @@ func (bm *backupScheduleManager) backupGCByMaxReservedTime(bs *v1alpha1.BackupSc
 	}
 }
 
+// getCompactProgress returns the ts which the log backup has been compacted to,
+// the log backup should not be truncated after it, or the logs not compacted yet would be lost.
+func getCompactProgress(bs *v1alpha1.BackupSchedule) uint64 {
+	if bs.Spec.CompactBackupTemplate == nil {
+		return math.MaxUint64
+	}
+	if bs.Status.LastCompactTs == nil {
+		return 0
+	}
+	return config.GoTimeToTS(bs.Status.LastCompactTs.Time)
+}
+
 func (bm *backupScheduleManager) compactGCByMaxReservedTime(bs *v1alpha1.BackupSchedule) {
 	ns := bs.GetNamespace()
 	bsName := bs.GetName()
@@ -370,6 +370,21 @@ func TestBuildBackup(t *testing.T) {
 	if diff := cmp.Diff(bk, get); diff != "" {
 		t.Errorf("unexpected (-want, +got): %s", diff)
 	}
+
+	// should inherit replicas from BackupSchedule and join the backup prefix
+	bs.Spec.Replicas = []v1alpha1.StorageProvider{
+		{S3: &v1alpha1.S3StorageProvider{Bucket: "dr", Prefix: "backup"}},
+	}
+	bk.Spec.Replicas = []v1alpha1.StorageProvider{
+		{S3: &v1alpha1.S3StorageProvider{Bucket: "dr", Prefix: "backup/-pd.ns-2379-" + now.UTC().Format(v1alpha1.BackupNameTimeFormat)}},
+	}
+	get = buildBackup(bs, now)
+	if diff := cmp.Diff(bk, get); diff != "" {
+		t.Errorf("unexpected (-want, +got): %s", diff)
+	}
+	if bs.Spec.Replicas[0].S3.Prefix != "backup" {
+		t.Errorf("replicas of backup schedule should not be changed")
+	}
 }
 
 func TestCalculateExpiredBackupsWithLogBackup(t *testing.T) {
@@ -0,0 +1,547 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupschedule
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/util"
+	utiltidbcluster "github.com/pingcap/tidb-operator/pkg/util/tidbcluster"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/pointer"
+)
+
+const (
+	defaultVerifyTimeout  = 2 * time.Hour
+	defaultVerifySQLImage = "mysql:8.0"
+
+	// sqlAssertionScript runs the SQL assertions passed by env and compares the outputs with the expected ones,
+	// the password is passed to mysql client by MYSQL_PWD
+	sqlAssertionScript = `i=0
+while [ "$i" -lt "$ASSERTION_COUNT" ]; do
+  eval name=\$ASSERTION_${i}_NAME
+  eval query=\$ASSERTION_${i}_QUERY
+  eval expected=\$ASSERTION_${i}_EXPECTED
+  if ! output=$(mysql -h "$TIDB_HOST" -P "$TIDB_PORT" -u "${TIDB_USER:-root}" $TIDB_SSL_ARGS -N -B -e "$query"); then
+    echo "assertion $name failed to run"
+    exit 1
+  fi
+  if [ -n "$expected" ] && [ "$output" != "$expected" ]; then
+    echo "assertion $name failed, expected: $expected, got: $output"
+    exit 1
+  fi
+  echo "assertion $name passed"
+  i=$((i+1))
+done`
+)
+
+// verifyResourceName returns the name of the TidbCluster and Restore created for backup verification
+func verifyResourceName(bs *v1alpha1.BackupSchedule) string {
+	return fmt.Sprintf("%s-verify", bs.GetName())
+}
+
+// verifySQLJobName returns the name of the job running SQL assertions
+func verifySQLJobName(bs *v1alpha1.BackupSchedule) string {
+	return fmt.Sprintf("%s-verify-sql", bs.GetName())
+}
+
+// syncVerify verifies the snapshot backups by restoring them into a short-lived TidbCluster.
+// Only one backup is verified at a time, and the progress is recorded in bs.Status.Verification.
+func (bm *backupScheduleManager) syncVerify(bs *v1alpha1.BackupSchedule) error {
+	if err := validateVerify(bs); err != nil {
+		return err
+	}
+
+	status := bs.Status.Verification
+	if status != nil && status.Phase == v1alpha1.BackupVerifyRunning {
+		return bm.runVerify(bs)
+	}
+
+	// the resources of the last verification should be deleted before starting a new one
+	cleaned, err := bm.cleanVerifyResources(bs)
+	if err != nil {
+		return err
+	}
+	if !cleaned {
+		klog.Infof("backup schedule %s/%s, waiting for the resources of the last verification to be deleted", bs.GetNamespace(), bs.GetName())
+		return nil
+	}
+
+	backupsList, err := bm.getBackupList(bs)
+	if err != nil {
+		return err
+	}
+	backup := nextBackupToVerify(bs, backupsList)
+	if backup == nil {
+		return nil
+	}
+
+	klog.Infof("backup schedule %s/%s start to verify backup %s", bs.GetNamespace(), bs.GetName(), backup.GetName())
+	bs.Status.Verification = &v1alpha1.BackupVerifyStatus{
+		Backup:    backup.GetName(),
+		Phase:     v1alpha1.BackupVerifyRunning,
+		StartTime: &metav1.Time{Time: bm.now()},
+	}
+	return nil
+}
+
+// runVerify drives the running verification: create the TidbCluster, restore the backup into it,
+// run the SQL assertions, and then record the result.
+func (bm *backupScheduleManager) runVerify(bs *v1alpha1.BackupSchedule) error {
+	ns := bs.GetNamespace()
+	bsName := bs.GetName()
+	status := bs.Status.Verification
+	name := verifyResourceName(bs)
+
+	backup, err := bm.deps.BackupLister.Backups(ns).Get(status.Backup)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return bm.finishVerify(bs, nil, false, fmt.Sprintf("backup %s is not found", status.Backup))
+		}
+		return fmt.Errorf("backup schedule %s/%s, get backup %s failed, err: %v", ns, bsName, status.Backup, err)
+	}
+	if backup.Spec.BR == nil {
+		return bm.finishVerify(bs, backup, false, "only the backups taken by BR can be verified")
+	}
+
+	timeout := getVerifyTimeout(bs)
+	if status.StartTime != nil && bm.now().Sub(status.StartTime.Time) > timeout {
+		return bm.finishVerify(bs, backup, false, fmt.Sprintf("verification is not finished in %v", timeout))
+	}
+
+	// create the TidbCluster to restore the backup into
+	tc, err := bm.deps.TiDBClusterLister.TidbClusters(ns).Get(name)
+	if errors.IsNotFound(err) {
+		tc = buildVerifyCluster(bs, backup)
+		if _, err := bm.deps.Clientset.PingcapV1alpha1().TidbClusters(ns).Create(context.TODO(), tc, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("backup schedule %s/%s, create tidbcluster %s for verification failed, err: %v", ns, bsName, name, err)
+		}
+		klog.Infof("backup schedule %s/%s, tidbcluster %s is created to verify backup %s", ns, bsName, name, backup.GetName())
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("backup schedule %s/%s, get tidbcluster %s failed, err: %v", ns, bsName, name, err)
+	}
+	if !metav1.IsControlledBy(tc, bs) || tc.Annotations[label.AnnVerifyBackup] != backup.GetName() {
+		return bm.finishVerify(bs, backup, false, fmt.Sprintf("tidbcluster %s is not created to verify backup %s", name, backup.GetName()))
+	}
+	if cond := utiltidbcluster.GetTidbClusterReadyCondition(tc.Status); cond == nil || cond.Status != corev1.ConditionTrue {
+		klog.Infof("backup schedule %s/%s, waiting for tidbcluster %s to be ready", ns, bsName, name)
+		return nil
+	}
+
+	// restore the backup into the TidbCluster
+	restore, err := bm.deps.RestoreLister.Restores(ns).Get(name)
+	if errors.IsNotFound(err) {
+		restore = buildVerifyRestore(bs, backup)
+		if _, err := bm.deps.Clientset.PingcapV1alpha1().Restores(ns).Create(context.TODO(), restore, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("backup schedule %s/%s, create restore %s for verification failed, err: %v", ns, bsName, name, err)
+		}
+		klog.Infof("backup schedule %s/%s, restore %s is created to verify backup %s", ns, bsName, name, backup.GetName())
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("backup schedule %s/%s, get restore %s failed, err: %v", ns, bsName, name, err)
+	}
+	if v1alpha1.IsRestoreFailed(restore) || v1alpha1.IsRestoreInvalid(restore) {
+		return bm.finishVerify(bs, backup, false, fmt.Sprintf("restore %s failed", name))
+	}
+	if !v1alpha1.IsRestoreComplete(restore) {
+		klog.Infof("backup schedule %s/%s, waiting for restore %s to complete", ns, bsName, name)
+		return nil
+	}
+
+	// run the SQL assertions against the restored TidbCluster
+	if len(bs.Spec.Verify.SQLAssertions) > 0 {
+		jobName := verifySQLJobName(bs)
+		job, err := bm.deps.JobLister.Jobs(ns).Get(jobName)
+		if errors.IsNotFound(err) {
+			return bm.deps.JobControl.CreateJob(bs, buildVerifySQLJob(bs, tc))
+		}
+		if err != nil {
+			return fmt.Errorf("backup schedule %s/%s, get job %s failed, err: %v", ns, bsName, jobName, err)
+		}
+		if isJobFinished(job, batchv1.JobFailed) {
+			return bm.finishVerify(bs, backup, false, fmt.Sprintf("sql assertions failed, see the logs of job %s for details", jobName))
+		}
+		if !isJobFinished(job, batchv1.JobComplete) {
+			klog.Infof("backup schedule %s/%s, waiting for job %s to complete", ns, bsName, jobName)
+			return nil
+		}
+	}
+
+	return bm.finishVerify(bs, backup, true, "")
+}
+
+// finishVerify records the result of the verification on the backup and the backup schedule,
+// and deletes the resources of the verification.
+func (bm *backupScheduleManager) finishVerify(bs *v1alpha1.BackupSchedule, backup *v1alpha1.Backup, succeeded bool, message string) error {
+	status := bs.Status.Verification
+	if backup != nil {
+		condition := &v1alpha1.BackupCondition{
+			Type:    v1alpha1.BackupVerified,
+			Status:  corev1.ConditionTrue,
+			Reason:  "VerifySucceeded",
+			Message: message,
+		}
+		if !succeeded {
+			condition.Status = corev1.ConditionFalse
+			condition.Reason = "VerifyFailed"
+		}
+		if err := bm.backupStatusUpdater.Update(backup, condition, nil); err != nil {
+			return fmt.Errorf("backup schedule %s/%s, update verified condition of backup %s failed, err: %v", bs.GetNamespace(), bs.GetName(), status.Backup, err)
+		}
+	}
+
+	status.CompletionTime = &metav1.Time{Time: bm.now()}
+	status.Message = message
+	if succeeded {
+		status.Phase = v1alpha1.BackupVerifySucceeded
+		bs.Status.LastVerifiedBackup = status.Backup
+		klog.Infof("backup schedule %s/%s, backup %s is verified", bs.GetNamespace(), bs.GetName(), status.Backup)
+	} else {
+		status.Phase = v1alpha1.BackupVerifyFailed
+		klog.Errorf("backup schedule %s/%s, verify backup %s failed: %s", bs.GetNamespace(), bs.GetName(), status.Backup, message)
+		bm.deps.Recorder.Eventf(bs, corev1.EventTypeWarning, "BackupVerifyFailed", "verify backup %s failed: %s", status.Backup, message)
+	}
+
+	// the resources not deleted in time will be deleted before the next verification
+	if _, err := bm.cleanVerifyResources(bs); err != nil {
+		klog.Errorf("backup schedule %s/%s, clean the resources of verification failed, err: %v", bs.GetNamespace(), bs.GetName(), err)
+	}
+	return nil
+}
+
+// cleanVerifyResources deletes the job, restore and TidbCluster created for verification, and the PVCs
+// of the TidbCluster after it's deleted, which are not deleted with the TidbCluster and would be reused
+// by the next verification. It returns true if all of them have been deleted.
+func (bm *backupScheduleManager) cleanVerifyResources(bs *v1alpha1.BackupSchedule) (bool, error) {
+	ns := bs.GetNamespace()
+	bsName := bs.GetName()
+	name := verifyResourceName(bs)
+	cleaned := true
+
+	jobName := verifySQLJobName(bs)
+	job, err := bm.deps.JobLister.Jobs(ns).Get(jobName)
+	if err == nil {
+		cleaned = false
+		if metav1.IsControlledBy(job, bs) && job.DeletionTimestamp == nil {
+			if err := bm.deps.JobControl.DeleteJob(bs, job); err != nil {
+				return false, err
+			}
+		}
+	} else if !errors.IsNotFound(err) {
+		return false, fmt.Errorf("backup schedule %s/%s, get job %s failed, err: %v", ns, bsName, jobName, err)
+	}
+
+	restore, err := bm.deps.RestoreLister.Restores(ns).Get(name)
+	if err == nil {
+		cleaned = false
+		if metav1.IsControlledBy(restore, bs) && restore.DeletionTimestamp == nil {
+			if err := bm.deps.Clientset.PingcapV1alpha1().Restores(ns).Delete(context.TODO(), name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+				return false, fmt.Errorf("backup schedule %s/%s, delete restore %s failed, err: %v", ns, bsName, name, err)
+			}
+		}
+	} else if !errors.IsNotFound(err) {
+		return false, fmt.Errorf("backup schedule %s/%s, get restore %s failed, err: %v", ns, bsName, name, err)
+	}
+
+	tc, err := bm.deps.TiDBClusterLister.TidbClusters(ns).Get(name)
+	if err == nil {
+		cleaned = false
+		if metav1.IsControlledBy(tc, bs) && tc.DeletionTimestamp == nil {
+			if err := bm.deps.Clientset.PingcapV1alpha1().TidbClusters(ns).Delete(context.TODO(), name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+				return false, fmt.Errorf("backup schedule %s/%s, delete tidbcluster %s failed, err: %v", ns, bsName, name, err)
+			}
+		}
+	} else if !errors.IsNotFound(err) {
+		return false, fmt.Errorf("backup schedule %s/%s, get tidbcluster %s failed, err: %v", ns, bsName, name, err)
+	} else {
+		pvcCleaned, err := bm.cleanVerifyPVCs(bs)
+		if err != nil {
+			return false, err
+		}
+		cleaned = cleaned && pvcCleaned
+	}
+
+	return cleaned, nil
+}
+
+// cleanVerifyPVCs deletes the PVCs of the TidbCluster created for verification.
+// It returns true if all of them have been deleted.
+func (bm *backupScheduleManager) cleanVerifyPVCs(bs *v1alpha1.BackupSchedule) (bool, error) {
+	ns := bs.GetNamespace()
+	name := verifyResourceName(bs)
+	selector, err := label.New().Instance(name).Selector()
+	if err != nil {
+		return false, fmt.Errorf("backup schedule %s/%s, generate selector of pvcs failed, err: %v", ns, bs.GetName(), err)
+	}
+	pvcs, err := bm.deps.PVCLister.PersistentVolumeClaims(ns).List(selector)
+	if err != nil {
+		return false, fmt.Errorf("backup schedule %s/%s, list pvcs of tidbcluster %s failed, err: %v", ns, bs.GetName(), name, err)
+	}
+	for _, pvc := range pvcs {
+		if pvc.DeletionTimestamp != nil {
+			continue
+		}
+		if err := bm.deps.PVCControl.DeletePVC(bs, pvc); err != nil && !errors.IsNotFound(err) {
+			return false, err
+		}
+	}
+	return len(pvcs) == 0, nil
+}
+
+// nextBackupToVerify returns the latest completed snapshot backup if there are at least
+// Spec.Verify.Every completed backups after the last verified one, otherwise returns nil.
+func nextBackupToVerify(bs *v1alpha1.BackupSchedule, backupsList []*v1alpha1.Backup) *v1alpha1.Backup {
+	every := bs.Spec.Verify.Every
+	if every < 1 {
+		every = 1
+	}
+
+	completed := make([]*v1alpha1.Backup, 0, len(backupsList))
+	for _, backup := range backupsList {
+		if backup.Spec.Mode == v1alpha1.BackupModeLog || backup.Spec.Mode == v1alpha1.BackupModeVolumeSnapshot {
+			continue
+		}
+		if backup.DeletionTimestamp != nil || !v1alpha1.IsBackupComplete(backup) {
+			continue
+		}
+		completed = append(completed, backup)
+	}
+	if len(completed) == 0 {
+		return nil
+	}
+	sort.Sort(byCreateTimeDesc(completed))
+
+	var lastVerified string
+	if bs.Status.Verification != nil {
+		lastVerified = bs.Status.Verification.Backup
+	}
+	count := int32(0)
+	for _, backup := range completed {
+		if backup.GetName() == lastVerified {
+			break
+		}
+		count++
+	}
+	if count < every {
+		return nil
+	}
+	return completed[0]
+}
+
+func validateVerify(bs *v1alpha1.BackupSchedule) error {
+	verify := bs.Spec.Verify
+	if verify.ClusterTemplate == nil {
+		return fmt.Errorf("clusterTemplate should be configured for verify in spec of %s/%s", bs.GetNamespace(), bs.GetName())
+	}
+	if verify.Timeout != "" {
+		if _, err := time.ParseDuration(verify.Timeout); err != nil {
+			return fmt.Errorf("invalid verify timeout %s in spec of %s/%s, err: %v", verify.Timeout, bs.GetNamespace(), bs.GetName(), err)
+		}
+	}
+	return nil
+}
+
+func getVerifyTimeout(bs *v1alpha1.BackupSchedule) time.Duration {
+	if timeout, err := time.ParseDuration(bs.Spec.Verify.Timeout); err == nil && timeout > 0 {
+		return timeout
+	}
+	return defaultVerifyTimeout
+}
+
+func verifyObjectMeta(bs *v1alpha1.BackupSchedule, name string, backup *v1alpha1.Backup) metav1.ObjectMeta {
+	bsName := bs.GetName()
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: bs.GetNamespace(),
+		Labels:    label.NewBackupSchedule().Instance(bsName).BackupSchedule(bsName),
+		Annotations: map[string]string{
+			label.AnnVerifyBackup: backup.GetName(),
+		},
+		OwnerReferences: []metav1.OwnerReference{
+			controller.GetBackupScheduleOwnerRef(bs),
+		},
+	}
+}
+
+func buildVerifyCluster(bs *v1alpha1.BackupSchedule, backup *v1alpha1.Backup) *v1alpha1.TidbCluster {
+	return &v1alpha1.TidbCluster{
+		ObjectMeta: verifyObjectMeta(bs, verifyResourceName(bs), backup),
+		Spec:       *bs.Spec.Verify.ClusterTemplate.DeepCopy(),
+	}
+}
+
+func buildVerifyRestore(bs *v1alpha1.BackupSchedule, backup *v1alpha1.Backup) *v1alpha1.Restore {
+	verify := bs.Spec.Verify
+	name := verifyResourceName(bs)
+
+	checksum := verify.Checksum
+	if checksum == nil {
+		checksum = pointer.BoolPtr(true)
+	}
+	toolImage := verify.ToolImage
+	if toolImage == "" {
+		toolImage = backup.Spec.ToolImage
+	}
+
+	return &v1alpha1.Restore{
+		ObjectMeta: verifyObjectMeta(bs, name, backup),
+		Spec: v1alpha1.RestoreSpec{
+			Type: backup.Spec.Type,
+			Mode: v1alpha1.RestoreModeSnapshot,
+			BR: &v1alpha1.BRConfig{
+				Cluster:          name,
+				ClusterNamespace: bs.GetNamespace(),
+				DB:               backup.Spec.BR.DB,
+				Table:            backup.Spec.BR.Table,
+				Checksum:         checksum,
+				SendCredToTikv:   backup.Spec.BR.SendCredToTikv,
+			},
+			StorageProvider:  *backup.Spec.StorageProvider.DeepCopy(),
+			TableFilter:      backup.Spec.TableFilter,
+			Env:              backup.Spec.Env,
+			ToolImage:        toolImage,
+			ServiceAccount:   backup.Spec.ServiceAccount,
+			UseKMS:           backup.Spec.UseKMS,
+			ImagePullSecrets: backup.Spec.ImagePullSecrets,
+			Tolerations:      backup.Spec.Tolerations,
+			Affinity:         backup.Spec.Affinity,
+		},
+	}
+}
+
+func buildVerifySQLJob(bs *v1alpha1.BackupSchedule, tc *v1alpha1.TidbCluster) *batchv1.Job {
+	verify := bs.Spec.Verify
+	bsName := bs.GetName()
+
+	image := verify.SQLImage
+	if image == "" {
+		image = defaultVerifySQLImage
+	}
+	port := int32(v1alpha1.DefaultTiDBServerPort)
+	if tc.Spec.TiDB != nil {
+		port = tc.Spec.TiDB.GetServicePort()
+	}
+	envs := []corev1.EnvVar{
+		{Name: "TIDB_HOST", Value: controller.TiDBMemberName(tc.Name)},
+		{Name: "TIDB_PORT", Value: strconv.Itoa(int(port))},
+		{Name: "ASSERTION_COUNT", Value: strconv.Itoa(len(verify.SQLAssertions))},
+	}
+	for i, assertion := range verify.SQLAssertions {
+		envs = append(envs,
+			corev1.EnvVar{Name: fmt.Sprintf("ASSERTION_%d_NAME", i), Value: assertion.Name},
+			corev1.EnvVar{Name: fmt.Sprintf("ASSERTION_%d_QUERY", i), Value: assertion.Query},
+			corev1.EnvVar{Name: fmt.Sprintf("ASSERTION_%d_EXPECTED", i), Value: assertion.Expected},
+		)
+	}
+	if verify.SecretName != "" {
+		envs = append(envs,
+			corev1.EnvVar{
+				Name: "TIDB_USER",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: verify.SecretName},
+						Key:                  "user",
+					},
+				},
+			},
+			corev1.EnvVar{
+				Name: "MYSQL_PWD",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: verify.SecretName},
+						Key:                  "password",
+						Optional:             pointer.BoolPtr(true),
+					},
+				},
+			},
+		)
+	}
+
+	var volumes []corev1.Volume
+	var volumeMounts []corev1.VolumeMount
+	if tc.Spec.TiDB != nil && tc.Spec.TiDB.IsTLSClientEnabled() {
+		envs = append(envs, corev1.EnvVar{
+			Name: "TIDB_SSL_ARGS",
+			Value: fmt.Sprintf("--ssl-ca=%s --ssl-cert=%s --ssl-key=%s",
+				path.Join(util.TiDBClientTLSPath, corev1.ServiceAccountRootCAKey),
+				path.Join(util.TiDBClientTLSPath, corev1.TLSCertKey),
+				path.Join(util.TiDBClientTLSPath, corev1.TLSPrivateKeyKey)),
+		})
+		volumes = append(volumes, corev1.Volume{
+			Name: "tidb-client-tls",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: util.TiDBClientTLSSecretName(tc.Name, verify.TLSClientSecretName),
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name: "tidb-client-tls", ReadOnly: true, MountPath: util.TiDBClientTLSPath,
+		})
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      verifySQLJobName(bs),
+			Namespace: bs.GetNamespace(),
+			Labels:    label.NewBackupSchedule().Instance(bsName).BackupSchedule(bsName),
+			OwnerReferences: []metav1.OwnerReference{
+				controller.GetBackupScheduleOwnerRef(bs),
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: pointer.Int32Ptr(0),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy:    corev1.RestartPolicyNever,
+					ImagePullSecrets: bs.Spec.ImagePullSecrets,
+					Containers: []corev1.Container{
+						{
+							Name:         "sql-assertion",
+							Image:        image,
+							Command:      []string{"/bin/sh", "-c", sqlAssertionScript},
+							Env:          envs,
+							VolumeMounts: volumeMounts,
+						},
+					},
+					Volumes: volumes,
+				},
+			},
+		},
+	}
+}
+
+func isJobFinished(job *batchv1.Job, conditionType batchv1.JobConditionType) bool {
+	for _, c := range job.Status.Conditions {
+		if c.Type == conditionType && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,257 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupschedule
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+)
+
+func newVerifyBackup(name string, created time.Time) *v1alpha1.Backup {
+	bk := &v1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "ns",
+			Name:              name,
+			CreationTimestamp: metav1.Time{Time: created},
+			Labels:            label.NewBackupSchedule().Instance("bs").BackupSchedule("bs"),
+		},
+		Spec: v1alpha1.BackupSpec{
+			BR: &v1alpha1.BRConfig{Cluster: "tc"},
+		},
+	}
+	bk.Status.Conditions = append(bk.Status.Conditions, v1alpha1.BackupCondition{
+		Type:   v1alpha1.BackupComplete,
+		Status: v1.ConditionTrue,
+	})
+	return bk
+}
+
+func TestNextBackupToVerify(t *testing.T) {
+	g := NewGomegaWithT(t)
+	now := time.Now()
+	bs := &v1alpha1.BackupSchedule{}
+	bs.Spec.Verify = &v1alpha1.BackupVerifySpec{Every: 2}
+
+	var backups []*v1alpha1.Backup
+	for i := 0; i < 3; i++ {
+		backups = append(backups, newVerifyBackup(fmt.Sprintf("bk-%d", i), now.Add(time.Duration(i)*time.Hour)))
+	}
+	running := newVerifyBackup("bk-running", now.Add(4*time.Hour))
+	running.Status.Conditions = nil
+	backups = append(backups, running)
+
+	// no backup verified yet
+	g.Expect(nextBackupToVerify(bs, backups).Name).Should(Equal("bk-2"))
+
+	// only one backup completed after the last verified one
+	bs.Status.Verification = &v1alpha1.BackupVerifyStatus{Backup: "bk-1", Phase: v1alpha1.BackupVerifySucceeded}
+	g.Expect(nextBackupToVerify(bs, backups)).Should(BeNil())
+
+	// two backups completed after the last verified one
+	bs.Status.Verification.Backup = "bk-0"
+	g.Expect(nextBackupToVerify(bs, backups).Name).Should(Equal("bk-2"))
+}
+
+func TestVerify(t *testing.T) {
+	g := NewGomegaWithT(t)
+	helper := newHelper(t)
+	defer helper.close()
+	deps := helper.deps
+	m := NewBackupScheduleManager(deps).(*backupScheduleManager)
+
+	bs := &v1alpha1.BackupSchedule{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "bs", UID: "bs-uid"},
+		Spec: v1alpha1.BackupScheduleSpec{
+			Verify: &v1alpha1.BackupVerifySpec{
+				ClusterTemplate: &v1alpha1.TidbClusterSpec{Version: "v7.5.0"},
+				Checksum:        pointer.BoolPtr(false),
+			},
+		},
+	}
+	bk := newVerifyBackup("bk", time.Now())
+	helper.createBackup(bk)
+
+	// start to verify the latest backup
+	g.Expect(m.syncVerify(bs)).Should(Succeed())
+	g.Expect(bs.Status.Verification.Backup).Should(Equal("bk"))
+	g.Expect(bs.Status.Verification.Phase).Should(Equal(v1alpha1.BackupVerifyRunning))
+
+	// the TidbCluster is created from the template
+	g.Expect(m.syncVerify(bs)).Should(Succeed())
+	var tc *v1alpha1.TidbCluster
+	g.Eventually(func() error {
+		var err error
+		tc, err = deps.TiDBClusterLister.TidbClusters("ns").Get("bs-verify")
+		return err
+	}, time.Second*10).Should(BeNil())
+	g.Expect(tc.Spec.Version).Should(Equal("v7.5.0"))
+	g.Expect(tc.Annotations[label.AnnVerifyBackup]).Should(Equal("bk"))
+	g.Expect(metav1.IsControlledBy(tc, bs)).Should(BeTrue())
+
+	// the PVCs of the TidbCluster are not deleted with it
+	_, err := deps.KubeClientset.CoreV1().PersistentVolumeClaims("ns").Create(context.TODO(), &v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "tikv-bs-verify-tikv-0",
+			Labels:    label.New().Instance("bs-verify").TiKV(),
+		},
+	}, metav1.CreateOptions{})
+	g.Expect(err).Should(BeNil())
+
+	// the restore is created after the TidbCluster is ready
+	tc = tc.DeepCopy()
+	tc.Status.Conditions = []v1alpha1.TidbClusterCondition{{Type: v1alpha1.TidbClusterReady, Status: v1.ConditionTrue}}
+	_, err = deps.Clientset.PingcapV1alpha1().TidbClusters("ns").Update(context.TODO(), tc, metav1.UpdateOptions{})
+	g.Expect(err).Should(BeNil())
+	g.Eventually(func() bool {
+		tc, _ := deps.TiDBClusterLister.TidbClusters("ns").Get("bs-verify")
+		return len(tc.Status.Conditions) > 0
+	}, time.Second*10).Should(BeTrue())
+	g.Expect(m.syncVerify(bs)).Should(Succeed())
+	var restore *v1alpha1.Restore
+	g.Eventually(func() error {
+		var err error
+		restore, err = deps.RestoreLister.Restores("ns").Get("bs-verify")
+		return err
+	}, time.Second*10).Should(BeNil())
+	g.Expect(restore.Spec.BR.Cluster).Should(Equal("bs-verify"))
+	g.Expect(*restore.Spec.BR.Checksum).Should(BeFalse())
+
+	// the verification is finished after the restore is complete
+	restore = restore.DeepCopy()
+	restore.Status.Conditions = []v1alpha1.RestoreCondition{{Type: v1alpha1.RestoreComplete, Status: v1.ConditionTrue}}
+	_, err = deps.Clientset.PingcapV1alpha1().Restores("ns").Update(context.TODO(), restore, metav1.UpdateOptions{})
+	g.Expect(err).Should(BeNil())
+	g.Eventually(func() bool {
+		restore, _ := deps.RestoreLister.Restores("ns").Get("bs-verify")
+		return v1alpha1.IsRestoreComplete(restore)
+	}, time.Second*10).Should(BeTrue())
+	g.Expect(m.syncVerify(bs)).Should(Succeed())
+	g.Expect(bs.Status.Verification.Phase).Should(Equal(v1alpha1.BackupVerifySucceeded))
+	g.Expect(bs.Status.LastVerifiedBackup).Should(Equal("bk"))
+
+	verified, err := deps.Clientset.PingcapV1alpha1().Backups("ns").Get(context.TODO(), "bk", metav1.GetOptions{})
+	g.Expect(err).Should(BeNil())
+	_, cond := v1alpha1.GetBackupCondition(&verified.Status, v1alpha1.BackupVerified)
+	g.Expect(cond).ShouldNot(BeNil())
+	g.Expect(cond.Status).Should(Equal(v1.ConditionTrue))
+	g.Expect(verified.Status.Phase).ShouldNot(Equal(v1alpha1.BackupVerified))
+
+	// the resources of the verification are deleted
+	_, err = deps.Clientset.PingcapV1alpha1().TidbClusters("ns").Get(context.TODO(), "bs-verify", metav1.GetOptions{})
+	g.Expect(err).ShouldNot(BeNil())
+	_, err = deps.Clientset.PingcapV1alpha1().Restores("ns").Get(context.TODO(), "bs-verify", metav1.GetOptions{})
+	g.Expect(err).ShouldNot(BeNil())
+
+	// the PVCs are deleted after the TidbCluster is deleted, so they are not reused by the next verification
+	pvc, err := deps.KubeClientset.CoreV1().PersistentVolumeClaims("ns").Get(context.TODO(), "tikv-bs-verify-tikv-0", metav1.GetOptions{})
+	g.Expect(err).Should(BeNil())
+	g.Eventually(func() bool {
+		_, err := deps.TiDBClusterLister.TidbClusters("ns").Get("bs-verify")
+		return errors.IsNotFound(err)
+	}, time.Second*10).Should(BeTrue())
+	g.Eventually(func() error {
+		_, err := deps.PVCLister.PersistentVolumeClaims("ns").Get(pvc.Name)
+		return err
+	}, time.Second*10).Should(BeNil())
+	cleaned, err := m.cleanVerifyResources(bs)
+	g.Expect(err).Should(BeNil())
+	g.Expect(cleaned).Should(BeFalse())
+	_, err = deps.PVCLister.PersistentVolumeClaims("ns").Get(pvc.Name)
+	g.Expect(errors.IsNotFound(err)).Should(BeTrue())
+	cleaned, err = m.cleanVerifyResources(bs)
+	g.Expect(err).Should(BeNil())
+	g.Expect(cleaned).Should(BeTrue())
+}
+
+func TestVerifyFailedEvent(t *testing.T) {
+	g := NewGomegaWithT(t)
+	helper := newHelper(t)
+	defer helper.close()
+	deps := helper.deps
+	m := NewBackupScheduleManager(deps).(*backupScheduleManager)
+	recorder := deps.Recorder.(*record.FakeRecorder)
+
+	bs := &v1alpha1.BackupSchedule{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "bs", UID: "bs-uid"},
+		Spec: v1alpha1.BackupScheduleSpec{
+			Verify: &v1alpha1.BackupVerifySpec{ClusterTemplate: &v1alpha1.TidbClusterSpec{Version: "v7.5.0"}},
+		},
+		Status: v1alpha1.BackupScheduleStatus{
+			Verification: &v1alpha1.BackupVerifyStatus{Backup: "bk", Phase: v1alpha1.BackupVerifyRunning},
+		},
+	}
+
+	// the backup is deleted during the verification
+	g.Expect(m.syncVerify(bs)).Should(Succeed())
+	g.Expect(bs.Status.Verification.Phase).Should(Equal(v1alpha1.BackupVerifyFailed))
+	g.Expect(recorder.Events).Should(Receive(ContainSubstring("BackupVerifyFailed")))
+}
+
+func TestBuildVerifySQLJob(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	bs := &v1alpha1.BackupSchedule{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "bs", UID: "bs-uid"},
+		Spec: v1alpha1.BackupScheduleSpec{
+			Verify: &v1alpha1.BackupVerifySpec{
+				SQLAssertions: []v1alpha1.BackupVerifySQLAssertion{{Name: "count", Query: "SELECT COUNT(*) FROM test.t", Expected: "10"}},
+			},
+		},
+	}
+	tc := &v1alpha1.TidbCluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "bs-verify"},
+		Spec:       v1alpha1.TidbClusterSpec{TiDB: &v1alpha1.TiDBSpec{}},
+	}
+	envNames := func(job *batchv1.Job) []string {
+		var names []string
+		for _, env := range job.Spec.Template.Spec.Containers[0].Env {
+			names = append(names, env.Name)
+		}
+		return names
+	}
+
+	// connect as root without password and TLS by default
+	job := buildVerifySQLJob(bs, tc)
+	for _, name := range []string{"TIDB_USER", "MYSQL_PWD", "TIDB_SSL_ARGS"} {
+		g.Expect(envNames(job)).ShouldNot(ContainElement(name))
+	}
+	g.Expect(job.Spec.Template.Spec.Volumes).Should(BeEmpty())
+
+	// the credentials and the TLS client certificate are used
+	bs.Spec.Verify.SecretName = "verify-secret"
+	tc.Spec.TiDB.TLSClient = &v1alpha1.TiDBTLSClient{Enabled: true}
+	job = buildVerifySQLJob(bs, tc)
+	g.Expect(envNames(job)).Should(ContainElements("TIDB_USER", "MYSQL_PWD", "TIDB_SSL_ARGS"))
+	for _, env := range job.Spec.Template.Spec.Containers[0].Env {
+		if env.Name == "TIDB_USER" || env.Name == "MYSQL_PWD" {
+			g.Expect(env.ValueFrom.SecretKeyRef.Name).Should(Equal("verify-secret"))
+		}
+	}
+	g.Expect(job.Spec.Template.Spec.Volumes).Should(HaveLen(1))
+	g.Expect(job.Spec.Template.Spec.Volumes[0].Secret.SecretName).Should(Equal("bs-verify-tidb-client-secret"))
+	g.Expect(job.Spec.Template.Spec.Containers[0].VolumeMounts).Should(HaveLen(1))
+}
@@ -56,6 +56,9 @@ const (
 	// BackupManagerEnvVarPrefix represents the environment variable used for tidb-backup-manager must include this prefix
 	BackupManagerEnvVarPrefix = "BACKUP_MANAGER"
 
+	// BackupReplicaEnvVarPrefix represents the prefix of the environment variables of backup replica storage credentials
+	BackupReplicaEnvVarPrefix = "BACKUP_REPLICA"
+
 	// BR certificate storage path
 	BRCertPath = "/var/lib/br-tls"
 
@@ -74,6 +77,9 @@ const (
 	// the volumes provisioned by CSI driver on GCEPersistentDisk
 	PdCSIDriver = "pd.csi.storage.gke.io"
 
+	// the volumes provisioned by CSI driver on Azure Disk
+	AzureDiskCSIDriver = "disk.csi.azure.com"
+
 	// the mount path for TiKV data volume
 	TiKVDataVolumeMountPath = "/var/lib/tikv"
 
@@ -96,7 +102,8 @@ const (
 	KubeAnnBoundByController      = "pv.kubernetes.io/bound-by-controller"
 	KubeAnnDynamicallyProvisioned = "pv.kubernetes.io/provisioned-by"
 
-	NodeAffinityCsiEbsAzKey = "topology.ebs.csi.aws.com/zone"
+	NodeAffinityCsiEbsAzKey   = "topology.ebs.csi.aws.com/zone"
+	NodeAffinityCsiAzureAzKey = "topology.disk.csi.azure.com/zone"
 
 	LocalTmp           = "/tmp"
 	ClusterBackupMeta  = "clustermeta"
@@ -0,0 +1,177 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/apis/util/config"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+)
+
+// pitrRangeError means the backups of the BackupSchedule can not cover the pitr restored ts
+type pitrRangeError struct {
+	msg string
+}
+
+func (e *pitrRangeError) Error() string {
+	return e.msg
+}
+
+// syncBackupScheduleRef resolves the full backup and the log backup from the referenced BackupSchedule,
+// and fills their storage in the restore spec. It returns the restore updated by the controller.
+func (rm *restoreManager) syncBackupScheduleRef(restore *v1alpha1.Restore) (*v1alpha1.Restore, error) {
+	ns := restore.GetNamespace()
+	name := restore.GetName()
+	bsName := restore.Spec.BackupScheduleRef.Name
+
+	bs, err := rm.deps.BackupScheduleLister.BackupSchedules(ns).Get(bsName)
+	if err != nil {
+		rm.statusUpdater.Update(restore, &v1alpha1.RestoreCondition{
+			Type:    v1alpha1.RestoreRetryFailed,
+			Status:  corev1.ConditionTrue,
+			Reason:  "GetBackupScheduleFailed",
+			Message: err.Error(),
+		}, nil)
+		return nil, fmt.Errorf("restore %s/%s get backup schedule %s failed, err: %v", ns, name, bsName, err)
+	}
+
+	selector, err := label.NewBackupSchedule().Instance(bsName).BackupSchedule(bsName).Selector()
+	if err != nil {
+		return nil, fmt.Errorf("generate backup schedule %s/%s label selector failed, err: %v", ns, bsName, err)
+	}
+	backups, err := rm.deps.BackupLister.Backups(ns).List(selector)
+	if err != nil {
+		return nil, fmt.Errorf("get backup schedule %s/%s backup list failed, selector: %s, err: %v", ns, bsName, selector, err)
+	}
+
+	fullBackup, logBackup, err := resolvePitrBackups(bs, backups, restore.Spec.PitrRestoredTs)
+	if err != nil {
+		if _, ok := err.(*pitrRangeError); ok {
+			rm.statusUpdater.Update(restore, &v1alpha1.RestoreCondition{
+				Type:    v1alpha1.RestoreFailed,
+				Status:  corev1.ConditionTrue,
+				Reason:  "PitrRangeNotCovered",
+				Message: err.Error(),
+			}, nil)
+			return nil, controller.IgnoreErrorf("restore %s/%s: %v", ns, name, err)
+		}
+		return nil, fmt.Errorf("restore %s/%s resolve backups from backup schedule %s failed, err: %v", ns, name, bsName, err)
+	}
+
+	if reflect.DeepEqual(restore.Spec.StorageProvider, logBackup.Spec.StorageProvider) &&
+		reflect.DeepEqual(restore.Spec.PitrFullBackupStorageProvider, fullBackup.Spec.StorageProvider) {
+		return restore, nil
+	}
+
+	newRestore := restore.DeepCopy()
+	newRestore.Spec.StorageProvider = *logBackup.Spec.StorageProvider.DeepCopy()
+	newRestore.Spec.PitrFullBackupStorageProvider = *fullBackup.Spec.StorageProvider.DeepCopy()
+	updated, err := rm.deps.RestoreControl.UpdateRestore(newRestore)
+	if err != nil {
+		return nil, fmt.Errorf("restore %s/%s update storage resolved from backup schedule %s failed, err: %v", ns, name, bsName, err)
+	}
+	klog.Infof("restore %s/%s resolved full backup %s and log backup %s from backup schedule %s", ns, name, fullBackup.Name, logBackup.Name, bsName)
+	return updated, nil
+}
+
+// resolvePitrBackups returns the latest complete full backup before the restored ts and the log backup of the
+// BackupSchedule, and checks that the log backup covers the range from the full backup to the restored ts.
+//
+// ---------snapshot1--------snapshot2---------------snapshot3-------> full backups
+// ---startTs/truncateUntil------------------------------checkpointTs--> log backup
+// ----------------------------------------restoredTs-----------------> time
+//
+// snapshot2 is chosen in the above case, and the restore can not be done if
+// snapshot2 is before the start or truncated ts of log backup, or restoredTs is after the checkpoint.
+func resolvePitrBackups(bs *v1alpha1.BackupSchedule, backups []*v1alpha1.Backup, restoredTs string) (*v1alpha1.Backup, *v1alpha1.Backup, error) {
+	ns := bs.Namespace
+	bsName := bs.Name
+
+	restoredTSO, err := config.ParseTSString(restoredTs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse pitr restored ts %s failed, err: %v", restoredTs, err)
+	}
+
+	if bs.Status.LogBackup == nil || *bs.Status.LogBackup == "" {
+		return nil, nil, &pitrRangeError{msg: fmt.Sprintf("backup schedule %s/%s has no log backup", ns, bsName)}
+	}
+	var logBackup *v1alpha1.Backup
+	fullBackups := make([]*v1alpha1.Backup, 0, len(backups))
+	for _, backup := range backups {
+		if backup.Spec.Mode == v1alpha1.BackupModeLog {
+			if backup.Name == *bs.Status.LogBackup {
+				logBackup = backup
+			}
+			continue
+		}
+		isSnapshot := backup.Spec.Mode == v1alpha1.BackupModeSnapshot || backup.Spec.Mode == ""
+		if isSnapshot && v1alpha1.IsBackupComplete(backup) && backup.DeletionTimestamp == nil {
+			fullBackups = append(fullBackups, backup)
+		}
+	}
+	if logBackup == nil {
+		return nil, nil, &pitrRangeError{msg: fmt.Sprintf("log backup %s of backup schedule %s/%s is not found", *bs.Status.LogBackup, ns, bsName)}
+	}
+
+	type candidate struct {
+		backup *v1alpha1.Backup
+		tso    uint64
+	}
+	candidates := make([]candidate, 0, len(fullBackups))
+	for _, backup := range fullBackups {
+		tso, err := config.ParseTSString(backup.Status.CommitTs)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parse commit ts of backup %s/%s failed, err: %v", backup.Namespace, backup.Name, err)
+		}
+		if tso <= restoredTSO {
+			candidates = append(candidates, candidate{backup: backup, tso: tso})
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, nil, &pitrRangeError{msg: fmt.Sprintf("no complete full backup of backup schedule %s/%s is before pitr restored ts %s", ns, bsName, restoredTs)}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].tso > candidates[j].tso
+	})
+	full := candidates[0]
+
+	startTSO, err := config.ParseTSString(logBackup.Status.CommitTs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse commit ts of log backup %s/%s failed, err: %v", logBackup.Namespace, logBackup.Name, err)
+	}
+	truncateTSO, err := config.ParseTSString(logBackup.Status.LogSuccessTruncateUntil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse truncate ts of log backup %s/%s failed, err: %v", logBackup.Namespace, logBackup.Name, err)
+	}
+	checkpointTSO, err := config.ParseTSString(logBackup.Status.LogCheckpointTs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse checkpoint ts of log backup %s/%s failed, err: %v", logBackup.Namespace, logBackup.Name, err)
+	}
+
+	if startTSO == 0 || full.tso < startTSO || full.tso < truncateTSO {
+		return nil, nil, &pitrRangeError{msg: fmt.Sprintf("log backup %s is not available from commit ts %d of full backup %s, log backup start ts: %d, truncate until: %d",
+			logBackup.Name, full.tso, full.backup.Name, startTSO, truncateTSO)}
+	}
+	if restoredTSO > checkpointTSO {
+		return nil, nil, &pitrRangeError{msg: fmt.Sprintf("pitr restored ts %s is after checkpoint ts %d of log backup %s", restoredTs, checkpointTSO, logBackup.Name)}
+	}
+	return full.backup, logBackup, nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/apis/util/config"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+func TestResolvePitrBackups(t *testing.T) {
+	g := NewGomegaWithT(t)
+	base := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	tso := func(hours int) string {
+		return strconv.FormatUint(config.GoTimeToTS(base.Add(time.Duration(hours)*time.Hour)), 10)
+	}
+	newFullBackup := func(name string, hours int, complete bool) *v1alpha1.Backup {
+		backup := &v1alpha1.Backup{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name},
+			Spec: v1alpha1.BackupSpec{
+				Mode: v1alpha1.BackupModeSnapshot,
+				StorageProvider: v1alpha1.StorageProvider{
+					S3: &v1alpha1.S3StorageProvider{Bucket: "bucket", Prefix: name},
+				},
+			},
+			Status: v1alpha1.BackupStatus{CommitTs: tso(hours)},
+		}
+		if complete {
+			backup.Status.Conditions = []v1alpha1.BackupCondition{{Type: v1alpha1.BackupComplete, Status: corev1.ConditionTrue}}
+		}
+		return backup
+	}
+	newLogBackup := func(start, truncate, checkpoint int) *v1alpha1.Backup {
+		return &v1alpha1.Backup{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "log"},
+			Spec:       v1alpha1.BackupSpec{Mode: v1alpha1.BackupModeLog},
+			Status: v1alpha1.BackupStatus{
+				CommitTs:                tso(start),
+				LogSuccessTruncateUntil: tso(truncate),
+				LogCheckpointTs:         tso(checkpoint),
+			},
+		}
+	}
+	bs := &v1alpha1.BackupSchedule{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "bs"},
+		Status:     v1alpha1.BackupScheduleStatus{LogBackup: pointer.StringPtr("log")},
+	}
+
+	tests := []struct {
+		name         string
+		backups      []*v1alpha1.Backup
+		restoredTs   string
+		expectedFull string
+		rangeError   bool
+	}{
+		{
+			name: "latest full backup before restored ts",
+			backups: []*v1alpha1.Backup{
+				newFullBackup("full-1", 1, true),
+				newFullBackup("full-2", 3, true),
+				newFullBackup("full-3", 6, true),
+				newLogBackup(0, 0, 10),
+			},
+			restoredTs:   tso(5),
+			expectedFull: "full-2",
+		},
+		{
+			name: "incomplete full backup is skipped",
+			backups: []*v1alpha1.Backup{
+				newFullBackup("full-1", 1, true),
+				newFullBackup("full-2", 3, false),
+				newLogBackup(0, 0, 10),
+			},
+			restoredTs:   tso(5),
+			expectedFull: "full-1",
+		},
+		{
+			name: "no full backup before restored ts",
+			backups: []*v1alpha1.Backup{
+				newFullBackup("full-1", 6, true),
+				newLogBackup(0, 0, 10),
+			},
+			restoredTs: tso(5),
+			rangeError: true,
+		},
+		{
+			name: "log backup is truncated after full backup",
+			backups: []*v1alpha1.Backup{
+				newFullBackup("full-1", 1, true),
+				newLogBackup(0, 2, 10),
+			},
+			restoredTs: tso(5),
+			rangeError: true,
+		},
+		{
+			name: "restored ts is after checkpoint",
+			backups: []*v1alpha1.Backup{
+				newFullBackup("full-1", 1, true),
+				newLogBackup(0, 0, 4),
+			},
+			restoredTs: tso(5),
+			rangeError: true,
+		},
+		{
+			name: "log backup is not found",
+			backups: []*v1alpha1.Backup{
+				newFullBackup("full-1", 1, true),
+			},
+			restoredTs: tso(5),
+			rangeError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			full, log, err := resolvePitrBackups(bs, tt.backups, tt.restoredTs)
+			if tt.rangeError {
+				g.Expect(err).Should(BeAssignableToTypeOf(&pitrRangeError{}))
+				return
+			}
+			g.Expect(err).Should(BeNil())
+			g.Expect(full.Name).Should(Equal(tt.expectedFull))
+			g.Expect(log.Name).Should(Equal("log"))
+		})
+	}
+}
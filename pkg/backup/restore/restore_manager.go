@@ -43,6 +43,10 @@ import (
 const (
 	TiKVConfigEncryptionMethod      = "security.encryption.data-encryption-method"
 	TiKVConfigEncryptionMasterKeyId = "security.encryption.master-key.key-id"
+
+	// lightningLocalBackoffLimit is the backoff limit of the import job of the lightning local backend,
+	// the retried pods resume the import from the checkpoint in the restore volume
+	lightningLocalBackoffLimit = 3
 )
 
 type restoreManager struct {
@@ -111,6 +115,13 @@ func (rm *restoreManager) syncRestoreJob(restore *v1alpha1.Restore) error {
 		return controller.IgnoreErrorf("invalid restore spec %s/%s", ns, name)
 	}
 
+	if restore.Spec.BR != nil && restore.Spec.BackupScheduleRef != nil && !v1alpha1.IsRestoreScheduled(restore) {
+		restore, err = rm.syncBackupScheduleRef(restore)
+		if err != nil {
+			return err
+		}
+	}
+
 	if restore.Spec.BR != nil && restore.Spec.Mode == v1alpha1.RestoreModeVolumeSnapshot {
 		err = rm.validateRestore(restore, tc)
 		if err != nil {
@@ -503,7 +514,7 @@ func (rm *restoreManager) volumeSnapshotRestore(r *v1alpha1.Restore, tc *v1alpha
 			}
 		}
 
-		s, reason, err := snapshotter.NewSnapshotterForRestore(r.Spec.Mode, rm.deps)
+		s, reason, err := snapshotter.NewSnapshotterForRestore(r, rm.deps)
 		if err != nil {
 			return reason, err
 		}
@@ -530,7 +541,7 @@ func (rm *restoreManager) volumeSnapshotRestore(r *v1alpha1.Restore, tc *v1alpha
 		if err != nil {
 			return reason, err
 		}
-		s, reason, err := snapshotter.NewSnapshotterForRestore(r.Spec.Mode, rm.deps)
+		s, reason, err := snapshotter.NewSnapshotterForRestore(r, rm.deps)
 		if err != nil {
 			return reason, err
 		}
@@ -625,6 +636,36 @@ func (rm *restoreManager) makeImportJob(restore *v1alpha1.Restore) (*batchv1.Job
 		})
 	}
 
+	if restore.IsLightningLocalBackend() {
+		clusterNamespace := restore.GetLightningClusterNamespace()
+		tc, err := rm.deps.TiDBClusterLister.TidbClusters(clusterNamespace).Get(restore.Spec.Lightning.Cluster)
+		if err != nil {
+			return nil, fmt.Sprintf("failed to fetch tidbcluster %s/%s", clusterNamespace, restore.Spec.Lightning.Cluster), err
+		}
+		// the local backend connects to PD and TiKV directly
+		pdAddress := fmt.Sprintf("%s-pd.%s:%d", tc.Name, clusterNamespace, v1alpha1.DefaultPDClientPort)
+		if tc.Spec.ClusterDomain != "" {
+			pdAddress = fmt.Sprintf("%s-pd.%s.svc.%s:%d", tc.Name, clusterNamespace, tc.Spec.ClusterDomain, v1alpha1.DefaultPDClientPort)
+		}
+		args = append(args, fmt.Sprintf("--pd-addr=%s", pdAddress))
+		if tc.IsTLSClusterEnabled() {
+			args = append(args, "--cluster-tls=true")
+			volumeMounts = append(volumeMounts, corev1.VolumeMount{
+				Name:      util.ClusterClientVolName,
+				ReadOnly:  true,
+				MountPath: util.ClusterClientTLSPath,
+			})
+			volumes = append(volumes, corev1.Volume{
+				Name: util.ClusterClientVolName,
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: util.ClusterClientTLSSecretName(restore.Spec.Lightning.Cluster),
+					},
+				},
+			})
+		}
+	}
+
 	if restore.Spec.ToolImage != "" {
 		lightningVolumeMount := corev1.VolumeMount{
 			Name:      "lightning-bin",
@@ -699,6 +740,11 @@ func (rm *restoreManager) makeImportJob(restore *v1alpha1.Restore) (*batchv1.Job
 		},
 	}
 
+	backoffLimit := int32(0)
+	if restore.IsLightningLocalBackend() {
+		backoffLimit = lightningLocalBackoffLimit
+	}
+
 	job := &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        restore.GetRestoreJobName(),
@@ -710,7 +756,7 @@ func (rm *restoreManager) makeImportJob(restore *v1alpha1.Restore) (*batchv1.Job
 			},
 		},
 		Spec: batchv1.JobSpec{
-			BackoffLimit: pointer.Int32Ptr(0),
+			BackoffLimit: pointer.Int32Ptr(backoffLimit),
 			Template:     *podSpec,
 		},
 	}
@@ -27,6 +27,7 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/backup/constants"
 	"github.com/pingcap/tidb-operator/pkg/backup/testutils"
+	"github.com/pingcap/tidb-operator/pkg/util"
 	"github.com/stretchr/testify/require"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -228,6 +229,64 @@ func TestLightningRestore(t *testing.T) {
 	g.Expect(job.Spec.Template.Spec.Containers[0].Env).NotTo(gomega.ContainElement(env2No))
 }
 
+func TestLightningLocalBackendRestore(t *testing.T) {
+	g := NewGomegaWithT(t)
+	helper := newHelper(t)
+	defer helper.Close()
+	deps := helper.Deps
+	var err error
+
+	// the cluster is required by the local backend
+	restore := validDumpRestore.DeepCopy()
+	restore.Namespace = "ns"
+	restore.Name = "invalid"
+	restore.Spec.Lightning = &v1alpha1.LightningConfig{Backend: v1alpha1.LightningBackendLocal}
+	helper.createRestore(restore)
+
+	m := NewRestoreManager(deps)
+	err = m.Sync(restore)
+	g.Expect(err).ShouldNot(BeNil())
+	helper.hasCondition(restore.Namespace, restore.Name, v1alpha1.RestoreInvalid, "InvalidSpec")
+
+	restore = validDumpRestore.DeepCopy()
+	restore.Namespace = "ns"
+	restore.Name = "name"
+	restore.Spec.Lightning = &v1alpha1.LightningConfig{
+		Backend:          v1alpha1.LightningBackendLocal,
+		Cluster:          "tidb",
+		ClusterNamespace: "tidb-ns",
+	}
+	helper.createRestore(restore)
+	helper.CreateSecret(restore)
+	helper.CreateTC(restore.Spec.Lightning.ClusterNamespace, restore.Spec.Lightning.Cluster, false, false)
+
+	err = m.Sync(restore)
+	g.Expect(err).Should(BeNil())
+	helper.hasCondition(restore.Namespace, restore.Name, v1alpha1.RestoreScheduled, "")
+	job, err := helper.Deps.KubeClientset.BatchV1().Jobs(restore.Namespace).Get(context.TODO(), restore.GetRestoreJobName(), metav1.GetOptions{})
+	g.Expect(err).Should(BeNil())
+	// the failed pods are retried to resume from the lightning checkpoint
+	g.Expect(*job.Spec.BackoffLimit).Should(BeEquivalentTo(lightningLocalBackoffLimit))
+
+	// the cluster client certificates are mounted to connect to PD and TiKV
+	container := job.Spec.Template.Spec.Containers[0]
+	g.Expect(container.Args).To(ContainElement("--cluster-tls=true"))
+	g.Expect(container.Args).To(ContainElement("--pd-addr=tidb-pd.tidb-ns:2379"))
+	g.Expect(container.VolumeMounts).To(ContainElement(corev1.VolumeMount{
+		Name:      util.ClusterClientVolName,
+		ReadOnly:  true,
+		MountPath: util.ClusterClientTLSPath,
+	}))
+	g.Expect(job.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+		Name: util.ClusterClientVolName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: util.ClusterClientTLSSecretName("tidb"),
+			},
+		},
+	}))
+}
+
 func TestBRRestore(t *testing.T) {
 	g := NewGomegaWithT(t)
 	helper := newHelper(t)
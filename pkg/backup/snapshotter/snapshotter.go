@@ -14,6 +14,7 @@
 package snapshotter
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
@@ -24,6 +25,7 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/apis/label"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/backup/constants"
+	"github.com/pingcap/tidb-operator/pkg/backup/util"
 	"github.com/pingcap/tidb-operator/pkg/controller"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -31,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type Snapshotter interface {
@@ -76,17 +79,22 @@ func (s *BaseSnapshotter) Init(deps *controller.Dependencies, conf map[string]st
 	return nil
 }
 
-func NewSnapshotterForBackup(m v1alpha1.BackupMode, d *controller.Dependencies) (Snapshotter, string, error) {
+func NewSnapshotterForBackup(b *v1alpha1.Backup, d *controller.Dependencies) (Snapshotter, string, error) {
 	var s Snapshotter
-	switch m {
-	case v1alpha1.BackupModeVolumeSnapshot:
-		// Currently, we only support aws volume snapshot. If gcp volume snapshot is supported
-		// in the future, we can infer the provider from the storage class.
+	var conf map[string]string
+	switch {
+	case b.Spec.Mode == v1alpha1.BackupModeVolumeSnapshot && b.Spec.VolumeSnapshotClassName != nil:
+		s = &CSISnapshotter{}
+		conf = map[string]string{CSIConfigVolumeSnapshotClass: *b.Spec.VolumeSnapshotClassName}
+	case b.Spec.Mode == v1alpha1.BackupModeVolumeSnapshot && b.Spec.VolumeSnapshotCloud == v1alpha1.VolumeSnapshotCloudAzure:
+		s = &AzureSnapshotter{}
+	case b.Spec.Mode == v1alpha1.BackupModeVolumeSnapshot:
+		// aws is the default cloud provider if spec.volumeSnapshotCloud is not set
 		s = &AWSSnapshotter{}
 	default:
 		s = &NoneSnapshotter{}
 	}
-	err := s.Init(d, nil)
+	err := s.Init(d, conf)
 	if err != nil {
 		return s, "InitSnapshotterFailed", err
 	}
@@ -94,17 +102,22 @@ func NewSnapshotterForBackup(m v1alpha1.BackupMode, d *controller.Dependencies)
 	return s, "", nil
 }
 
-func NewSnapshotterForRestore(m v1alpha1.RestoreMode, d *controller.Dependencies) (Snapshotter, string, error) {
+func NewSnapshotterForRestore(r *v1alpha1.Restore, d *controller.Dependencies) (Snapshotter, string, error) {
 	var s Snapshotter
-	switch m {
-	case v1alpha1.RestoreModeVolumeSnapshot:
-		// Currently, we only support aws volume snapshot. If gcp volume snapshot is supported
-		// in the future, we can infer the provider from the storage class.
+	var conf map[string]string
+	switch {
+	case r.Spec.Mode == v1alpha1.RestoreModeVolumeSnapshot && r.Spec.VolumeSnapshotClassName != nil:
+		s = &CSISnapshotter{}
+		conf = map[string]string{CSIConfigVolumeSnapshotClass: *r.Spec.VolumeSnapshotClassName}
+	case r.Spec.Mode == v1alpha1.RestoreModeVolumeSnapshot && r.Spec.VolumeSnapshotCloud == v1alpha1.VolumeSnapshotCloudAzure:
+		s = &AzureSnapshotter{}
+	case r.Spec.Mode == v1alpha1.RestoreModeVolumeSnapshot:
+		// aws is the default cloud provider if spec.volumeSnapshotCloud is not set
 		s = &AWSSnapshotter{}
 	default:
 		s = &NoneSnapshotter{}
 	}
-	err := s.Init(d, nil)
+	err := s.Init(d, conf)
 	if err != nil {
 		return s, "InitSnapshotterFailed", err
 	}
@@ -112,6 +125,55 @@ func NewSnapshotterForRestore(m v1alpha1.RestoreMode, d *controller.Dependencies
 	return s, "", nil
 }
 
+// VolumeSnapshotTaker takes the snapshots of the TiKV volumes in the backup job, for the volumes BR can't snapshot by itself.
+// BR runs with --skip-aws to resolve the ts of the backup while GC and PD schedulers are paused by the initializing job,
+// then the snapshots are taken before they are resumed, so the volumes can be restored to the resolved ts.
+type VolumeSnapshotTaker interface {
+	// TakeSnapshots takes the snapshots of the volumes in the backup meta, and records the snapshot IDs in it
+	TakeSnapshots(ctx context.Context, b *v1alpha1.Backup, meta *util.EBSBasedBRMeta) error
+	// DeleteSnapshots deletes the snapshots recorded in the backup meta
+	DeleteSnapshots(ctx context.Context, b *v1alpha1.Backup, meta *util.EBSBasedBRMeta) error
+}
+
+// SnapshotsTakenByBR returns whether the volume snapshots of the backup are taken by BR, it's only supported on AWS EBS
+func SnapshotsTakenByBR(b *v1alpha1.Backup) bool {
+	return b.Spec.VolumeSnapshotClassName == nil && b.Spec.VolumeSnapshotCloud != v1alpha1.VolumeSnapshotCloudAzure
+}
+
+// VolumesRestoredByBR returns whether the volumes of the restore are created from the snapshots by BR,
+// it's only supported on AWS EBS
+func VolumesRestoredByBR(r *v1alpha1.Restore) bool {
+	return r.Spec.VolumeSnapshotClassName == nil && r.Spec.VolumeSnapshotCloud != v1alpha1.VolumeSnapshotCloudAzure
+}
+
+// NewVolumeSnapshotTaker returns the VolumeSnapshotTaker of the backup, cli is used to operate the VolumeSnapshots.
+// It returns nil if the snapshots are taken by BR.
+func NewVolumeSnapshotTaker(b *v1alpha1.Backup, cli client.Client) (VolumeSnapshotTaker, error) {
+	switch {
+	case b.Spec.VolumeSnapshotClassName != nil:
+		return NewCSIVolumeSnapshotTaker(cli, *b.Spec.VolumeSnapshotClassName), nil
+	case b.Spec.VolumeSnapshotCloud == v1alpha1.VolumeSnapshotCloudAzure:
+		return NewAzureVolumeSnapshotTaker()
+	}
+	return nil, nil
+}
+
+// VolumeCreator creates the TiKV volumes from the snapshots in the restore job, for the volumes BR can't restore by itself.
+// BR runs with --skip-aws to prepare the restore, then the volumes are created before the restore metadata is uploaded.
+type VolumeCreator interface {
+	// CreateVolumes creates the volumes from the snapshots in the backup meta, and records the volume IDs in it
+	CreateVolumes(ctx context.Context, r *v1alpha1.Restore, meta *util.EBSBasedBRMeta) error
+}
+
+// NewVolumeCreator returns the VolumeCreator of the restore.
+// It returns nil if the volumes are created by BR, or provisioned from the VolumeSnapshots by the CSI driver.
+func NewVolumeCreator(r *v1alpha1.Restore) (VolumeCreator, error) {
+	if r.Spec.VolumeSnapshotClassName == nil && r.Spec.VolumeSnapshotCloud == v1alpha1.VolumeSnapshotCloudAzure {
+		return NewAzureVolumeSnapshotTaker()
+	}
+	return nil, nil
+}
+
 func (s *BaseSnapshotter) PrepareCSBK8SMeta(tc *v1alpha1.TidbCluster) (
 	[]*corev1.Pod,
 	[]*corev1.PersistentVolumeClaim,
@@ -0,0 +1,239 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshotter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/backup/constants"
+	"github.com/pingcap/tidb-operator/pkg/backup/util"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// Azure tag names can't contain '/', these are the tags set by Azure Disk CSI driver
+	AzurePvNameTagKey   = "kubernetes.io-created-for-pv-name"
+	AzurePvcNameTagKey  = "kubernetes.io-created-for-pvc-name"
+	AzurePvcNSTagKey    = "kubernetes.io-created-for-pvc-namespace"
+	azureDiskIDSplitter = "/"
+)
+
+// AzureSnapshotter is the snapshotter for creating snapshots from volumes (during a backup)
+// and volumes from snapshots (during a restore) on Azure managed disks.
+// The volume ID is the resource ID of the managed disk, the format is
+// /subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/disks/{name}
+type AzureSnapshotter struct {
+	BaseSnapshotter
+}
+
+func (s *AzureSnapshotter) Init(deps *controller.Dependencies, conf map[string]string) error {
+	return s.BaseSnapshotter.Init(deps, conf)
+}
+
+func (s *AzureSnapshotter) GetVolumeID(pv *corev1.PersistentVolume) (string, error) {
+	if pv == nil {
+		return "", nil
+	}
+
+	if pv.Spec.CSI != nil {
+		driver := pv.Spec.CSI.Driver
+		if driver == constants.AzureDiskCSIDriver {
+			return validAzureDiskID(pv.Spec.CSI.VolumeHandle)
+		}
+		return "", fmt.Errorf("unable to handle CSI driver: %s", driver)
+	}
+	if pv.Spec.AzureDisk != nil {
+		if pv.Spec.AzureDisk.DataDiskURI == "" {
+			return "", fmt.Errorf("spec.azureDisk.diskURI not found")
+		}
+		return validAzureDiskID(pv.Spec.AzureDisk.DataDiskURI)
+	}
+
+	return "", nil
+}
+
+func (s *AzureSnapshotter) GenerateBackupMetadata(b *v1alpha1.Backup, tc *v1alpha1.TidbCluster) (*CloudSnapBackup, string, error) {
+	return s.BaseSnapshotter.generateBackupMetadata(b, tc, s)
+}
+
+// SetVolumeID sets the restored disk to the pv. The volumeID can be the resource ID of the disk, or
+// the disk name only if the disk is restored in the same resource group as the original one.
+func (s *AzureSnapshotter) SetVolumeID(pv *corev1.PersistentVolume, volumeID string) error {
+	if pv.Spec.CSI != nil {
+		// PV is provisioned by CSI driver
+		driver := pv.Spec.CSI.Driver
+		if driver != constants.AzureDiskCSIDriver {
+			return fmt.Errorf("unable to handle CSI driver: %s", driver)
+		}
+		diskID, err := restoredAzureDiskID(pv.Spec.CSI.VolumeHandle, volumeID)
+		if err != nil {
+			return err
+		}
+		pv.Spec.CSI.VolumeHandle = diskID
+	} else if pv.Spec.AzureDisk != nil {
+		// PV is provisioned by in-tree driver
+		diskID, err := restoredAzureDiskID(pv.Spec.AzureDisk.DataDiskURI, volumeID)
+		if err != nil {
+			return err
+		}
+		id, _ := util.ParseAzureResourceID(diskID, util.AzureDiskResourceType)
+		pv.Spec.AzureDisk.DataDiskURI = diskID
+		pv.Spec.AzureDisk.DiskName = id.Name
+	} else {
+		return errors.New("spec.csi and spec.azureDisk not found")
+	}
+
+	return nil
+}
+
+func (s *AzureSnapshotter) PrepareRestoreMetadata(r *v1alpha1.Restore, csb *CloudSnapBackup) (string, error) {
+	return s.BaseSnapshotter.prepareRestoreMetadata(r, csb, s)
+}
+
+// ResetPvAvailableZone sets the zone of the pv to the zone the disks are restored to, the zone
+// has the same format as the zone label of the nodes, such as eastus2-1.
+func (s *AzureSnapshotter) ResetPvAvailableZone(r *v1alpha1.Restore, pv *corev1.PersistentVolume) {
+	if r.Spec.VolumeAZ == "" {
+		return
+	}
+
+	restoreAZ := r.Spec.VolumeAZ
+	if pv.Spec.NodeAffinity == nil {
+		return
+	}
+	if pv.Spec.NodeAffinity.Required == nil {
+		return
+	}
+	for i, nodeSelector := range pv.Spec.NodeAffinity.Required.NodeSelectorTerms {
+		for j, field := range nodeSelector.MatchFields {
+			if isAzureZoneKey(field.Key) {
+				pv.Spec.NodeAffinity.Required.NodeSelectorTerms[i].MatchFields[j].Values = []string{restoreAZ}
+			}
+		}
+		for j, expr := range nodeSelector.MatchExpressions {
+			if isAzureZoneKey(expr.Key) && expr.Operator == corev1.NodeSelectorOpIn {
+				pv.Spec.NodeAffinity.Required.NodeSelectorTerms[i].MatchExpressions[j].Values = []string{restoreAZ}
+			}
+		}
+	}
+}
+
+func isAzureZoneKey(key string) bool {
+	return key == constants.NodeAffinityCsiAzureAzKey || key == corev1.LabelTopologyZone
+}
+
+func (s *AzureSnapshotter) AddVolumeTags(pvs []*corev1.PersistentVolume) error {
+	resourcesTags := make(map[string]util.TagMap)
+
+	for _, pv := range pvs {
+		tags := make(map[string]string)
+		tags[AzurePvNameTagKey] = pv.GetName()
+		if pv.Spec.ClaimRef != nil {
+			tags[AzurePvcNameTagKey] = pv.Spec.ClaimRef.Name
+			tags[AzurePvcNSTagKey] = pv.Spec.ClaimRef.Namespace
+		}
+
+		diskID, err := s.GetVolumeID(pv)
+		if err != nil {
+			return err
+		}
+		resourcesTags[diskID] = tags
+	}
+	session, err := util.NewAzureDiskSession(util.CloudAPIConcurrency)
+	if err != nil {
+		return err
+	}
+	return session.AddTags(resourcesTags)
+}
+
+func (s *AzureSnapshotter) CleanVolumes(r *v1alpha1.Restore, csb *CloudSnapBackup) error {
+	if !v1alpha1.IsRestoreVolumeFailed(r) {
+		return errors.New("can't clean volumes if not restore volume failed")
+	}
+
+	volumeIDs := s.getRestoreVolumeIDs(csb)
+	session, err := util.NewAzureDiskSession(util.CloudAPIConcurrency)
+	if err != nil {
+		return fmt.Errorf("new azure disk session error: %w", err)
+	}
+	if err := session.DeleteDisks(volumeIDs); err != nil {
+		return fmt.Errorf("delete volumes error: %w", err)
+	}
+	return nil
+}
+
+// AzureVolumeSnapshotTaker takes the snapshots of the Azure managed disks in the backup job, and creates the
+// disks from the snapshots in the restore job. The snapshots and disks are named with the UID of the backup
+// and the restore, so they are reused if the job is retried.
+type AzureVolumeSnapshotTaker struct {
+	session *util.AzureDiskSession
+}
+
+func NewAzureVolumeSnapshotTaker() (*AzureVolumeSnapshotTaker, error) {
+	session, err := util.NewAzureDiskSession(util.CloudAPIConcurrency)
+	if err != nil {
+		return nil, fmt.Errorf("new azure disk session error: %w", err)
+	}
+	return &AzureVolumeSnapshotTaker{session: session}, nil
+}
+
+func (t *AzureVolumeSnapshotTaker) TakeSnapshots(ctx context.Context, b *v1alpha1.Backup, meta *util.EBSBasedBRMeta) error {
+	return t.session.CreateSnapshots(ctx, meta, string(b.UID))
+}
+
+func (t *AzureVolumeSnapshotTaker) DeleteSnapshots(ctx context.Context, b *v1alpha1.Backup, meta *util.EBSBasedBRMeta) error {
+	deleteRatio := 1.0
+	if b.Spec.CleanOption != nil {
+		deleteRatio = b.Spec.CleanOption.SnapshotsDeleteRatio
+	}
+	snapIDMap := make(map[string]string)
+	for _, store := range meta.TiKVComponent.Stores {
+		for _, vol := range store.Volumes {
+			if vol.SnapshotID != "" {
+				snapIDMap[vol.ID] = vol.SnapshotID
+			}
+		}
+	}
+	return t.session.DeleteSnapshots(ctx, snapIDMap, deleteRatio)
+}
+
+func (t *AzureVolumeSnapshotTaker) CreateVolumes(ctx context.Context, r *v1alpha1.Restore, meta *util.EBSBasedBRMeta) error {
+	return t.session.CreateDisks(ctx, meta, string(r.UID), r.Spec.VolumeAZ)
+}
+
+func validAzureDiskID(diskID string) (string, error) {
+	if _, err := util.ParseAzureResourceID(diskID, util.AzureDiskResourceType); err != nil {
+		return "", err
+	}
+	return diskID, nil
+}
+
+// restoredAzureDiskID returns the resource ID of the restored disk, if volumeID is a disk name,
+// the disk is in the same resource group as the original disk.
+func restoredAzureDiskID(origin, volumeID string) (string, error) {
+	if strings.Contains(volumeID, azureDiskIDSplitter) {
+		return validAzureDiskID(volumeID)
+	}
+	id, err := util.ParseAzureResourceID(origin, util.AzureDiskResourceType)
+	if err != nil {
+		return "", err
+	}
+	id.Name = volumeID
+	return id.String(), nil
+}
@@ -0,0 +1,196 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshotter
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testAzureDiskID = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/disks/pvc-1"
+
+func TestNewAzureSnapshotter(t *testing.T) {
+	backup := &v1alpha1.Backup{
+		Spec: v1alpha1.BackupSpec{
+			Mode:                v1alpha1.BackupModeVolumeSnapshot,
+			VolumeSnapshotCloud: v1alpha1.VolumeSnapshotCloudAzure,
+			StorageProvider: v1alpha1.StorageProvider{
+				S3: &v1alpha1.S3StorageProvider{Bucket: "backup"},
+			},
+		},
+	}
+	s, _, err := NewSnapshotterForBackup(backup, nil)
+	require.NoError(t, err)
+	assert.IsType(t, &AzureSnapshotter{}, s)
+	assert.False(t, SnapshotsTakenByBR(backup))
+
+	// the cloud is not inferred from the storage provider
+	backup.Spec.VolumeSnapshotCloud = ""
+	backup.Spec.StorageProvider = v1alpha1.StorageProvider{
+		Azblob: &v1alpha1.AzblobStorageProvider{Container: "backup"},
+	}
+	s, _, err = NewSnapshotterForBackup(backup, nil)
+	require.NoError(t, err)
+	assert.IsType(t, &AWSSnapshotter{}, s)
+	assert.True(t, SnapshotsTakenByBR(backup))
+
+	restore := &v1alpha1.Restore{
+		Spec: v1alpha1.RestoreSpec{
+			Mode: v1alpha1.RestoreModeVolumeSnapshot,
+			StorageProvider: v1alpha1.StorageProvider{
+				S3: &v1alpha1.S3StorageProvider{Bucket: "backup"},
+			},
+		},
+	}
+	s, _, err = NewSnapshotterForRestore(restore, nil)
+	require.NoError(t, err)
+	assert.IsType(t, &AWSSnapshotter{}, s)
+	assert.True(t, VolumesRestoredByBR(restore))
+
+	restore.Spec.VolumeSnapshotCloud = v1alpha1.VolumeSnapshotCloudAzure
+	s, _, err = NewSnapshotterForRestore(restore, nil)
+	require.NoError(t, err)
+	assert.IsType(t, &AzureSnapshotter{}, s)
+	assert.False(t, VolumesRestoredByBR(restore))
+}
+
+func TestAzureSnapshotterVolumeID(t *testing.T) {
+	s := &AzureSnapshotter{}
+	require.NoError(t, s.Init(nil, nil))
+
+	cases := []struct {
+		name       string
+		source     corev1.PersistentVolumeSource
+		volumeID   string
+		restoreID  string
+		wantErr    bool
+		wantHandle string
+	}{
+		{
+			name: "csi",
+			source: corev1.PersistentVolumeSource{
+				CSI: &corev1.CSIPersistentVolumeSource{Driver: "disk.csi.azure.com", VolumeHandle: testAzureDiskID},
+			},
+			volumeID:   testAzureDiskID,
+			restoreID:  "/subscriptions/sub/resourceGroups/rg2/providers/Microsoft.Compute/disks/restored",
+			wantHandle: "/subscriptions/sub/resourceGroups/rg2/providers/Microsoft.Compute/disks/restored",
+		},
+		{
+			name: "csi restored with disk name",
+			source: corev1.PersistentVolumeSource{
+				CSI: &corev1.CSIPersistentVolumeSource{Driver: "disk.csi.azure.com", VolumeHandle: testAzureDiskID},
+			},
+			volumeID:   testAzureDiskID,
+			restoreID:  "restored",
+			wantHandle: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/disks/restored",
+		},
+		{
+			name: "in-tree",
+			source: corev1.PersistentVolumeSource{
+				AzureDisk: &corev1.AzureDiskVolumeSource{DiskName: "pvc-1", DataDiskURI: testAzureDiskID},
+			},
+			volumeID:   testAzureDiskID,
+			restoreID:  "restored",
+			wantHandle: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/disks/restored",
+		},
+		{
+			name: "other csi driver",
+			source: corev1.PersistentVolumeSource{
+				CSI: &corev1.CSIPersistentVolumeSource{Driver: "ebs.csi.aws.com", VolumeHandle: "vol-1"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid volume handle",
+			source: corev1.PersistentVolumeSource{
+				CSI: &corev1.CSIPersistentVolumeSource{Driver: "disk.csi.azure.com", VolumeHandle: "pvc-1"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			pv := &corev1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{Name: "pv-1"},
+				Spec:       corev1.PersistentVolumeSpec{PersistentVolumeSource: tt.source},
+			}
+			volumeID, err := s.GetVolumeID(pv)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Error(t, s.SetVolumeID(pv, "restored"))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.volumeID, volumeID)
+
+			require.NoError(t, s.SetVolumeID(pv, tt.restoreID))
+			volumeID, err = s.GetVolumeID(pv)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantHandle, volumeID)
+			if pv.Spec.AzureDisk != nil {
+				assert.Equal(t, "restored", pv.Spec.AzureDisk.DiskName)
+			}
+		})
+	}
+}
+
+func TestAzureSnapshotterCleanVolumes(t *testing.T) {
+	s := &AzureSnapshotter{}
+	require.NoError(t, s.Init(nil, nil))
+
+	// the volumes are only cleaned after the restore volume failed
+	restore := &v1alpha1.Restore{
+		Spec: v1alpha1.RestoreSpec{
+			Mode: v1alpha1.RestoreModeVolumeSnapshot,
+		},
+	}
+	require.Error(t, s.CleanVolumes(restore, &CloudSnapBackup{}))
+}
+
+func TestAzureSnapshotterResetPvAvailableZone(t *testing.T) {
+	s := &AzureSnapshotter{}
+	require.NoError(t, s.Init(nil, nil))
+
+	pv := &corev1.PersistentVolume{
+		Spec: corev1.PersistentVolumeSpec{
+			NodeAffinity: &corev1.VolumeNodeAffinity{
+				Required: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+						MatchExpressions: []corev1.NodeSelectorRequirement{
+							{Key: "topology.disk.csi.azure.com/zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"eastus2-1"}},
+							{Key: "kubernetes.io/hostname", Operator: corev1.NodeSelectorOpIn, Values: []string{"node-1"}},
+						},
+					}},
+				},
+			},
+		},
+	}
+	restore := &v1alpha1.Restore{}
+
+	// the zone is kept if volumeAZ is not set
+	s.ResetPvAvailableZone(restore, pv)
+	exprs := pv.Spec.NodeAffinity.Required.NodeSelectorTerms[0].MatchExpressions
+	assert.Equal(t, []string{"eastus2-1"}, exprs[0].Values)
+
+	restore.Spec.VolumeAZ = "eastus2-3"
+	s.ResetPvAvailableZone(restore, pv)
+	assert.Equal(t, []string{"eastus2-3"}, exprs[0].Values)
+	assert.Equal(t, []string{"node-1"}, exprs[1].Values)
+}
@@ -0,0 +1,427 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshotter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/backup/constants"
+	"github.com/pingcap/tidb-operator/pkg/backup/util"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// CSIConfigVolumeSnapshotClass is the config key of the VolumeSnapshotClass used by CSISnapshotter
+	CSIConfigVolumeSnapshotClass = "volumeSnapshotClass"
+
+	csiSnapshotGroup = "snapshot.storage.k8s.io"
+
+	csiSnapshotCheckInterval = 5 * time.Second
+)
+
+var (
+	volumeSnapshotGVK        = schema.GroupVersionKind{Group: csiSnapshotGroup, Version: "v1", Kind: "VolumeSnapshot"}
+	volumeSnapshotContentGVK = schema.GroupVersionKind{Group: csiSnapshotGroup, Version: "v1", Kind: "VolumeSnapshotContent"}
+)
+
+// CSISnapshotter is the snapshotter for creating snapshots from volumes (during a backup)
+// and volumes from snapshots (during a restore) through the Kubernetes VolumeSnapshot API,
+// so it works with any CSI driver supporting snapshots.
+//
+// During a backup, the VolumeSnapshots are taken by CSIVolumeSnapshotTaker in the backup job.
+// During a restore, a pre-provisioned VolumeSnapshotContent and VolumeSnapshot are created for each
+// snapshot, and the PVCs are created with the VolumeSnapshot as data source, so the volumes are
+// provisioned by the CSI driver.
+type CSISnapshotter struct {
+	BaseSnapshotter
+}
+
+func (s *CSISnapshotter) Init(deps *controller.Dependencies, conf map[string]string) error {
+	return s.BaseSnapshotter.Init(deps, conf)
+}
+
+func (s *CSISnapshotter) GetVolumeID(pv *corev1.PersistentVolume) (string, error) {
+	if pv == nil {
+		return "", nil
+	}
+	if pv.Spec.CSI == nil {
+		return "", fmt.Errorf("pv %s is not provisioned by CSI driver", pv.Name)
+	}
+	return pv.Spec.CSI.VolumeHandle, nil
+}
+
+func (s *CSISnapshotter) SetVolumeID(pv *corev1.PersistentVolume, volumeID string) error {
+	if pv.Spec.CSI == nil {
+		return errors.New("spec.csi not found")
+	}
+	pv.Spec.CSI.VolumeHandle = volumeID
+	return nil
+}
+
+func (s *CSISnapshotter) ResetPvAvailableZone(r *v1alpha1.Restore, pv *corev1.PersistentVolume) {}
+
+func (s *CSISnapshotter) AddVolumeTags(pvs []*corev1.PersistentVolume) error {
+	// the volumes are provisioned by the CSI driver, nothing to tag
+	return nil
+}
+
+func (s *CSISnapshotter) volumeSnapshotClass() string {
+	return s.config[CSIConfigVolumeSnapshotClass]
+}
+
+// GenerateBackupMetadata generates the metadata of the TiKV volumes. The VolumeSnapshots are not created here,
+// they are taken by CSIVolumeSnapshotTaker in the backup job at the resolved ts of BR.
+func (s *CSISnapshotter) GenerateBackupMetadata(b *v1alpha1.Backup, tc *v1alpha1.TidbCluster) (*CloudSnapBackup, string, error) {
+	return s.BaseSnapshotter.generateBackupMetadata(b, tc, s)
+}
+
+// PrepareRestoreMetadata creates the VolumeSnapshots of the backup snapshots
+// and the TiKV PVCs restored from them.
+func (s *CSISnapshotter) PrepareRestoreMetadata(r *v1alpha1.Restore, csb *CloudSnapBackup) (string, error) {
+	if reason, err := checkCloudSnapBackup(csb); err != nil {
+		return reason, err
+	}
+
+	// the volumes are provisioned by the CSI driver from the snapshots,
+	// so the snapshot is used as the restore volume of each volume
+	for _, store := range csb.TiKV.Stores {
+		for _, vol := range store.Volumes {
+			vol.RestoreVolumeID = vol.SnapshotID
+		}
+	}
+	m := NewRestoreStoresMixture(s)
+	if reason, err := m.ProcessCSBPVCsAndPVs(r, csb); err != nil {
+		return reason, err
+	}
+
+	for i, pvc := range csb.Kubernetes.PVCs {
+		pv := csb.Kubernetes.PVs[i]
+		ns := restorePVCNamespace(r, pvc)
+		snapshotName := fmt.Sprintf("%s-%s", r.Name, pvc.Name)
+		if reason, err := s.ensureRestoreVolumeSnapshot(r, ns, snapshotName, pv); err != nil {
+			return reason, err
+		}
+
+		pvc.Namespace = ns
+		pvc.Spec.VolumeName = ""
+		apiGroup := csiSnapshotGroup
+		pvc.Spec.DataSource = &corev1.TypedLocalObjectReference{
+			APIGroup: &apiGroup,
+			Kind:     volumeSnapshotGVK.Kind,
+			Name:     snapshotName,
+		}
+		existingPVC, err := s.deps.PVCLister.PersistentVolumeClaims(ns).Get(pvc.Name)
+		if err == nil {
+			if existingPVC.Spec.DataSource != nil && existingPVC.Spec.DataSource.Name == snapshotName {
+				klog.Infof("Restore %s/%s the pvc %s is already existing, skip it", r.Namespace, r.Name, pvc.Name)
+				continue
+			}
+			return "ExistingPVCConflict", fmt.Errorf(
+				"pvc %s/%s already exists, and has different data source. please remove it carefully to continue volume restore process",
+				ns, pvc.Name)
+		}
+		if !apierrors.IsNotFound(err) {
+			return "GetPVCFailed", err
+		}
+		if err := s.deps.PVCControl.CreatePVC(r, pvc); err != nil && !apierrors.IsAlreadyExists(err) {
+			return "CreatePVCFailed", err
+		}
+	}
+	return "", nil
+}
+
+// ensureRestoreVolumeSnapshot creates a pre-provisioned VolumeSnapshotContent for the snapshot of the pv,
+// and the VolumeSnapshot bound to it. The content retains the snapshot when it is deleted,
+// because the snapshot belongs to the backup.
+func (s *CSISnapshotter) ensureRestoreVolumeSnapshot(r *v1alpha1.Restore, ns, name string, pv *corev1.PersistentVolume) (string, error) {
+	if pv.Spec.CSI == nil {
+		return "InvalidPV", fmt.Errorf("pv %s is not provisioned by CSI driver", pv.Name)
+	}
+	snapshotHandle := pv.Annotations[constants.AnnRestoredVolumeID]
+	if snapshotHandle == "" {
+		return "GetSnapshotIDFailed", fmt.Errorf("snapshot id of pv %s not found", pv.Name)
+	}
+
+	ctx := context.TODO()
+	contentName := restoreVolumeSnapshotContentName(r, name)
+	content := newUnstructured(volumeSnapshotContentGVK)
+	content.SetName(contentName)
+	content.SetLabels(label.NewRestore().Instance(r.GetInstanceName()).Restore(r.Name))
+	spec := map[string]interface{}{
+		"deletionPolicy": "Retain",
+		"driver":         pv.Spec.CSI.Driver,
+		"source": map[string]interface{}{
+			"snapshotHandle": snapshotHandle,
+		},
+		"volumeSnapshotRef": map[string]interface{}{
+			"name":      name,
+			"namespace": ns,
+		},
+	}
+	if class := s.volumeSnapshotClass(); class != "" {
+		spec["volumeSnapshotClassName"] = class
+	}
+	content.Object["spec"] = spec
+	if err := s.deps.GenericClient.Create(ctx, content); err != nil && !apierrors.IsAlreadyExists(err) {
+		return "CreateVolumeSnapshotContentFailed", fmt.Errorf("create volume snapshot content %s failed, err: %v", contentName, err)
+	}
+
+	vs := newVolumeSnapshot(ns, name, s.volumeSnapshotClass(),
+		label.NewRestore().Instance(r.GetInstanceName()).Restore(r.Name),
+		map[string]interface{}{"volumeSnapshotContentName": contentName})
+	if err := s.deps.GenericClient.Create(ctx, vs); err != nil && !apierrors.IsAlreadyExists(err) {
+		return "CreateVolumeSnapshotFailed", fmt.Errorf("create volume snapshot %s/%s failed, err: %v", ns, name, err)
+	}
+	return "", nil
+}
+
+// CleanVolumes deletes the PVCs, VolumeSnapshots and VolumeSnapshotContents created by the failed restore.
+// The backup snapshots are retained.
+func (s *CSISnapshotter) CleanVolumes(r *v1alpha1.Restore, csb *CloudSnapBackup) error {
+	if !v1alpha1.IsRestoreVolumeFailed(r) {
+		return errors.New("can't clean volumes if not restore volume failed")
+	}
+	if _, err := checkCloudSnapBackup(csb); err != nil {
+		return err
+	}
+
+	// process the metadata again to get the names of restored pvcs
+	for _, store := range csb.TiKV.Stores {
+		for _, vol := range store.Volumes {
+			vol.RestoreVolumeID = vol.SnapshotID
+		}
+	}
+	m := NewRestoreStoresMixture(s)
+	if _, err := m.ProcessCSBPVCsAndPVs(r, csb); err != nil {
+		return err
+	}
+
+	ctx := context.TODO()
+	for _, pvc := range csb.Kubernetes.PVCs {
+		ns := restorePVCNamespace(r, pvc)
+		existingPVC, err := s.deps.PVCLister.PersistentVolumeClaims(ns).Get(pvc.Name)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("get pvc %s/%s error: %w", ns, pvc.Name, err)
+		}
+		if err == nil {
+			if err := s.deps.PVCControl.DeletePVC(r, existingPVC); err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("delete pvc %s/%s error: %w", ns, pvc.Name, err)
+			}
+		}
+
+		snapshotName := fmt.Sprintf("%s-%s", r.Name, pvc.Name)
+		vs := newUnstructured(volumeSnapshotGVK)
+		vs.SetNamespace(ns)
+		vs.SetName(snapshotName)
+		if err := s.deps.GenericClient.Delete(ctx, vs); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("delete volume snapshot %s/%s error: %w", ns, snapshotName, err)
+		}
+		content := newUnstructured(volumeSnapshotContentGVK)
+		content.SetName(restoreVolumeSnapshotContentName(r, snapshotName))
+		if err := s.deps.GenericClient.Delete(ctx, content); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("delete volume snapshot content %s error: %w", content.GetName(), err)
+		}
+	}
+	return nil
+}
+
+// CSIVolumeSnapshotTaker takes the VolumeSnapshots of the TiKV volumes in the backup job.
+// The snapshot handle of the bound VolumeSnapshotContent of each VolumeSnapshot is recorded
+// as the snapshot ID, so the snapshot can be restored in another namespace or cluster.
+type CSIVolumeSnapshotTaker struct {
+	cli   client.Client
+	class string
+	// interval to check whether the VolumeSnapshots are ready to use
+	interval time.Duration
+}
+
+func NewCSIVolumeSnapshotTaker(cli client.Client, class string) *CSIVolumeSnapshotTaker {
+	return &CSIVolumeSnapshotTaker{
+		cli:      cli,
+		class:    class,
+		interval: csiSnapshotCheckInterval,
+	}
+}
+
+// TakeSnapshots creates the VolumeSnapshots of all the volumes first, so that they are taken
+// as close as possible, then waits until all of them are ready to use.
+func (t *CSIVolumeSnapshotTaker) TakeSnapshots(ctx context.Context, b *v1alpha1.Backup, meta *util.EBSBasedBRMeta) error {
+	claims, err := volumeClaims(meta)
+	if err != nil {
+		return err
+	}
+
+	for volID, claim := range claims {
+		name := backupVolumeSnapshotName(b, claim.Name)
+		vs := newVolumeSnapshot(claim.Namespace, name, t.class,
+			label.NewBackup().Instance(b.GetInstanceName()).Backup(b.Name),
+			map[string]interface{}{"persistentVolumeClaimName": claim.Name})
+		if err := t.cli.Create(ctx, vs); err != nil && !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("create volume snapshot %s/%s of volume %s failed, err: %v", claim.Namespace, name, volID, err)
+		}
+		klog.Infof("backup %s/%s created volume snapshot %s/%s for pvc %s", b.Namespace, b.Name, claim.Namespace, name, claim.Name)
+	}
+
+	snapshotIDs := make(map[string]string, len(claims))
+	err = wait.PollImmediateUntil(t.interval, func() (bool, error) {
+		for volID, claim := range claims {
+			if _, ok := snapshotIDs[volID]; ok {
+				continue
+			}
+			handle, err := t.snapshotHandle(ctx, claim.Namespace, backupVolumeSnapshotName(b, claim.Name))
+			if err != nil {
+				return false, err
+			}
+			if handle == "" {
+				return false, nil
+			}
+			snapshotIDs[volID] = handle
+		}
+		return true, nil
+	}, ctx.Done())
+	if err != nil {
+		return fmt.Errorf("wait volume snapshots of backup %s/%s ready to use failed, err: %v", b.Namespace, b.Name, err)
+	}
+
+	for _, store := range meta.TiKVComponent.Stores {
+		for _, vol := range store.Volumes {
+			vol.SnapshotID = snapshotIDs[vol.ID]
+		}
+	}
+	return nil
+}
+
+// snapshotHandle returns the snapshot handle if the VolumeSnapshot is ready to use
+func (t *CSIVolumeSnapshotTaker) snapshotHandle(ctx context.Context, ns, name string) (string, error) {
+	vs := newUnstructured(volumeSnapshotGVK)
+	if err := t.cli.Get(ctx, types.NamespacedName{Namespace: ns, Name: name}, vs); err != nil {
+		return "", fmt.Errorf("get volume snapshot %s/%s failed, err: %v", ns, name, err)
+	}
+	if msg, found, _ := unstructured.NestedString(vs.Object, "status", "error", "message"); found && msg != "" {
+		return "", fmt.Errorf("volume snapshot %s/%s failed, err: %s", ns, name, msg)
+	}
+	ready, _, _ := unstructured.NestedBool(vs.Object, "status", "readyToUse")
+	contentName, _, _ := unstructured.NestedString(vs.Object, "status", "boundVolumeSnapshotContentName")
+	if !ready || contentName == "" {
+		return "", nil
+	}
+
+	content := newUnstructured(volumeSnapshotContentGVK)
+	if err := t.cli.Get(ctx, types.NamespacedName{Name: contentName}, content); err != nil {
+		return "", fmt.Errorf("get volume snapshot content %s of %s/%s failed, err: %v", contentName, ns, name, err)
+	}
+	handle, _, _ := unstructured.NestedString(content.Object, "status", "snapshotHandle")
+	return handle, nil
+}
+
+// DeleteSnapshots deletes the VolumeSnapshots of the backup. Whether the snapshots are deleted
+// along with them depends on the deletionPolicy of the VolumeSnapshotClass.
+func (t *CSIVolumeSnapshotTaker) DeleteSnapshots(ctx context.Context, b *v1alpha1.Backup, meta *util.EBSBasedBRMeta) error {
+	claims, err := volumeClaims(meta)
+	if err != nil {
+		return err
+	}
+	for _, claim := range claims {
+		vs := newUnstructured(volumeSnapshotGVK)
+		vs.SetNamespace(claim.Namespace)
+		vs.SetName(backupVolumeSnapshotName(b, claim.Name))
+		if err := t.cli.Delete(ctx, vs); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("delete volume snapshot %s/%s failed, err: %v", vs.GetNamespace(), vs.GetName(), err)
+		}
+		klog.Infof("volume snapshot %s/%s is deleted", vs.GetNamespace(), vs.GetName())
+	}
+	return nil
+}
+
+// volumeClaims returns the pvcs of the TiKV volumes in the backup meta, the key is the volume id
+func volumeClaims(meta *util.EBSBasedBRMeta) (map[string]types.NamespacedName, error) {
+	if meta.KubernetesMeta == nil || meta.TiKVComponent == nil {
+		return nil, errors.New(".kubernetes or .tikv of backup meta not found")
+	}
+	volID2PV := make(map[string]*corev1.PersistentVolume, len(meta.KubernetesMeta.PVs))
+	for _, pv := range meta.KubernetesMeta.PVs {
+		if volID, ok := pv.Annotations[constants.AnnTemporaryVolumeID]; ok {
+			volID2PV[volID] = pv
+		}
+	}
+
+	claims := make(map[string]types.NamespacedName)
+	for _, store := range meta.TiKVComponent.Stores {
+		for _, vol := range store.Volumes {
+			pv, ok := volID2PV[vol.ID]
+			if !ok {
+				return nil, fmt.Errorf("pv with volume id %s not found", vol.ID)
+			}
+			if pv.Spec.ClaimRef == nil {
+				return nil, fmt.Errorf("pv %s claimRef is nil", pv.Name)
+			}
+			claims[vol.ID] = types.NamespacedName{Namespace: pv.Spec.ClaimRef.Namespace, Name: pv.Spec.ClaimRef.Name}
+		}
+	}
+	return claims, nil
+}
+
+func backupVolumeSnapshotName(b *v1alpha1.Backup, pvcName string) string {
+	return fmt.Sprintf("%s-%s", b.Name, pvcName)
+}
+
+func restorePVCNamespace(r *v1alpha1.Restore, pvc *corev1.PersistentVolumeClaim) string {
+	if pvc.Namespace != "" {
+		return pvc.Namespace
+	}
+	if r.Spec.BR != nil && r.Spec.BR.ClusterNamespace != "" {
+		return r.Spec.BR.ClusterNamespace
+	}
+	return r.Namespace
+}
+
+// restoreVolumeSnapshotContentName returns the name of the cluster scoped VolumeSnapshotContent,
+// the namespace of the restore is included to avoid conflicts
+func restoreVolumeSnapshotContentName(r *v1alpha1.Restore, snapshotName string) string {
+	return fmt.Sprintf("%s-%s", r.Namespace, snapshotName)
+}
+
+func newUnstructured(gvk schema.GroupVersionKind) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	return obj
+}
+
+func newVolumeSnapshot(ns, name, class string, labels map[string]string, source map[string]interface{}) *unstructured.Unstructured {
+	vs := newUnstructured(volumeSnapshotGVK)
+	vs.SetNamespace(ns)
+	vs.SetName(name)
+	vs.SetLabels(labels)
+	spec := map[string]interface{}{
+		"source": source,
+	}
+	if class != "" {
+		spec["volumeSnapshotClassName"] = class
+	}
+	vs.Object["spec"] = spec
+	return vs
+}
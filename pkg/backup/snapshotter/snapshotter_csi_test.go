@@ -0,0 +1,264 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshotter
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/backup/constants"
+	"github.com/pingcap/tidb-operator/pkg/backup/testutils"
+	"github.com/pingcap/tidb-operator/pkg/backup/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestCSISnapshotterGenerateBackupMetadata(t *testing.T) {
+	helper := newHelper(t)
+	defer helper.Close()
+	deps := helper.Deps
+	ctx := context.TODO()
+
+	tc, pods, pvcs, pvs := constructTidbClusterWithSpecTiKV()
+	for _, pod := range pods {
+		pod.Labels = label.New().Instance(tc.Name).TiKV().Labels()
+		pod.Labels[label.StoreIDLabelKey] = strings.TrimPrefix(pod.Name, "test-db-tikv-")
+		require.NoError(t, deps.KubeInformerFactory.Core().V1().Pods().Informer().GetIndexer().Add(pod))
+	}
+	for _, pvc := range pvcs {
+		pvc.Labels = label.New().Instance(tc.Name).TiKV().Labels()
+		require.NoError(t, deps.KubeInformerFactory.Core().V1().PersistentVolumeClaims().Informer().GetIndexer().Add(pvc))
+	}
+	for _, pv := range pvs {
+		pv.Labels = label.New().Instance(tc.Name).Namespace(tc.Namespace).TiKV().Labels()
+		require.NoError(t, deps.KubeInformerFactory.Core().V1().PersistentVolumes().Informer().GetIndexer().Add(pv))
+	}
+
+	backup := &v1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-backup",
+		},
+		Spec: v1alpha1.BackupSpec{
+			Type:                    v1alpha1.BackupTypeFull,
+			Mode:                    v1alpha1.BackupModeVolumeSnapshot,
+			VolumeSnapshotClassName: pointer.StringPtr("csi-snapclass"),
+		},
+	}
+	s, _, err := NewSnapshotterForBackup(backup, deps)
+	require.NoError(t, err)
+	require.IsType(t, &CSISnapshotter{}, s)
+
+	// the volume snapshots are not taken by the controller
+	csb, _, err := s.GenerateBackupMetadata(backup, tc)
+	require.NoError(t, err)
+	require.Len(t, csb.TiKV.Stores, 3)
+	for _, store := range csb.TiKV.Stores {
+		require.Len(t, store.Volumes, 2)
+		for _, vol := range store.Volumes {
+			assert.NotEmpty(t, vol.VolumeID)
+			assert.Empty(t, vol.SnapshotID)
+		}
+	}
+	for _, pvc := range pvcs {
+		err := deps.GenericClient.Get(ctx, types.NamespacedName{Namespace: tc.Namespace, Name: "test-backup-" + pvc.Name}, newUnstructured(volumeSnapshotGVK))
+		assert.True(t, apierrors.IsNotFound(err))
+	}
+}
+
+func TestCSIVolumeSnapshotTaker(t *testing.T) {
+	ctx := context.TODO()
+	cli := fake.NewFakeClientWithScheme(scheme.Scheme)
+
+	backup := &v1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-backup",
+			Namespace: "backup",
+		},
+		Spec: v1alpha1.BackupSpec{
+			Type:                    v1alpha1.BackupTypeFull,
+			Mode:                    v1alpha1.BackupModeVolumeSnapshot,
+			VolumeSnapshotClassName: pointer.StringPtr("csi-snapclass"),
+		},
+	}
+	require.False(t, SnapshotsTakenByBR(backup))
+	taker, err := NewVolumeSnapshotTaker(backup, cli)
+	require.NoError(t, err)
+	require.IsType(t, &CSIVolumeSnapshotTaker{}, taker)
+	taker.(*CSIVolumeSnapshotTaker).interval = 10 * time.Millisecond
+
+	meta := &util.EBSBasedBRMeta{
+		TiKVComponent:  &util.TiKVComponent{},
+		KubernetesMeta: &util.KubernetesBackup{},
+	}
+	pvcNames := []string{"tikv-test-tikv-0", "tikv-test-tikv-1"}
+	for i, pvcName := range pvcNames {
+		volID := "vol-" + strconv.Itoa(i)
+		meta.TiKVComponent.Stores = append(meta.TiKVComponent.Stores, &util.EBSStore{
+			StoreID: uint64(i + 1),
+			Volumes: []*util.EBSVolume{{ID: volID, Type: "storage.data-dir"}},
+		})
+		meta.KubernetesMeta.PVs = append(meta.KubernetesMeta.PVs, &corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "pv-" + strconv.Itoa(i),
+				Annotations: map[string]string{constants.AnnTemporaryVolumeID: volID},
+			},
+			Spec: corev1.PersistentVolumeSpec{
+				ClaimRef: &corev1.ObjectReference{Namespace: "test", Name: pvcName},
+			},
+		})
+	}
+
+	// mark the volume snapshots ready to use once they are created
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for _, pvcName := range pvcNames {
+			name := "test-backup-" + pvcName
+			vs := newUnstructured(volumeSnapshotGVK)
+			for cli.Get(ctx, types.NamespacedName{Namespace: "test", Name: name}, vs) != nil {
+				time.Sleep(time.Millisecond)
+			}
+			content := newUnstructured(volumeSnapshotContentGVK)
+			content.SetName("content-" + pvcName)
+			content.Object["status"] = map[string]interface{}{"snapshotHandle": "snap-" + pvcName}
+			assert.NoError(t, cli.Create(ctx, content))
+			vs.Object["status"] = map[string]interface{}{
+				"readyToUse":                     true,
+				"boundVolumeSnapshotContentName": content.GetName(),
+			}
+			assert.NoError(t, cli.Update(ctx, vs))
+		}
+	}()
+	require.NoError(t, taker.TakeSnapshots(ctx, backup, meta))
+	<-done
+
+	for i, store := range meta.TiKVComponent.Stores {
+		assert.Equal(t, "snap-"+pvcNames[i], store.Volumes[0].SnapshotID)
+
+		vs := newUnstructured(volumeSnapshotGVK)
+		require.NoError(t, cli.Get(ctx, types.NamespacedName{Namespace: "test", Name: "test-backup-" + pvcNames[i]}, vs))
+		claim, _, _ := unstructured.NestedString(vs.Object, "spec", "source", "persistentVolumeClaimName")
+		assert.Equal(t, pvcNames[i], claim)
+		class, _, _ := unstructured.NestedString(vs.Object, "spec", "volumeSnapshotClassName")
+		assert.Equal(t, "csi-snapclass", class)
+	}
+
+	// the volume snapshot is failed
+	vs := newUnstructured(volumeSnapshotGVK)
+	require.NoError(t, cli.Get(ctx, types.NamespacedName{Namespace: "test", Name: "test-backup-" + pvcNames[0]}, vs))
+	vs.Object["status"] = map[string]interface{}{"error": map[string]interface{}{"message": "snapshot failed"}}
+	require.NoError(t, cli.Update(ctx, vs))
+	err = taker.TakeSnapshots(ctx, backup, meta)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "snapshot failed")
+
+	require.NoError(t, taker.DeleteSnapshots(ctx, backup, meta))
+	for _, pvcName := range pvcNames {
+		err := cli.Get(ctx, types.NamespacedName{Namespace: "test", Name: "test-backup-" + pvcName}, newUnstructured(volumeSnapshotGVK))
+		assert.True(t, apierrors.IsNotFound(err))
+	}
+}
+
+func TestCSISnapshotterRestore(t *testing.T) {
+	helper := newHelper(t)
+	defer helper.Close()
+	deps := helper.Deps
+	ctx := context.TODO()
+
+	restore := &v1alpha1.Restore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-restore",
+			Namespace: "test",
+		},
+		Spec: v1alpha1.RestoreSpec{
+			Type: v1alpha1.BackupTypeFull,
+			Mode: v1alpha1.RestoreModeVolumeSnapshot,
+			BR: &v1alpha1.BRConfig{
+				Cluster:          "test",
+				ClusterNamespace: "test",
+			},
+			VolumeSnapshotClassName: pointer.StringPtr(""),
+		},
+	}
+	s, _, err := NewSnapshotterForRestore(restore, deps)
+	require.NoError(t, err)
+	require.IsType(t, &CSISnapshotter{}, s)
+
+	csb := &CloudSnapBackup{}
+	require.NoError(t, json.Unmarshal([]byte(testutils.ConstructRestoreMetaStr()), csb))
+	reason, err := s.PrepareRestoreMetadata(restore, csb)
+	require.NoError(t, err)
+	require.Empty(t, reason)
+
+	// the pvcs are renamed to be sequential
+	snapshotIDs := map[string]string{
+		"tikv-test-tikv-0": "snap-1234567890abcdef0",
+		"tikv-test-tikv-1": "snap-1234567890abcdef1",
+		"tikv-test-tikv-2": "snap-1234567890abcdef2",
+	}
+	for pvcName, snapshotID := range snapshotIDs {
+		snapshotName := "test-restore-" + pvcName
+		pvc, err := deps.PVCLister.PersistentVolumeClaims("test").Get(pvcName)
+		require.NoError(t, err)
+		assert.Empty(t, pvc.Spec.VolumeName)
+		require.NotNil(t, pvc.Spec.DataSource)
+		assert.Equal(t, "VolumeSnapshot", pvc.Spec.DataSource.Kind)
+		assert.Equal(t, snapshotName, pvc.Spec.DataSource.Name)
+
+		vs := newUnstructured(volumeSnapshotGVK)
+		require.NoError(t, deps.GenericClient.Get(ctx, types.NamespacedName{Namespace: "test", Name: snapshotName}, vs))
+		contentName, _, _ := unstructured.NestedString(vs.Object, "spec", "source", "volumeSnapshotContentName")
+		assert.Equal(t, "test-"+snapshotName, contentName)
+
+		content := newUnstructured(volumeSnapshotContentGVK)
+		require.NoError(t, deps.GenericClient.Get(ctx, types.NamespacedName{Name: contentName}, content))
+		handle, _, _ := unstructured.NestedString(content.Object, "spec", "source", "snapshotHandle")
+		assert.Equal(t, snapshotID, handle)
+		policy, _, _ := unstructured.NestedString(content.Object, "spec", "deletionPolicy")
+		assert.Equal(t, "Retain", policy)
+		driver, _, _ := unstructured.NestedString(content.Object, "spec", "driver")
+		assert.Equal(t, "ebs.csi.aws.com", driver)
+	}
+
+	// clean the volumes of the failed restore
+	restore.Status.Conditions = []v1alpha1.RestoreCondition{
+		{Type: v1alpha1.RestoreFailed, Status: corev1.ConditionTrue},
+	}
+	csb = &CloudSnapBackup{}
+	require.NoError(t, json.Unmarshal([]byte(testutils.ConstructRestoreMetaStr()), csb))
+	require.NoError(t, s.CleanVolumes(restore, csb))
+	for pvcName := range snapshotIDs {
+		snapshotName := "test-restore-" + pvcName
+		_, err := deps.PVCLister.PersistentVolumeClaims("test").Get(pvcName)
+		assert.True(t, apierrors.IsNotFound(err))
+		err = deps.GenericClient.Get(ctx, types.NamespacedName{Namespace: "test", Name: snapshotName}, newUnstructured(volumeSnapshotGVK))
+		assert.True(t, apierrors.IsNotFound(err))
+		err = deps.GenericClient.Get(ctx, types.NamespacedName{Name: "test-" + snapshotName}, newUnstructured(volumeSnapshotContentGVK))
+		assert.True(t, apierrors.IsNotFound(err))
+	}
+}
@@ -450,7 +450,7 @@ func TestGenerateBackupMetadata(t *testing.T) {
 
 	for _, tt := range cases {
 		t.Run(tt.name, func(t *testing.T) {
-			s, _, err := NewSnapshotterForBackup(tt.backup.Spec.Mode, deps)
+			s, _, err := NewSnapshotterForBackup(tt.backup, deps)
 			require.NoError(t, err)
 			_, _, err = s.GenerateBackupMetadata(tt.backup, tc)
 			if tt.wantErr {
@@ -893,7 +893,7 @@ func TestPrepareRestoreMetadata(t *testing.T) {
 		},
 	}
 
-	s, _, err := NewSnapshotterForRestore(restore.Spec.Mode, deps)
+	s, _, err := NewSnapshotterForRestore(restore, deps)
 	require.NoError(t, err)
 
 	// missing .annotation["tidb.pingcap.com/backup-cloud-snapshot"] as metadata
@@ -0,0 +1,527 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	azruntime "github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute"
+	"golang.org/x/sync/errgroup"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// AzureDiskResourceType is the resource type of Azure managed disks
+	AzureDiskResourceType = "disks"
+	// AzureSnapshotResourceType is the resource type of Azure disk snapshots
+	AzureSnapshotResourceType = "snapshots"
+	// AzureSourceDiskSKUTagKey is the tag of the snapshots to record the sku of the source disks,
+	// the disks restored from the snapshots are created with the same sku
+	AzureSourceDiskSKUTagKey = "tidb-operator-source-disk-sku"
+
+	azureProvisioningSucceeded = "Succeeded"
+	azureProvisioningFailed    = "Failed"
+	// the max length of the names of Azure managed disks and snapshots
+	azureResourceNameMaxLen = 80
+	azureProvisionTimeout   = 30 * time.Minute
+	azureProvisionInterval  = 5 * time.Second
+)
+
+// AzureResourceID is the parsed ID of an Azure compute resource, the format is
+// /subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/{type}/{name}
+type AzureResourceID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Type           string
+	Name           string
+}
+
+func (id *AzureResourceID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/%s/%s",
+		id.SubscriptionID, id.ResourceGroup, id.Type, id.Name)
+}
+
+// ParseAzureResourceID parses the ID of an Azure compute resource with the given type
+func ParseAzureResourceID(id, resourceType string) (*AzureResourceID, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 9 || parts[0] != "" ||
+		!strings.EqualFold(parts[1], "subscriptions") ||
+		!strings.EqualFold(parts[3], "resourceGroups") ||
+		!strings.EqualFold(parts[5], "providers") ||
+		!strings.EqualFold(parts[6], "Microsoft.Compute") ||
+		!strings.EqualFold(parts[7], resourceType) ||
+		parts[2] == "" || parts[4] == "" || parts[8] == "" {
+		return nil, fmt.Errorf("invalid azure %s resource id %s, expected /subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/%s/{name}",
+			resourceType, id, resourceType)
+	}
+	return &AzureResourceID{
+		SubscriptionID: parts[2],
+		ResourceGroup:  parts[4],
+		Type:           resourceType,
+		Name:           parts[8],
+	}, nil
+}
+
+// AzureDisksClient is the subset of armcompute.DisksClient used by the snapshotter
+type AzureDisksClient interface {
+	Get(ctx context.Context, resourceGroupName string, diskName string, options *armcompute.DisksClientGetOptions) (armcompute.DisksClientGetResponse, error)
+	BeginCreateOrUpdate(ctx context.Context, resourceGroupName string, diskName string, disk armcompute.Disk, options *armcompute.DisksClientBeginCreateOrUpdateOptions) (*azruntime.Poller[armcompute.DisksClientCreateOrUpdateResponse], error)
+	BeginUpdate(ctx context.Context, resourceGroupName string, diskName string, parameters armcompute.DiskUpdate, options *armcompute.DisksClientBeginUpdateOptions) (*azruntime.Poller[armcompute.DisksClientUpdateResponse], error)
+	BeginDelete(ctx context.Context, resourceGroupName string, diskName string, options *armcompute.DisksClientBeginDeleteOptions) (*azruntime.Poller[armcompute.DisksClientDeleteResponse], error)
+}
+
+// AzureSnapshotsClient is the subset of armcompute.SnapshotsClient used by the snapshotter
+type AzureSnapshotsClient interface {
+	Get(ctx context.Context, resourceGroupName string, snapshotName string, options *armcompute.SnapshotsClientGetOptions) (armcompute.SnapshotsClientGetResponse, error)
+	BeginCreateOrUpdate(ctx context.Context, resourceGroupName string, snapshotName string, snapshot armcompute.Snapshot, options *armcompute.SnapshotsClientBeginCreateOrUpdateOptions) (*azruntime.Poller[armcompute.SnapshotsClientCreateOrUpdateResponse], error)
+	BeginDelete(ctx context.Context, resourceGroupName string, snapshotName string, options *armcompute.SnapshotsClientBeginDeleteOptions) (*azruntime.Poller[armcompute.SnapshotsClientDeleteResponse], error)
+}
+
+// AzureDiskSession operates the managed disks and snapshots on Azure.
+// The clients are created per subscription, because the subscription is part of the resource id.
+type AzureDiskSession struct {
+	// for unit test, the clients can be replaced by fake ones
+	NewDisksClient     func(subscriptionID string) (AzureDisksClient, error)
+	NewSnapshotsClient func(subscriptionID string) (AzureSnapshotsClient, error)
+	// azure operation concurrency
+	concurrency uint
+	// interval to check whether the created disks and snapshots are provisioned
+	interval time.Duration
+}
+
+func NewAzureDiskSession(concurrency uint) (*AzureDiskSession, error) {
+	// TiDB Operator need make sure we have the correct permission to call azure api
+	// (through azure env variables or workload identity)
+	cred, err := azidentity.NewDefaultAzureCredential(nil)
+	if err != nil {
+		return nil, fmt.Errorf("obtain azure credential failed, err: %w", err)
+	}
+	return &AzureDiskSession{
+		NewDisksClient: func(subscriptionID string) (AzureDisksClient, error) {
+			return armcompute.NewDisksClient(subscriptionID, cred, nil)
+		},
+		NewSnapshotsClient: func(subscriptionID string) (AzureSnapshotsClient, error) {
+			return armcompute.NewSnapshotsClient(subscriptionID, cred, nil)
+		},
+		concurrency: concurrency,
+		interval:    azureProvisionInterval,
+	}, nil
+}
+
+func isAzureNotFound(err error) bool {
+	var respErr *azcore.ResponseError
+	return errors.As(err, &respErr) && respErr.StatusCode == http.StatusNotFound
+}
+
+// disksClients returns the disks clients of the subscriptions of the disk ids
+func (s *AzureDiskSession) disksClients(ids []*AzureResourceID) (map[string]AzureDisksClient, error) {
+	clients := make(map[string]AzureDisksClient)
+	for _, id := range ids {
+		if _, ok := clients[id.SubscriptionID]; ok {
+			continue
+		}
+		client, err := s.NewDisksClient(id.SubscriptionID)
+		if err != nil {
+			return nil, fmt.Errorf("create disks client for subscription %s failed, err: %w", id.SubscriptionID, err)
+		}
+		clients[id.SubscriptionID] = client
+	}
+	return clients, nil
+}
+
+// DeleteDisks deletes the managed disks, the disks not found are skipped.
+// The deletion is asynchronous on Azure, it returns once all deletions are accepted.
+func (s *AzureDiskSession) DeleteDisks(diskIDs []string) error {
+	ids := make([]*AzureResourceID, 0, len(diskIDs))
+	for _, diskID := range diskIDs {
+		id, err := ParseAzureResourceID(diskID, AzureDiskResourceType)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, id)
+	}
+	clients, err := s.disksClients(ids)
+	if err != nil {
+		return err
+	}
+
+	eg, ctx := errgroup.WithContext(context.Background())
+	workerPool := NewWorkerPool(s.concurrency, "delete disks")
+	for _, id := range ids {
+		id := id
+		workerPool.ApplyOnErrorGroup(eg, func() error {
+			if _, err := clients[id.SubscriptionID].BeginDelete(ctx, id.ResourceGroup, id.Name, nil); err != nil {
+				if isAzureNotFound(err) {
+					klog.Warningf("disk %s is not found, skip deleting it", id)
+					return nil
+				}
+				return fmt.Errorf("delete disk %s error: %w", id, err)
+			}
+			klog.Infof("disk %s is deleted", id)
+			return nil
+		})
+	}
+	return eg.Wait()
+}
+
+// AddTags merges the tags into the existing tags of the managed disks
+func (s *AzureDiskSession) AddTags(resourcesTags map[string]TagMap) error {
+	ids := make(map[string]*AzureResourceID, len(resourcesTags))
+	idList := make([]*AzureResourceID, 0, len(resourcesTags))
+	for diskID := range resourcesTags {
+		id, err := ParseAzureResourceID(diskID, AzureDiskResourceType)
+		if err != nil {
+			return err
+		}
+		ids[diskID] = id
+		idList = append(idList, id)
+	}
+	clients, err := s.disksClients(idList)
+	if err != nil {
+		return err
+	}
+
+	eg, ctx := errgroup.WithContext(context.Background())
+	workerPool := NewWorkerPool(s.concurrency, "add tags")
+	for diskID, tagMap := range resourcesTags {
+		id := ids[diskID]
+		tagMap := tagMap
+		workerPool.ApplyOnErrorGroup(eg, func() error {
+			client := clients[id.SubscriptionID]
+			resp, err := client.Get(ctx, id.ResourceGroup, id.Name, nil)
+			if err != nil {
+				return fmt.Errorf("get disk %s error: %w", id, err)
+			}
+			tags := make(map[string]*string, len(resp.Tags)+len(tagMap))
+			for k, v := range resp.Tags {
+				tags[k] = v
+			}
+			for k, v := range tagMap {
+				v := v
+				tags[k] = &v
+			}
+			if _, err := client.BeginUpdate(ctx, id.ResourceGroup, id.Name, armcompute.DiskUpdate{Tags: tags}, nil); err != nil {
+				klog.Errorf("failed to add tags for disk %s, %v", id, err)
+				return err
+			}
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		klog.Errorf("failed to add tags for all disks")
+		return err
+	}
+	return nil
+}
+
+// DeleteSnapshots deletes the disk snapshots, the snapshots not found are skipped.
+// Like EC2Session.DeleteSnapshots, no more than deleteRatio deletions are requested per second.
+// It stops requesting deletions once ctx is done.
+func (s *AzureDiskSession) DeleteSnapshots(ctx context.Context, snapIDMap map[string]string, deleteRatio float64) error {
+	clients := make(map[string]AzureSnapshotsClient)
+	var deletedCnt int32
+	lastFlowCheck := time.Now()
+	klog.Infof("Start deleting snapshots, total is %d", len(snapIDMap))
+	for volID := range snapIDMap {
+		snapID := snapIDMap[volID]
+		id, err := ParseAzureResourceID(snapID, AzureSnapshotResourceType)
+		if err != nil {
+			return err
+		}
+		client, ok := clients[id.SubscriptionID]
+		if !ok {
+			client, err = s.NewSnapshotsClient(id.SubscriptionID)
+			if err != nil {
+				return fmt.Errorf("create snapshots client for subscription %s failed, err: %w", id.SubscriptionID, err)
+			}
+			clients[id.SubscriptionID] = client
+		}
+
+		klog.Infof("deleting snapshot %s ", snapID)
+		if _, err := client.BeginDelete(ctx, id.ResourceGroup, id.Name, nil); err != nil {
+			if isAzureNotFound(err) {
+				klog.Warningf("snapshot %s not found, azure err: %s", snapID, err.Error())
+				continue
+			}
+			klog.Errorf("failed to delete snapshot id=%s, error=%s", snapID, err.Error())
+			return err
+		}
+		klog.Infof("snapshot %s is deleted", snapID)
+		deletedCnt++
+		if deleteRatio > 0 && deletedCnt%SnapshotDeletionFlowControlInterval == 0 {
+			lastRoundDuration := time.Since(lastFlowCheck)
+			expectedET := time.Duration(SnapshotDeletionFlowControlInterval/deleteRatio) * time.Second
+			if lastRoundDuration < expectedET {
+				suspension := expectedET - lastRoundDuration
+				klog.Infof("Snapshot deletion flow control for %s", suspension)
+				select {
+				case <-time.After(suspension):
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+			lastFlowCheck = time.Now()
+		}
+	}
+	return nil
+}
+
+// snapshotsClients returns the snapshots clients of the subscriptions of the ids
+func (s *AzureDiskSession) snapshotsClients(ids []*AzureResourceID) (map[string]AzureSnapshotsClient, error) {
+	clients := make(map[string]AzureSnapshotsClient)
+	for _, id := range ids {
+		if _, ok := clients[id.SubscriptionID]; ok {
+			continue
+		}
+		client, err := s.NewSnapshotsClient(id.SubscriptionID)
+		if err != nil {
+			return nil, fmt.Errorf("create snapshots client for subscription %s failed, err: %w", id.SubscriptionID, err)
+		}
+		clients[id.SubscriptionID] = client
+	}
+	return clients, nil
+}
+
+// CreateSnapshots creates the incremental snapshots of the disks in the backup meta, and records the ids
+// of the snapshots and the zones of the disks in it. The snapshots are named with the prefix and the names
+// of the disks, and placed in the resource groups of the disks. It returns once all snapshots are provisioned
+// or ctx is done.
+func (s *AzureDiskSession) CreateSnapshots(ctx context.Context, meta *EBSBasedBRMeta, prefix string) error {
+	volumes := metaVolumes(meta)
+	ids := make([]*AzureResourceID, 0, len(volumes))
+	for _, vol := range volumes {
+		id, err := ParseAzureResourceID(vol.ID, AzureDiskResourceType)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, id)
+	}
+	disksClients, err := s.disksClients(ids)
+	if err != nil {
+		return err
+	}
+	snapshotsClients, err := s.snapshotsClients(ids)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, azureProvisionTimeout)
+	defer cancel()
+	eg, ctx := errgroup.WithContext(ctx)
+	workerPool := NewWorkerPool(s.concurrency, "create snapshots")
+	for i, vol := range volumes {
+		diskID, vol := ids[i], vol
+		workerPool.ApplyOnErrorGroup(eg, func() error {
+			resp, err := disksClients[diskID.SubscriptionID].Get(ctx, diskID.ResourceGroup, diskID.Name, nil)
+			if err != nil {
+				return fmt.Errorf("get disk %s error: %w", diskID, err)
+			}
+			snapshotID := &AzureResourceID{
+				SubscriptionID: diskID.SubscriptionID,
+				ResourceGroup:  diskID.ResourceGroup,
+				Type:           AzureSnapshotResourceType,
+				Name:           azureResourceName(prefix, diskID.Name),
+			}
+			snapshot := armcompute.Snapshot{
+				Location: resp.Location,
+				Properties: &armcompute.SnapshotProperties{
+					CreationData: &armcompute.CreationData{
+						CreateOption:     to.Ptr(armcompute.DiskCreateOptionCopy),
+						SourceResourceID: to.Ptr(diskID.String()),
+					},
+					Incremental: to.Ptr(true),
+				},
+			}
+			if resp.SKU != nil && resp.SKU.Name != nil {
+				snapshot.Tags = map[string]*string{AzureSourceDiskSKUTagKey: to.Ptr(string(*resp.SKU.Name))}
+			}
+			client := snapshotsClients[diskID.SubscriptionID]
+			if _, err := client.BeginCreateOrUpdate(ctx, snapshotID.ResourceGroup, snapshotID.Name, snapshot, nil); err != nil {
+				return fmt.Errorf("create snapshot %s of disk %s error: %w", snapshotID, diskID, err)
+			}
+			klog.Infof("snapshot %s of disk %s is being created", snapshotID, diskID)
+
+			err = s.waitProvisioned(ctx, snapshotID, func(ctx context.Context) (*string, error) {
+				resp, err := client.Get(ctx, snapshotID.ResourceGroup, snapshotID.Name, nil)
+				if err != nil || resp.Properties == nil {
+					return nil, err
+				}
+				return resp.Properties.ProvisioningState, nil
+			})
+			if err != nil {
+				return err
+			}
+			vol.SnapshotID = snapshotID.String()
+			if resp.Location != nil && len(resp.Zones) > 0 && resp.Zones[0] != nil {
+				vol.VolumeAZ = fmt.Sprintf("%s-%s", *resp.Location, *resp.Zones[0])
+			}
+			return nil
+		})
+	}
+	return eg.Wait()
+}
+
+// CreateDisks creates the disks from the snapshots in the restore meta, and records the ids of the disks in it.
+// The disks are named with the prefix and the names of the backup disks, and placed in the resource groups of
+// the snapshots. The disks are created in the zone, or the zones of the backup disks if it's empty, the format
+// of the zone is the same as the zone label of the nodes, such as eastus2-1. It returns once all disks are provisioned
+// or ctx is done.
+func (s *AzureDiskSession) CreateDisks(ctx context.Context, meta *EBSBasedBRMeta, prefix, zone string) error {
+	volumes := metaVolumes(meta)
+	ids := make([]*AzureResourceID, 0, len(volumes))
+	for _, vol := range volumes {
+		id, err := ParseAzureResourceID(vol.SnapshotID, AzureSnapshotResourceType)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, id)
+	}
+	disksClients, err := s.disksClients(ids)
+	if err != nil {
+		return err
+	}
+	snapshotsClients, err := s.snapshotsClients(ids)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, azureProvisionTimeout)
+	defer cancel()
+	eg, ctx := errgroup.WithContext(ctx)
+	workerPool := NewWorkerPool(s.concurrency, "create disks")
+	for i, vol := range volumes {
+		snapshotID, vol := ids[i], vol
+		workerPool.ApplyOnErrorGroup(eg, func() error {
+			resp, err := snapshotsClients[snapshotID.SubscriptionID].Get(ctx, snapshotID.ResourceGroup, snapshotID.Name, nil)
+			if err != nil {
+				return fmt.Errorf("get snapshot %s error: %w", snapshotID, err)
+			}
+			backupDiskName := vol.ID
+			if id, err := ParseAzureResourceID(vol.ID, AzureDiskResourceType); err == nil {
+				backupDiskName = id.Name
+			}
+			diskID := &AzureResourceID{
+				SubscriptionID: snapshotID.SubscriptionID,
+				ResourceGroup:  snapshotID.ResourceGroup,
+				Type:           AzureDiskResourceType,
+				Name:           azureResourceName(prefix, backupDiskName),
+			}
+			disk := armcompute.Disk{
+				Location: resp.Location,
+				Properties: &armcompute.DiskProperties{
+					CreationData: &armcompute.CreationData{
+						CreateOption:     to.Ptr(armcompute.DiskCreateOptionCopy),
+						SourceResourceID: to.Ptr(snapshotID.String()),
+					},
+				},
+			}
+			if sku := resp.Tags[AzureSourceDiskSKUTagKey]; sku != nil {
+				disk.SKU = &armcompute.DiskSKU{Name: to.Ptr(armcompute.DiskStorageAccountTypes(*sku))}
+			}
+			volumeAZ := zone
+			if volumeAZ == "" {
+				volumeAZ = vol.VolumeAZ
+			}
+			if diskZone := AzureDiskZone(volumeAZ); diskZone != "" {
+				disk.Zones = []*string{to.Ptr(diskZone)}
+			}
+			client := disksClients[diskID.SubscriptionID]
+			if _, err := client.BeginCreateOrUpdate(ctx, diskID.ResourceGroup, diskID.Name, disk, nil); err != nil {
+				return fmt.Errorf("create disk %s from snapshot %s error: %w", diskID, snapshotID, err)
+			}
+			klog.Infof("disk %s is being created from snapshot %s", diskID, snapshotID)
+
+			err = s.waitProvisioned(ctx, diskID, func(ctx context.Context) (*string, error) {
+				resp, err := client.Get(ctx, diskID.ResourceGroup, diskID.Name, nil)
+				if err != nil || resp.Properties == nil {
+					return nil, err
+				}
+				return resp.Properties.ProvisioningState, nil
+			})
+			if err != nil {
+				return err
+			}
+			vol.RestoreVolumeId = diskID.String()
+			vol.VolumeAZ = volumeAZ
+			return nil
+		})
+	}
+	return eg.Wait()
+}
+
+// waitProvisioned waits until the provisioning state of the resource is succeeded
+func (s *AzureDiskSession) waitProvisioned(ctx context.Context, id *AzureResourceID, getState func(ctx context.Context) (*string, error)) error {
+	err := wait.PollImmediateUntil(s.interval, func() (bool, error) {
+		state, err := getState(ctx)
+		if err != nil {
+			return false, err
+		}
+		if state == nil {
+			return false, nil
+		}
+		switch *state {
+		case azureProvisioningSucceeded:
+			return true, nil
+		case azureProvisioningFailed:
+			return false, fmt.Errorf("provisioning state is %s", *state)
+		}
+		return false, nil
+	}, ctx.Done())
+	if err != nil {
+		return fmt.Errorf("wait %s provisioned error: %w", id, err)
+	}
+	klog.Infof("%s is provisioned", id)
+	return nil
+}
+
+// AzureDiskZone returns the zone of the disks in the az, the format of az is the same as the zone label of
+// the nodes, such as eastus2-1, and the zone of the disks is 1. It returns empty if the az is not zonal.
+func AzureDiskZone(az string) string {
+	i := strings.LastIndex(az, "-")
+	if i < 0 {
+		return ""
+	}
+	return az[i+1:]
+}
+
+// azureResourceName returns the name of the resource with the prefix, it's truncated to the max length
+func azureResourceName(prefix, name string) string {
+	n := fmt.Sprintf("%s-%s", prefix, name)
+	if len(n) > azureResourceNameMaxLen {
+		n = n[:azureResourceNameMaxLen]
+	}
+	return n
+}
+
+func metaVolumes(meta *EBSBasedBRMeta) []*EBSVolume {
+	volumes := make([]*EBSVolume, 0)
+	if meta.TiKVComponent == nil {
+		return volumes
+	}
+	for _, store := range meta.TiKVComponent.Stores {
+		volumes = append(volumes, store.Volumes...)
+	}
+	return volumes
+}
@@ -0,0 +1,251 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	azruntime "github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/pointer"
+)
+
+type fakeAzureClient struct {
+	mu       sync.Mutex
+	existing map[string]map[string]*string
+	deleted  []string
+	updated  map[string]map[string]*string
+	// the created disks and snapshots, they are provisioned once created
+	disks     map[string]armcompute.Disk
+	snapshots map[string]armcompute.Snapshot
+}
+
+func newFakeAzureClient(existing ...string) *fakeAzureClient {
+	c := &fakeAzureClient{
+		existing:  make(map[string]map[string]*string),
+		updated:   make(map[string]map[string]*string),
+		disks:     make(map[string]armcompute.Disk),
+		snapshots: make(map[string]armcompute.Snapshot),
+	}
+	for _, name := range existing {
+		c.existing[name] = map[string]*string{"origin": pointer.StringPtr("kept")}
+	}
+	return c
+}
+
+func (c *fakeAzureClient) delete(resourceGroupName, name string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.existing[name]; !ok {
+		return &azcore.ResponseError{StatusCode: http.StatusNotFound}
+	}
+	c.deleted = append(c.deleted, resourceGroupName+"/"+name)
+	return nil
+}
+
+func (c *fakeAzureClient) Get(ctx context.Context, resourceGroupName string, diskName string, options *armcompute.DisksClientGetOptions) (armcompute.DisksClientGetResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if disk, ok := c.disks[diskName]; ok {
+		return armcompute.DisksClientGetResponse{Disk: disk}, nil
+	}
+	return armcompute.DisksClientGetResponse{Disk: armcompute.Disk{Tags: c.existing[diskName]}}, nil
+}
+
+func (c *fakeAzureClient) BeginCreateOrUpdate(ctx context.Context, resourceGroupName string, diskName string, disk armcompute.Disk, options *armcompute.DisksClientBeginCreateOrUpdateOptions) (*azruntime.Poller[armcompute.DisksClientCreateOrUpdateResponse], error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	disk.Properties.ProvisioningState = to.Ptr(azureProvisioningSucceeded)
+	c.disks[diskName] = disk
+	return nil, nil
+}
+
+func (c *fakeAzureClient) BeginUpdate(ctx context.Context, resourceGroupName string, diskName string, parameters armcompute.DiskUpdate, options *armcompute.DisksClientBeginUpdateOptions) (*azruntime.Poller[armcompute.DisksClientUpdateResponse], error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.updated[diskName] = parameters.Tags
+	return nil, nil
+}
+
+func (c *fakeAzureClient) BeginDelete(ctx context.Context, resourceGroupName string, diskName string, options *armcompute.DisksClientBeginDeleteOptions) (*azruntime.Poller[armcompute.DisksClientDeleteResponse], error) {
+	return nil, c.delete(resourceGroupName, diskName)
+}
+
+type fakeAzureSnapshotsClient struct {
+	*fakeAzureClient
+}
+
+func (c *fakeAzureSnapshotsClient) BeginDelete(ctx context.Context, resourceGroupName string, snapshotName string, options *armcompute.SnapshotsClientBeginDeleteOptions) (*azruntime.Poller[armcompute.SnapshotsClientDeleteResponse], error) {
+	return nil, c.delete(resourceGroupName, snapshotName)
+}
+
+func (c *fakeAzureSnapshotsClient) Get(ctx context.Context, resourceGroupName string, snapshotName string, options *armcompute.SnapshotsClientGetOptions) (armcompute.SnapshotsClientGetResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	snapshot, ok := c.snapshots[snapshotName]
+	if !ok {
+		return armcompute.SnapshotsClientGetResponse{}, &azcore.ResponseError{StatusCode: http.StatusNotFound}
+	}
+	return armcompute.SnapshotsClientGetResponse{Snapshot: snapshot}, nil
+}
+
+func (c *fakeAzureSnapshotsClient) BeginCreateOrUpdate(ctx context.Context, resourceGroupName string, snapshotName string, snapshot armcompute.Snapshot, options *armcompute.SnapshotsClientBeginCreateOrUpdateOptions) (*azruntime.Poller[armcompute.SnapshotsClientCreateOrUpdateResponse], error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	snapshot.Properties.ProvisioningState = to.Ptr(azureProvisioningSucceeded)
+	c.snapshots[snapshotName] = snapshot
+	return nil, nil
+}
+
+func newFakeAzureDiskSession(c *fakeAzureClient) *AzureDiskSession {
+	return &AzureDiskSession{
+		NewDisksClient: func(subscriptionID string) (AzureDisksClient, error) {
+			return c, nil
+		},
+		NewSnapshotsClient: func(subscriptionID string) (AzureSnapshotsClient, error) {
+			return &fakeAzureSnapshotsClient{c}, nil
+		},
+		concurrency: CloudAPIConcurrency,
+		interval:    time.Millisecond,
+	}
+}
+
+func TestParseAzureResourceID(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	id, err := ParseAzureResourceID("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/disks/disk-1", AzureDiskResourceType)
+	g.Expect(err).Should(BeNil())
+	g.Expect(*id).Should(Equal(AzureResourceID{SubscriptionID: "sub", ResourceGroup: "rg", Type: AzureDiskResourceType, Name: "disk-1"}))
+	g.Expect(id.String()).Should(Equal("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/disks/disk-1"))
+
+	// resource group is case insensitive in the id generated by azure
+	_, err = ParseAzureResourceID("/subscriptions/sub/resourcegroups/rg/providers/Microsoft.Compute/snapshots/snap-1", AzureSnapshotResourceType)
+	g.Expect(err).Should(BeNil())
+
+	for _, invalid := range []string{
+		"disk-1",
+		"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/snapshots/snap-1",
+		"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/disks/",
+		"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Storage/disks/disk-1",
+	} {
+		_, err = ParseAzureResourceID(invalid, AzureDiskResourceType)
+		g.Expect(err).ShouldNot(BeNil(), invalid)
+	}
+}
+
+func TestAzureDiskSession(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	c := newFakeAzureClient("disk-1", "snap-1")
+	s := newFakeAzureDiskSession(c)
+
+	// the not found disks are skipped
+	err := s.DeleteDisks([]string{
+		"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/disks/disk-1",
+		"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/disks/disk-2",
+	})
+	g.Expect(err).Should(BeNil())
+	g.Expect(c.deleted).Should(ConsistOf("rg/disk-1"))
+
+	err = s.DeleteDisks([]string{"disk-1"})
+	g.Expect(err).ShouldNot(BeNil())
+
+	c.deleted = nil
+	err = s.DeleteSnapshots(context.Background(), map[string]string{
+		"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/disks/disk-1": "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/snapshots/snap-1",
+		"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/disks/disk-2": "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/snapshots/snap-2",
+	}, 1)
+	g.Expect(err).Should(BeNil())
+	g.Expect(c.deleted).Should(ConsistOf("rg/snap-1"))
+
+	// the flow control stops waiting once the context is done
+	snapIDMap := make(map[string]string)
+	for i := 0; i < SnapshotDeletionFlowControlInterval; i++ {
+		name := fmt.Sprintf("snap-%d", i+10)
+		c.existing[name] = nil
+		snapIDMap[fmt.Sprintf("disk-%d", i+10)] = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/snapshots/" + name
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = s.DeleteSnapshots(ctx, snapIDMap, 0.001)
+	g.Expect(err).Should(Equal(context.Canceled))
+
+	// the tags are merged into the existing ones
+	err = s.AddTags(map[string]TagMap{
+		"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/disks/disk-1": {"pv": "pv-1"},
+	})
+	g.Expect(err).Should(BeNil())
+	g.Expect(c.updated).Should(HaveKey("disk-1"))
+	g.Expect(*c.updated["disk-1"]["origin"]).Should(Equal("kept"))
+	g.Expect(*c.updated["disk-1"]["pv"]).Should(Equal("pv-1"))
+}
+
+func TestAzureDiskSessionCreateSnapshotsAndDisks(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	c := newFakeAzureClient()
+	c.disks["disk-1"] = armcompute.Disk{
+		Location:   to.Ptr("eastus2"),
+		Zones:      []*string{to.Ptr("1")},
+		SKU:        &armcompute.DiskSKU{Name: to.Ptr(armcompute.DiskStorageAccountTypesPremiumLRS)},
+		Properties: &armcompute.DiskProperties{},
+	}
+	s := newFakeAzureDiskSession(c)
+
+	meta := &EBSBasedBRMeta{
+		TiKVComponent: &TiKVComponent{
+			Stores: []*EBSStore{{
+				StoreID: 1,
+				Volumes: []*EBSVolume{{ID: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/disks/disk-1"}},
+			}},
+		},
+	}
+	err := s.CreateSnapshots(context.Background(), meta, "backup-uid")
+	g.Expect(err).Should(BeNil())
+	vol := meta.TiKVComponent.Stores[0].Volumes[0]
+	g.Expect(vol.SnapshotID).Should(Equal("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/snapshots/backup-uid-disk-1"))
+	g.Expect(vol.VolumeAZ).Should(Equal("eastus2-1"))
+	snapshot := c.snapshots["backup-uid-disk-1"]
+	g.Expect(*snapshot.Properties.Incremental).Should(BeTrue())
+	g.Expect(*snapshot.Properties.CreationData.SourceResourceID).Should(Equal(vol.ID))
+	g.Expect(*snapshot.Tags[AzureSourceDiskSKUTagKey]).Should(Equal(string(armcompute.DiskStorageAccountTypesPremiumLRS)))
+
+	// the disks are restored to the zones of the backup disks by default
+	err = s.CreateDisks(context.Background(), meta, "restore-uid", "")
+	g.Expect(err).Should(BeNil())
+	g.Expect(vol.RestoreVolumeId).Should(Equal("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/disks/restore-uid-disk-1"))
+	disk := c.disks["restore-uid-disk-1"]
+	g.Expect(*disk.SKU.Name).Should(Equal(armcompute.DiskStorageAccountTypesPremiumLRS))
+	g.Expect(*disk.Properties.CreationData.SourceResourceID).Should(Equal(vol.SnapshotID))
+	g.Expect(disk.Zones).Should(Equal([]*string{to.Ptr("1")}))
+
+	// the disks are restored to another zone
+	err = s.CreateDisks(context.Background(), meta, "restore-uid-2", "eastus2-3")
+	g.Expect(err).Should(BeNil())
+	g.Expect(vol.VolumeAZ).Should(Equal("eastus2-3"))
+	g.Expect(c.disks["restore-uid-2-disk-1"].Zones).Should(Equal([]*string{to.Ptr("3")}))
+
+	// the snapshot is not found
+	meta.TiKVComponent.Stores[0].Volumes[0].SnapshotID = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/snapshots/snap-2"
+	err = s.CreateDisks(context.Background(), meta, "restore-uid-3", "")
+	g.Expect(err).ShouldNot(BeNil())
+}
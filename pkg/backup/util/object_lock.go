@@ -0,0 +1,337 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/Azure/azure-pipeline-go/pipeline"
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	errorutils "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+)
+
+const (
+	// azblobImmutabilityAPIVersion is the first version of azblob REST API which supports the immutability policy of blobs
+	azblobImmutabilityAPIVersion = "2020-10-02"
+	// azblobRetainUntilHeader is the header of the retain date of the immutability policy of blobs
+	azblobRetainUntilHeader = "x-ms-immutability-policy-until-date"
+	// azblobImmutableWithVersioningHeader is the header which indicates whether version-level immutability is enabled on the container
+	azblobImmutableWithVersioningHeader = "x-ms-immutable-storage-with-versioning-enabled"
+	// daysPerYear is used to convert the years of the default retention of S3 buckets
+	daysPerYear = 365
+)
+
+// ObjectsRetentionResult is the result of verifying the retention of the objects of a storage
+type ObjectsRetentionResult struct {
+	ObjectCount int64
+	// RetainUntil is the earliest retain date of the objects
+	RetainUntil time.Time
+}
+
+// GetObjectLock returns the object lock of the storage, nil if the objects written to it are not retained
+func GetObjectLock(provider v1alpha1.StorageProvider) *v1alpha1.ObjectLock {
+	switch GetStorageType(provider) {
+	case v1alpha1.BackupStorageTypeS3:
+		return provider.S3.ObjectLock
+	case v1alpha1.BackupStorageTypeGcs:
+		return provider.Gcs.ObjectLock
+	case v1alpha1.BackupStorageTypeAzblob:
+		return provider.Azblob.ObjectLock
+	default:
+		return nil
+	}
+}
+
+// GetRetainUntil returns the date until which the objects written at the time are retained
+func GetRetainUntil(lock *v1alpha1.ObjectLock, t time.Time) time.Time {
+	return t.AddDate(0, 0, int(lock.RetainDays)).UTC()
+}
+
+// ObjectLock returns the object lock of the storage backend, nil if the objects are not retained
+func (b *StorageBackend) ObjectLock() *v1alpha1.ObjectLock {
+	if b.s3 != nil {
+		return b.s3.objectLock
+	} else if b.gcs != nil {
+		return b.gcs.objectLock
+	} else if b.azblob != nil {
+		return b.azblob.objectLock
+	}
+	return nil
+}
+
+// objectKey returns the absolute key of the object in the bucket
+func (b *StorageBackend) objectKey(key string) string {
+	prefix := strings.Trim(b.GetPrefix(), "/")
+	if prefix == "" {
+		return key
+	}
+	return prefix + "/" + key
+}
+
+// CheckDefaultRetention checks the objects written to the storage are retained at write time as required by
+// its object lock, i.e. the default retention of the S3 bucket, the retention policy of the gcs bucket or the
+// version-level immutability of the azblob container. The operator doesn't retain the objects by itself,
+// since most of them are written by BR, dumpling and rclone.
+func (b *StorageBackend) CheckDefaultRetention(ctx context.Context) error {
+	lock := b.ObjectLock()
+	if lock == nil {
+		return fmt.Errorf("object lock of storage %s is not set", b.StorageType())
+	}
+
+	switch b.StorageType() {
+	case v1alpha1.BackupStorageTypeS3:
+		s3cli, ok := b.AsS3()
+		if !ok {
+			return fmt.Errorf("get s3 client failed")
+		}
+		return CheckDefaultRetentionOfS3(ctx, s3cli, b.GetBucket(), lock)
+	case v1alpha1.BackupStorageTypeGcs:
+		client, ok := b.AsGCS()
+		if !ok {
+			return fmt.Errorf("get gcs client failed")
+		}
+		attrs, err := client.Bucket(b.GetBucket()).Attrs(ctx)
+		if err != nil {
+			return err
+		}
+		return checkRetentionPolicyOfGCS(attrs.RetentionPolicy, lock)
+	case v1alpha1.BackupStorageTypeAzblob:
+		header, err := b.doAzblobRequest(ctx, http.MethodHead, "", url.Values{"restype": []string{"container"}}, nil)
+		if err != nil {
+			return err
+		}
+		if header.Get(azblobImmutableWithVersioningHeader) != "true" {
+			return fmt.Errorf("version-level immutability is not enabled on container %s", b.GetBucket())
+		}
+		return nil
+	default:
+		return fmt.Errorf("object lock is not supported by storage %s", b.StorageType())
+	}
+}
+
+// VerifyObjectsRetention verifies all the objects of the storage written since the time are retained as required
+// by its object lock concurrently, keys limits the objects to verify if it is not empty
+func VerifyObjectsRetention(ctx context.Context, b *StorageBackend, since time.Time, concurrency int, keys ...string) (*ObjectsRetentionResult, error) {
+	lock := b.ObjectLock()
+	if lock == nil {
+		return nil, fmt.Errorf("object lock of storage %s is not set", b.StorageType())
+	}
+
+	if len(keys) == 0 {
+		iter := b.List(nil)
+		for {
+			obj, err := iter.Next(ctx)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("list objects failed, err: %v", err)
+			}
+			keys = append(keys, obj.Key)
+		}
+	}
+
+	// the objects written after the time are retained until the date at least
+	expected := GetRetainUntil(lock, since).Truncate(time.Second)
+	retainUntils := make([]time.Time, len(keys))
+	errs := make([]error, len(keys))
+	workqueue.ParallelizeUntil(ctx, concurrency, len(keys), func(piece int) {
+		retainUntils[piece], errs[piece] = b.GetObjectRetainUntil(ctx, keys[piece])
+	})
+
+	result := &ObjectsRetentionResult{ObjectCount: int64(len(keys))}
+	var verifyErrs []error
+	for i, key := range keys {
+		if errs[i] != nil {
+			verifyErrs = append(verifyErrs, fmt.Errorf("get retention of object %s failed, err: %v", key, errs[i]))
+			continue
+		}
+		if retainUntils[i].Before(expected) {
+			verifyErrs = append(verifyErrs, fmt.Errorf("object %s is retained until %s, expect %s at least",
+				key, retainUntils[i].Format(time.RFC3339), expected.Format(time.RFC3339)))
+			continue
+		}
+		if result.RetainUntil.IsZero() || retainUntils[i].Before(result.RetainUntil) {
+			result.RetainUntil = retainUntils[i]
+		}
+	}
+	if len(verifyErrs) != 0 {
+		return nil, errorutils.NewAggregate(verifyErrs)
+	}
+	return result, nil
+}
+
+// GetObjectRetainUntil returns the retain date of the object, zero if the object is not retained
+func (b *StorageBackend) GetObjectRetainUntil(ctx context.Context, key string) (time.Time, error) {
+	switch b.StorageType() {
+	case v1alpha1.BackupStorageTypeS3:
+		s3cli, ok := b.AsS3()
+		if !ok {
+			return time.Time{}, fmt.Errorf("get s3 client failed")
+		}
+		return GetRetainUntilOfS3(ctx, s3cli, b.GetBucket(), b.objectKey(key))
+	case v1alpha1.BackupStorageTypeGcs:
+		client, ok := b.AsGCS()
+		if !ok {
+			return time.Time{}, fmt.Errorf("get gcs client failed")
+		}
+		attrs, err := client.Bucket(b.GetBucket()).Object(b.objectKey(key)).Attrs(ctx)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return attrs.RetentionExpirationTime, nil
+	case v1alpha1.BackupStorageTypeAzblob:
+		header, err := b.doAzblobRequest(ctx, http.MethodHead, key, nil, nil)
+		if err != nil || header.Get(azblobRetainUntilHeader) == "" {
+			return time.Time{}, err
+		}
+		return time.Parse(http.TimeFormat, header.Get(azblobRetainUntilHeader))
+	default:
+		return time.Time{}, nil
+	}
+}
+
+// CheckObjectRetention returns the retain date of the object if it is still retained at the time,
+// otherwise returns the zero time.
+func (b *StorageBackend) CheckObjectRetention(ctx context.Context, key string, t time.Time) (time.Time, error) {
+	retainUntil, err := b.GetObjectRetainUntil(ctx, key)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if retainUntil.After(t) {
+		return retainUntil, nil
+	}
+	return time.Time{}, nil
+}
+
+// CheckDefaultRetentionOfS3 checks the S3 bucket has Object Lock enabled with a default retention
+// which is at least as strict as the object lock
+func CheckDefaultRetentionOfS3(ctx context.Context, s3cli s3iface.S3API, bucket string, lock *v1alpha1.ObjectLock) error {
+	output, err := s3cli.GetObjectLockConfigurationWithContext(ctx, &s3.GetObjectLockConfigurationInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		return fmt.Errorf("get object lock configuration of bucket %s failed, err: %v", bucket, err)
+	}
+	config := output.ObjectLockConfiguration
+	if config == nil || aws.StringValue(config.ObjectLockEnabled) != s3.ObjectLockEnabledEnabled {
+		return fmt.Errorf("object lock is not enabled on bucket %s", bucket)
+	}
+	if config.Rule == nil || config.Rule.DefaultRetention == nil {
+		return fmt.Errorf("default retention is not set on bucket %s", bucket)
+	}
+	retention := config.Rule.DefaultRetention
+	if lock.Mode != v1alpha1.ObjectLockModeGovernance && aws.StringValue(retention.Mode) != s3.ObjectLockRetentionModeCompliance {
+		return fmt.Errorf("mode of the default retention of bucket %s is %s, expect %s", bucket, aws.StringValue(retention.Mode), s3.ObjectLockRetentionModeCompliance)
+	}
+	days := aws.Int64Value(retention.Days) + aws.Int64Value(retention.Years)*daysPerYear
+	if days < int64(lock.RetainDays) {
+		return fmt.Errorf("default retention of bucket %s is %d days, expect %d days at least", bucket, days, lock.RetainDays)
+	}
+	return nil
+}
+
+// GetRetainUntilOfS3 returns the retain date of the object by S3 Object Lock, zero if the object is not retained
+func GetRetainUntilOfS3(ctx context.Context, s3cli s3iface.S3API, bucket, key string) (time.Time, error) {
+	output, err := s3cli.GetObjectRetentionWithContext(ctx, &s3.GetObjectRetentionInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			case "NoSuchObjectLockConfiguration", "ObjectLockConfigurationNotFoundError":
+				return time.Time{}, nil
+			}
+		}
+		return time.Time{}, err
+	}
+	if output.Retention == nil || output.Retention.RetainUntilDate == nil {
+		return time.Time{}, nil
+	}
+	return *output.Retention.RetainUntilDate, nil
+}
+
+// checkRetentionPolicyOfGCS checks the retention policy of the gcs bucket is at least as strict as the object lock,
+// the policy must be locked for compliance mode, otherwise it can be removed.
+func checkRetentionPolicyOfGCS(policy *storage.RetentionPolicy, lock *v1alpha1.ObjectLock) error {
+	if policy == nil {
+		return fmt.Errorf("retention policy is not set on the bucket")
+	}
+	if policy.RetentionPeriod < time.Duration(lock.RetainDays)*24*time.Hour {
+		return fmt.Errorf("retention period %s of the bucket is shorter than %d days", policy.RetentionPeriod, lock.RetainDays)
+	}
+	if lock.Mode != v1alpha1.ObjectLockModeGovernance && !policy.IsLocked {
+		return fmt.Errorf("retention policy of the bucket is not locked")
+	}
+	return nil
+}
+
+// doAzblobRequest sends the request of the newer azblob REST API which is not supported by the azblob SDK,
+// such as the immutability policy of blobs, and returns the header of the response. The request is sent
+// to the container if key is empty.
+func (b *StorageBackend) doAzblobRequest(ctx context.Context, method, key string, query url.Values, header map[string]string) (http.Header, error) {
+	var containerURL *azblob.ContainerURL
+	if ok := b.As(&containerURL); !ok {
+		return nil, fmt.Errorf("get azblob container url failed")
+	}
+	p, _, _, err := newAzblobPipeline(b.azblob, b.cred)
+	if err != nil {
+		return nil, err
+	}
+
+	u := containerURL.URL()
+	if key != "" {
+		u = containerURL.NewBlobURL(b.objectKey(key)).URL()
+	}
+	values := u.Query()
+	for k, v := range query {
+		values[k] = v
+	}
+	u.RawQuery = values.Encode()
+	req, err := pipeline.NewRequest(method, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("x-ms-version", azblobImmutabilityAPIVersion)
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := p.Do(ctx, nil, req)
+	if err != nil {
+		return nil, err
+	}
+	r := resp.Response()
+	defer r.Body.Close()
+	_, _ = io.Copy(io.Discard, r.Body)
+	if r.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("%s blob %s failed, status: %s, code: %s", method, key, r.Status, r.Header.Get("x-ms-error-code"))
+	}
+	return r.Header, nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/onsi/gomega"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+)
+
+func TestGetRetainUntil(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	now := time.Date(2024, 2, 28, 10, 0, 0, 0, time.FixedZone("UTC+8", 8*3600))
+	retainUntil := GetRetainUntil(&v1alpha1.ObjectLock{RetainDays: 2}, now)
+	g.Expect(retainUntil).Should(gomega.Equal(time.Date(2024, 3, 1, 2, 0, 0, 0, time.UTC)))
+}
+
+func TestCheckDefaultRetentionOfS3(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	rule := func(mode string, days, years int64) *s3.ObjectLockConfiguration {
+		return &s3.ObjectLockConfiguration{
+			ObjectLockEnabled: aws.String(s3.ObjectLockEnabledEnabled),
+			Rule: &s3.ObjectLockRule{DefaultRetention: &s3.DefaultRetention{
+				Mode:  aws.String(mode),
+				Days:  aws.Int64(days),
+				Years: aws.Int64(years),
+			}},
+		}
+	}
+	type testcase struct {
+		lock      *v1alpha1.ObjectLock
+		config    *s3.ObjectLockConfiguration
+		err       error
+		expectErr bool
+	}
+	cases := []testcase{
+		{
+			lock:   &v1alpha1.ObjectLock{RetainDays: 30},
+			config: rule(s3.ObjectLockRetentionModeCompliance, 30, 0),
+		},
+		{
+			lock:   &v1alpha1.ObjectLock{RetainDays: 400},
+			config: rule(s3.ObjectLockRetentionModeCompliance, 0, 2),
+		},
+		{
+			lock:   &v1alpha1.ObjectLock{Mode: v1alpha1.ObjectLockModeGovernance, RetainDays: 30},
+			config: rule(s3.ObjectLockRetentionModeGovernance, 30, 0),
+		},
+		{
+			lock:      &v1alpha1.ObjectLock{RetainDays: 30},
+			config:    rule(s3.ObjectLockRetentionModeGovernance, 30, 0),
+			expectErr: true,
+		},
+		{
+			lock:      &v1alpha1.ObjectLock{RetainDays: 30},
+			config:    rule(s3.ObjectLockRetentionModeCompliance, 7, 0),
+			expectErr: true,
+		},
+		{
+			lock:      &v1alpha1.ObjectLock{RetainDays: 30},
+			config:    &s3.ObjectLockConfiguration{ObjectLockEnabled: aws.String(s3.ObjectLockEnabledEnabled)},
+			expectErr: true,
+		},
+		{
+			lock:      &v1alpha1.ObjectLock{RetainDays: 30},
+			err:       awserr.New("ObjectLockConfigurationNotFoundError", "not found", nil),
+			expectErr: true,
+		},
+	}
+
+	for _, tcase := range cases {
+		cli := &mockS3Client{
+			getObjectLockConfiguration: func(*s3.GetObjectLockConfigurationInput) (*s3.GetObjectLockConfigurationOutput, error) {
+				return &s3.GetObjectLockConfigurationOutput{ObjectLockConfiguration: tcase.config}, tcase.err
+			},
+		}
+		err := CheckDefaultRetentionOfS3(context.TODO(), cli, "bucket", tcase.lock)
+		if tcase.expectErr {
+			g.Expect(err).ShouldNot(gomega.BeNil())
+		} else {
+			g.Expect(err).Should(gomega.BeNil())
+		}
+	}
+}
+
+func TestCheckRetentionPolicyOfGCS(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	lock := &v1alpha1.ObjectLock{RetainDays: 30}
+	period := 30 * 24 * time.Hour
+	g.Expect(checkRetentionPolicyOfGCS(nil, lock)).ShouldNot(gomega.BeNil())
+	g.Expect(checkRetentionPolicyOfGCS(&storage.RetentionPolicy{RetentionPeriod: period, IsLocked: true}, lock)).Should(gomega.BeNil())
+	g.Expect(checkRetentionPolicyOfGCS(&storage.RetentionPolicy{RetentionPeriod: period - time.Hour, IsLocked: true}, lock)).ShouldNot(gomega.BeNil())
+	// the unlocked retention policy can be removed, it's only allowed in governance mode
+	g.Expect(checkRetentionPolicyOfGCS(&storage.RetentionPolicy{RetentionPeriod: period}, lock)).ShouldNot(gomega.BeNil())
+	lock.Mode = v1alpha1.ObjectLockModeGovernance
+	g.Expect(checkRetentionPolicyOfGCS(&storage.RetentionPolicy{RetentionPeriod: period}, lock)).Should(gomega.BeNil())
+}
+
+func TestGetRetainUntilOfS3(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	retainUntil := time.Now().Add(24 * time.Hour).UTC()
+	type testcase struct {
+		output    *s3.GetObjectRetentionOutput
+		err       error
+		expectErr bool
+		expect    time.Time
+	}
+	cases := []testcase{
+		{
+			output: &s3.GetObjectRetentionOutput{Retention: &s3.ObjectLockRetention{RetainUntilDate: aws.Time(retainUntil)}},
+			expect: retainUntil,
+		},
+		{
+			output: &s3.GetObjectRetentionOutput{},
+		},
+		{
+			err: awserr.New("NoSuchObjectLockConfiguration", "not locked", nil),
+		},
+		{
+			err:       awserr.New(s3.ErrCodeNoSuchKey, "no such key", nil),
+			expectErr: true,
+		},
+	}
+
+	for _, tcase := range cases {
+		cli := &mockS3Client{
+			getObjectRetention: func(*s3.GetObjectRetentionInput) (*s3.GetObjectRetentionOutput, error) {
+				return tcase.output, tcase.err
+			},
+		}
+		got, err := GetRetainUntilOfS3(context.TODO(), cli, "bucket", "key")
+		if tcase.expectErr {
+			g.Expect(err).ShouldNot(gomega.BeNil())
+			continue
+		}
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(got).Should(gomega.Equal(tcase.expect))
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"sync"
 
 	"cloud.google.com/go/storage"
+	"github.com/Azure/azure-pipeline-go/pipeline"
 	"github.com/Azure/azure-storage-blob-go/azblob"
 	"github.com/Azure/go-autorest/autorest/azure/auth"
 	"github.com/aws/aws-sdk-go/aws"
@@ -41,7 +42,7 @@ import (
 	"gocloud.dev/blob/s3blob"
 	"gocloud.dev/gcerrors"
 	"gocloud.dev/gcp"
-	corev1 "k8s.io/api/core/v1"
+	"golang.org/x/oauth2/google"
 	corelisterv1 "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
@@ -55,9 +56,22 @@ const (
 	defaultStorageFlag = "storage"
 )
 
+// StorageCredential is the credential to access the storage, the default credential of the
+// environment, such as IRSA or the env vars of the pod, is used for the storage type whose credential is not set.
 type StorageCredential struct {
-	//TODO: currently, we do not have better way to unify storage credentials, temp solution using s3 credentials
 	awsCred *credentials.Credentials
+	// gcsCredentials is the json key of the gcs service account
+	gcsCredentials []byte
+	azblobCred     *azblobCred
+}
+
+// azblobCred is the credential of azblob, which is either AAD or shared key credential
+type azblobCred struct {
+	account      string
+	clientID     string
+	clientSecret string
+	tenantID     string
+	sharedKey    string
 }
 
 type s3Config struct {
@@ -70,6 +84,7 @@ type s3Config struct {
 	acl            string
 	storageClass   string
 	forcePathStyle bool
+	objectLock     *v1alpha1.ObjectLock
 }
 
 type gcsConfig struct {
@@ -81,6 +96,7 @@ type gcsConfig struct {
 	bucketAcl    string
 	secretName   string
 	prefix       string
+	objectLock   *v1alpha1.ObjectLock
 }
 
 type azblobConfig struct {
@@ -90,6 +106,7 @@ type azblobConfig struct {
 	accessTier     string
 	secretName     string
 	prefix         string
+	objectLock     *v1alpha1.ObjectLock
 }
 
 type localConfig struct {
@@ -105,6 +122,8 @@ type StorageBackend struct {
 	gcs    *gcsConfig
 	azblob *azblobConfig
 	local  *localConfig
+
+	cred *StorageCredential
 }
 
 // NewStorageBackend creates new storage backend, now supports S3/GCS/Azblob/Local
@@ -113,7 +132,7 @@ func NewStorageBackend(provider v1alpha1.StorageProvider, cred *StorageCredentia
 	var bucket *blob.Bucket
 	var err error
 
-	b := &StorageBackend{}
+	b := &StorageBackend{cred: cred}
 
 	st := GetStorageType(provider)
 	switch st {
@@ -122,10 +141,10 @@ func NewStorageBackend(provider v1alpha1.StorageProvider, cred *StorageCredentia
 		bucket, err = newS3Storage(b.s3, cred)
 	case v1alpha1.BackupStorageTypeGcs:
 		b.gcs = makeGcsConfig(provider.Gcs, true)
-		bucket, err = newGcsStorage(b.gcs)
+		bucket, err = newGcsStorage(b.gcs, cred)
 	case v1alpha1.BackupStorageTypeAzblob:
 		b.azblob = makeAzblobConfig(provider.Azblob)
-		bucket, err = newAzblobStorage(b.azblob)
+		bucket, err = newAzblobStorage(b.azblob, cred)
 	case v1alpha1.BackupStorageTypeLocal:
 		b.local = makeLocalConfig(provider.Local)
 		bucket, err = newLocalStorage(b.local)
@@ -328,40 +347,61 @@ func BatchDeleteObjectsConcurrently(ctx context.Context, bucket *blob.Bucket, ob
 	return result
 }
 
+// GetStorageCredential returns the credential of the storage from its secret,
+// the default credential of the environment is used if the secret is not set.
 func GetStorageCredential(ns string, provider v1alpha1.StorageProvider, secretLister corelisterv1.SecretLister) *StorageCredential {
-	var err error
-	var secret *corev1.Secret
+	var secretName string
 	storageType := GetStorageType(provider)
-
 	switch storageType {
 	case v1alpha1.BackupStorageTypeS3:
-		s3SecretName := provider.S3.SecretName
-		if s3SecretName != "" {
-			secret, err = secretLister.Secrets(ns).Get(s3SecretName)
-			if err != nil {
-				klog.Errorf("Get the secret key failed.")
-				return &StorageCredential{}
-			}
-
-			s3AccessKey := string(secret.Data[constants.S3AccessKey])
-			s3SecretKey := string(secret.Data[constants.S3SecretKey])
-
-			if s3AccessKey != "" && s3SecretKey != "" {
-				cred := credentials.NewStaticCredentials(s3AccessKey, s3SecretKey, "")
-				return &StorageCredential{
-					cred,
-				}
-			}
-
-		}
-	//TODO: will support gcs
+		secretName = provider.S3.SecretName
 	case v1alpha1.BackupStorageTypeGcs:
+		secretName = provider.Gcs.SecretName
+	case v1alpha1.BackupStorageTypeAzblob:
+		secretName = provider.Azblob.SecretName
+	}
+	if secretName == "" {
 		return &StorageCredential{}
-	default:
+	}
+
+	secret, err := secretLister.Secrets(ns).Get(secretName)
+	if err != nil {
+		klog.Errorf("Get the secret %s/%s of %s storage failed, err: %v", ns, secretName, storageType, err)
 		return &StorageCredential{}
 	}
+	cred := newStorageCredential(storageType, func(key string) string {
+		return string(secret.Data[key])
+	})
+	return cred
+}
 
-	return &StorageCredential{}
+// newStorageCredential returns the credential of the storage type with the values got by the keys,
+// the keys are the same as the ones in the secret of the storage.
+func newStorageCredential(storageType v1alpha1.BackupStorageType, get func(key string) string) *StorageCredential {
+	cred := &StorageCredential{}
+	switch storageType {
+	case v1alpha1.BackupStorageTypeS3:
+		accessKey, secretKey := get(constants.S3AccessKey), get(constants.S3SecretKey)
+		if accessKey != "" && secretKey != "" {
+			cred.awsCred = credentials.NewStaticCredentials(accessKey, secretKey, "")
+		}
+	case v1alpha1.BackupStorageTypeGcs:
+		if key := get(constants.GcsCredentialsKey); key != "" {
+			cred.gcsCredentials = []byte(key)
+		}
+	case v1alpha1.BackupStorageTypeAzblob:
+		azCred := &azblobCred{
+			account:      get(constants.AzblobAccountName),
+			clientID:     get(constants.AzblobClientID),
+			clientSecret: get(constants.AzblobClientScrt),
+			tenantID:     get(constants.AzblobTenantID),
+			sharedKey:    get(constants.AzblobAccountKey),
+		}
+		if azCred.sharedKey != "" || (azCred.clientID != "" && azCred.clientSecret != "" && azCred.tenantID != "") {
+			cred.azblobCred = azCred
+		}
+	}
+	return cred
 }
 
 // genStorageArgs returns the arg for --flag option and the remote/local path for br, default flag is storage.
@@ -393,6 +433,95 @@ func GenStorageArgsForFlag(provider v1alpha1.StorageProvider, flag string) ([]st
 	}
 }
 
+// AppendStoragePrefix returns a copy of the storage provider whose prefix is appended with subPath
+func AppendStoragePrefix(provider v1alpha1.StorageProvider, subPath string) v1alpha1.StorageProvider {
+	p := provider.DeepCopy()
+	switch GetStorageType(provider) {
+	case v1alpha1.BackupStorageTypeS3:
+		p.S3.Prefix = path.Join(p.S3.Prefix, subPath)
+	case v1alpha1.BackupStorageTypeGcs:
+		p.Gcs.Prefix = path.Join(p.Gcs.Prefix, subPath)
+	case v1alpha1.BackupStorageTypeAzblob:
+		p.Azblob.Prefix = path.Join(p.Azblob.Prefix, subPath)
+	case v1alpha1.BackupStorageTypeLocal:
+		p.Local.Prefix = path.Join(p.Local.Prefix, subPath)
+	}
+	return *p
+}
+
+// SetStorageBucketAndPrefix returns a copy of the storage provider whose bucket and prefix are
+// parsed from the path, such as s3://bucket/prefix or bucket/prefix
+func SetStorageBucketAndPrefix(provider v1alpha1.StorageProvider, storagePath string) v1alpha1.StorageProvider {
+	if i := strings.LastIndex(storagePath, "://"); i >= 0 {
+		storagePath = storagePath[i+len("://"):]
+	}
+	fields := strings.SplitN(strings.Trim(storagePath, "/")+"/", "/", 2)
+	bucket, prefix := fields[0], strings.Trim(fields[1], "/")
+
+	p := provider.DeepCopy()
+	switch GetStorageType(provider) {
+	case v1alpha1.BackupStorageTypeS3:
+		p.S3.Bucket, p.S3.Prefix = bucket, prefix
+	case v1alpha1.BackupStorageTypeGcs:
+		p.Gcs.Bucket, p.Gcs.Prefix = bucket, prefix
+	case v1alpha1.BackupStorageTypeAzblob:
+		p.Azblob.Container, p.Azblob.Prefix = bucket, prefix
+	}
+	return *p
+}
+
+// AppendStorageQuery appends the options of the storage to the URL as query parameters, which is
+// the form accepted by the external storage of dumpling and TiDB Lightning
+func AppendStorageQuery(provider v1alpha1.StorageProvider, storageURL string) string {
+	values := url.Values{}
+	switch GetStorageType(provider) {
+	case v1alpha1.BackupStorageTypeS3:
+		conf := makeS3Config(provider.S3, false)
+		if conf.region != "" {
+			values.Add("region", conf.region)
+		}
+		if conf.provider != "" {
+			values.Add("provider", conf.provider)
+		}
+		if conf.endpoint != "" {
+			values.Add("endpoint", conf.endpoint)
+		}
+		if conf.sse != "" {
+			values.Add("sse", conf.sse)
+		}
+		if conf.acl != "" {
+			values.Add("acl", conf.acl)
+		}
+		if conf.storageClass != "" {
+			values.Add("storage-class", conf.storageClass)
+		}
+		values.Add("force-path-style", fmt.Sprintf("%t", conf.forcePathStyle))
+	case v1alpha1.BackupStorageTypeGcs:
+		conf := makeGcsConfig(provider.Gcs, false)
+		if conf.storageClass != "" {
+			values.Add("storage-class", conf.storageClass)
+		}
+		if conf.objectAcl != "" {
+			values.Add("predefined-acl", conf.objectAcl)
+		}
+	case v1alpha1.BackupStorageTypeAzblob:
+		conf := makeAzblobConfig(provider.Azblob)
+		if conf.storageAccount != "" {
+			values.Add("account-name", conf.storageAccount)
+		}
+		if conf.sasToken != "" {
+			values.Add("sas-token", conf.sasToken)
+		}
+		if conf.accessTier != "" {
+			values.Add("access-tier", conf.accessTier)
+		}
+	}
+	if v := values.Encode(); v != "" {
+		return storageURL + "?" + v
+	}
+	return storageURL
+}
+
 // newLocalStorageOption constructs `--flag local://$PATH` arg for br
 func newLocalStorageOptionForFlag(conf *localConfig, flag string) ([]string, error) {
 	if flag != "" && flag != defaultStorageFlag {
@@ -474,11 +603,17 @@ func newS3Storage(conf *s3Config, cred *StorageCredential) (*blob.Bucket, error)
 }
 
 // newGcsStorage initialize a new gcs storage
-func newGcsStorage(conf *gcsConfig) (*blob.Bucket, error) {
+func newGcsStorage(conf *gcsConfig, cred *StorageCredential) (*blob.Bucket, error) {
 	ctx := context.Background()
 
 	// Your GCP credentials.
-	creds, err := gcp.DefaultCredentials(ctx)
+	var creds *google.Credentials
+	var err error
+	if cred != nil && len(cred.gcsCredentials) > 0 {
+		creds, err = google.CredentialsFromJSON(ctx, cred.gcsCredentials, storage.ScopeFullControl)
+	} else {
+		creds, err = gcp.DefaultCredentials(ctx)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -514,65 +649,89 @@ type azblobSharedKeyCred struct {
 }
 
 // newAzblobStorage initialize a new azblob storage
-func newAzblobStorage(conf *azblobConfig) (*blob.Bucket, error) {
-	account := os.Getenv("AZURE_STORAGE_ACCOUNT")
+func newAzblobStorage(conf *azblobConfig, cred *StorageCredential) (*blob.Bucket, error) {
+	pipeline, accountName, opts, err := newAzblobPipeline(conf, cred)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a *blob.Bucket.
+	ctx := context.Background()
+	bucket, err := azureblob.OpenBucket(ctx, pipeline, accountName, conf.container, opts)
+	if err != nil {
+		return nil, err
+	}
+	return blob.PrefixedBucket(bucket, strings.Trim(conf.prefix, "/")+"/"), nil
+}
+
+// newAzblobPipeline creates the pipeline to access the azblob storage with the credentials,
+// the credentials from env are used if they are not set.
+func newAzblobPipeline(conf *azblobConfig, cred *StorageCredential) (pipeline.Pipeline, azureblob.AccountName, *azureblob.Options, error) {
+	azCred := &azblobCred{
+		account:      os.Getenv("AZURE_STORAGE_ACCOUNT"),
+		clientID:     os.Getenv("AZURE_CLIENT_ID"),
+		clientSecret: os.Getenv("AZURE_CLIENT_SECRET"),
+		tenantID:     os.Getenv("AZURE_TENANT_ID"),
+		sharedKey:    os.Getenv("AZURE_STORAGE_KEY"),
+	}
+	if cred != nil && cred.azblobCred != nil {
+		azCred = cred.azblobCred
+	}
+	account := conf.storageAccount
 	if len(account) == 0 {
-		return nil, errors.New("No AZURE_STORAGE_ACCOUNT")
+		account = azCred.account
+	}
+	if len(account) == 0 {
+		return nil, "", nil, errors.New("No AZURE_STORAGE_ACCOUNT")
 	}
 
 	// Azure AAD Service Principal with access to the storage account.
-	clientID := os.Getenv("AZURE_CLIENT_ID")
-	clientSecret := os.Getenv("AZURE_CLIENT_SECRET")
-	tenantID := os.Getenv("AZURE_TENANT_ID")
+	clientID := azCred.clientID
+	clientSecret := azCred.clientSecret
+	tenantID := azCred.tenantID
 
 	// Azure shared key with access to the storage account
-	accountKey := os.Getenv("AZURE_STORAGE_KEY")
+	accountKey := azCred.sharedKey
 
 	// Azure Storage Account Shared Access Signature Token
 	sasToken := conf.sasToken
 
-	var bucket *blob.Bucket
+	var p pipeline.Pipeline
+	var opts *azureblob.Options
 	var err error
 	if len(sasToken) != 0 {
-		bucket, err = newAzblobStorageUsingSasToken(conf, account, sasToken)
+		p, opts = newAzblobPipelineUsingSasToken(sasToken)
 	} else if len(clientID) != 0 && len(clientSecret) != 0 && len(tenantID) != 0 {
-		bucket, err = newAzblobStorageUsingAAD(conf, &azblobAADCred{
+		p, opts, err = newAzblobPipelineUsingAAD(&azblobAADCred{
 			account:      account,
 			clientID:     clientID,
 			clientSecret: clientSecret,
 			tenantID:     tenantID,
 		})
 	} else if len(accountKey) != 0 {
-		bucket, err = newAzblobStorageUsingSharedKey(conf, &azblobSharedKeyCred{
+		p, opts, err = newAzblobPipelineUsingSharedKey(&azblobSharedKeyCred{
 			account:   account,
 			sharedKey: accountKey,
 		})
 	} else {
-		return nil, errors.New("Missing necessary key(s) for credentials")
+		return nil, "", nil, errors.New("Missing necessary key(s) for credentials")
 	}
 
 	if err != nil {
-		return nil, err
+		return nil, "", nil, err
 	}
-	return blob.PrefixedBucket(bucket, strings.Trim(conf.prefix, "/")+"/"), nil
+	return p, azureblob.AccountName(account), opts, nil
 }
 
-func newAzblobStorageUsingSasToken(conf *azblobConfig, account, token string) (*blob.Bucket, error) {
-	// Azure Storage Account.
-	accountName := azureblob.AccountName(account)
+func newAzblobPipelineUsingSasToken(token string) (pipeline.Pipeline, *azureblob.Options) {
+	// Azure Storage Account Shared Access Signature Token.
 	sasToken := azureblob.SASToken(token)
 	cred := azblob.NewAnonymousCredential()
-	pipeline := azureblob.NewPipeline(cred, azblob.PipelineOptions{})
-	// Create a *blob.Bucket.
-	ctx := context.Background()
-	return azureblob.OpenBucket(ctx, pipeline, accountName, conf.container, &azureblob.Options{SASToken: sasToken})
+	return azureblob.NewPipeline(cred, azblob.PipelineOptions{}), &azureblob.Options{SASToken: sasToken}
 }
 
-// newAzblobStorageUsingAAD initialize a new azblob storage using AAD credentials
-func newAzblobStorageUsingAAD(conf *azblobConfig, cred *azblobAADCred) (*blob.Bucket, error) {
-	// Azure Storage Account.
-	accountName := azureblob.AccountName(cred.account)
-
+// newAzblobPipelineUsingAAD creates the azblob pipeline using AAD credentials
+func newAzblobPipelineUsingAAD(cred *azblobAADCred) (pipeline.Pipeline, *azureblob.Options, error) {
 	// Get an Oauth2 token for the account for use with Azure Storage.
 	ccc := auth.NewClientCredentialsConfig(cred.clientID, cred.clientSecret, cred.tenantID)
 
@@ -580,29 +739,23 @@ func newAzblobStorageUsingAAD(conf *azblobConfig, cred *azblobAADCred) (*blob.Bu
 	ccc.Resource = "https://storage.azure.com/"
 	token, err := ccc.ServicePrincipalToken()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Refresh OAuth2 token.
 	if err := token.RefreshWithContext(context.Background()); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Create the credential using the OAuth2 token.
 	credential := azblob.NewTokenCredential(token.OAuthToken(), nil)
 
 	// Create a Pipeline, using whatever PipelineOptions you need.
-	pipeline := azureblob.NewPipeline(credential, azblob.PipelineOptions{})
-
-	// Create a *blob.Bucket.
-	ctx := context.Background()
-	return azureblob.OpenBucket(ctx, pipeline, accountName, conf.container, new(azureblob.Options))
+	return azureblob.NewPipeline(credential, azblob.PipelineOptions{}), new(azureblob.Options), nil
 }
 
-// newAzblobStorageUsingSharedKey initialize a new azblob storage using shared key credentials
-func newAzblobStorageUsingSharedKey(conf *azblobConfig, cred *azblobSharedKeyCred) (*blob.Bucket, error) {
-	ctx := context.Background()
-
+// newAzblobPipelineUsingSharedKey creates the azblob pipeline using shared key credentials
+func newAzblobPipelineUsingSharedKey(cred *azblobSharedKeyCred) (pipeline.Pipeline, *azureblob.Options, error) {
 	// Azure Storage Account and Access Key.
 	accountName := azureblob.AccountName(cred.account)
 	accountKey := azureblob.AccountKey(cred.sharedKey)
@@ -610,15 +763,12 @@ func newAzblobStorageUsingSharedKey(conf *azblobConfig, cred *azblobSharedKeyCre
 	// Create a credentials object.
 	credential, err := azureblob.NewCredential(accountName, accountKey)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Create a Pipeline, using whatever PipelineOptions you need.
-	pipeline := azureblob.NewPipeline(credential, azblob.PipelineOptions{})
-
-	// Create a *blob.Bucket.
 	// The credential Option is required if you're going to use blob.SignedURL.
-	return azureblob.OpenBucket(ctx, pipeline, accountName, conf.container, &azureblob.Options{Credential: credential})
+	return azureblob.NewPipeline(credential, azblob.PipelineOptions{}), &azureblob.Options{Credential: credential}, nil
 }
 
 // newGcsStorageOption constructs the arg for --flag option and the remote path for br
@@ -683,6 +833,7 @@ func makeS3Config(s3 *v1alpha1.S3StorageProvider, fakeRegion bool) *s3Config {
 	conf.sse = s3.SSE
 	conf.acl = s3.Acl
 	conf.storageClass = s3.StorageClass
+	conf.objectLock = s3.ObjectLock
 	conf.forcePathStyle = true
 	// In some cases, we need to set ForcePathStyle to false.
 	// Refer to: https://rclone.org/s3/#s3-force-path-style
@@ -712,6 +863,7 @@ func makeGcsConfig(gcs *v1alpha1.GcsStorageProvider, fakeRegion bool) *gcsConfig
 	conf.bucketAcl = gcs.BucketAcl
 	conf.secretName = gcs.SecretName
 	conf.prefix = fields[1]
+	conf.objectLock = gcs.ObjectLock
 
 	return &conf
 }
@@ -730,6 +882,7 @@ func makeAzblobConfig(azblob *v1alpha1.AzblobStorageProvider) *azblobConfig {
 	conf.accessTier = azblob.AccessTier
 	conf.secretName = azblob.SecretName
 	conf.prefix = fields[1]
+	conf.objectLock = azblob.ObjectLock
 
 	return &conf
 }
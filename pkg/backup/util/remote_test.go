@@ -38,13 +38,23 @@ import (
 type mockS3Client struct {
 	s3iface.S3API
 
-	deleteObjects func(*s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error)
+	deleteObjects              func(*s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error)
+	getObjectLockConfiguration func(*s3.GetObjectLockConfigurationInput) (*s3.GetObjectLockConfigurationOutput, error)
+	getObjectRetention         func(*s3.GetObjectRetentionInput) (*s3.GetObjectRetentionOutput, error)
 }
 
 func (c *mockS3Client) DeleteObjectsWithContext(_ aws.Context, input *s3.DeleteObjectsInput, _ ...request.Option) (*s3.DeleteObjectsOutput, error) {
 	return c.deleteObjects(input)
 }
 
+func (c *mockS3Client) GetObjectLockConfigurationWithContext(_ aws.Context, input *s3.GetObjectLockConfigurationInput, _ ...request.Option) (*s3.GetObjectLockConfigurationOutput, error) {
+	return c.getObjectLockConfiguration(input)
+}
+
+func (c *mockS3Client) GetObjectRetentionWithContext(_ aws.Context, input *s3.GetObjectRetentionInput, _ ...request.Option) (*s3.GetObjectRetentionOutput, error) {
+	return c.getObjectRetention(input)
+}
+
 func TestPageIterator(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 
@@ -260,11 +270,11 @@ func TestStorageBackendBasic(t *testing.T) {
 			return nil, nil
 		})
 		defer s3patches.Reset()
-		gcsPatches := gomonkey.ApplyFunc(newGcsStorage, func(conf *gcsConfig) (*blob.Bucket, error) {
+		gcsPatches := gomonkey.ApplyFunc(newGcsStorage, func(conf *gcsConfig, cred *StorageCredential) (*blob.Bucket, error) {
 			return nil, nil
 		})
 		defer gcsPatches.Reset()
-		azblobPatches := gomonkey.ApplyFunc(newAzblobStorage, func(conf *azblobConfig) (*blob.Bucket, error) {
+		azblobPatches := gomonkey.ApplyFunc(newAzblobStorage, func(conf *azblobConfig, cred *StorageCredential) (*blob.Bucket, error) {
 			return nil, nil
 		})
 		defer azblobPatches.Reset()
@@ -620,6 +630,55 @@ func TestBatchDeleteObjectsConcurrently(t *testing.T) {
 	}
 }
 
+func TestStorageURLOfDumpling(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	s3Provider := v1alpha1.StorageProvider{
+		S3: &v1alpha1.S3StorageProvider{
+			Provider: v1alpha1.S3StorageProviderTypeAWS,
+			Region:   "us-west-2",
+			Bucket:   "s3-bucket",
+			Prefix:   "s3-prefix",
+		},
+	}
+	azblobProvider := v1alpha1.StorageProvider{
+		Azblob: &v1alpha1.AzblobStorageProvider{
+			Container: "azblob-bucket",
+			Prefix:    "azblob-prefix",
+			SasToken:  "sas",
+		},
+	}
+
+	// append prefix
+	p := AppendStoragePrefix(s3Provider, "backup-1")
+	g.Expect(p.S3.Prefix).To(gomega.Equal("s3-prefix/backup-1"))
+	g.Expect(s3Provider.S3.Prefix).To(gomega.Equal("s3-prefix"))
+	p = AppendStoragePrefix(azblobProvider, "backup-1")
+	g.Expect(p.Azblob.Prefix).To(gomega.Equal("azblob-prefix/backup-1"))
+
+	// set bucket and prefix
+	p = SetStorageBucketAndPrefix(s3Provider, "s3://bucket-2/prefix-2/backup-1/")
+	g.Expect(p.S3.Bucket).To(gomega.Equal("bucket-2"))
+	g.Expect(p.S3.Prefix).To(gomega.Equal("prefix-2/backup-1"))
+	g.Expect(p.S3.Region).To(gomega.Equal("us-west-2"))
+	p = SetStorageBucketAndPrefix(azblobProvider, "azblob://azure://container-2/backup-1")
+	g.Expect(p.Azblob.Container).To(gomega.Equal("container-2"))
+	g.Expect(p.Azblob.Prefix).To(gomega.Equal("backup-1"))
+	p = SetStorageBucketAndPrefix(s3Provider, "bucket-3")
+	g.Expect(p.S3.Bucket).To(gomega.Equal("bucket-3"))
+	g.Expect(p.S3.Prefix).To(gomega.Equal(""))
+
+	// append query
+	u := AppendStorageQuery(s3Provider, "s3://s3-bucket/s3-prefix")
+	g.Expect(u).To(gomega.HavePrefix("s3://s3-bucket/s3-prefix?"))
+	g.Expect(u).To(gomega.ContainSubstring("region=us-west-2"))
+	g.Expect(u).To(gomega.ContainSubstring("provider=aws"))
+	u = AppendStorageQuery(azblobProvider, "azure://azblob-bucket/azblob-prefix")
+	g.Expect(u).To(gomega.ContainSubstring("sas-token=sas"))
+	u = AppendStorageQuery(v1alpha1.StorageProvider{Gcs: &v1alpha1.GcsStorageProvider{Bucket: "gcs-bucket"}}, "gcs://gcs-bucket/")
+	g.Expect(u).To(gomega.Equal("gcs://gcs-bucket/"))
+}
+
 func objects(size int) []*blob.ListObject {
 	objs := make([]*blob.ListObject, 0, size)
 	for i := 0; i < size; i++ {
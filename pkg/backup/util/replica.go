@@ -0,0 +1,214 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/backup/constants"
+	corev1 "k8s.io/api/core/v1"
+	corelisterv1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// CopyObjectsResult is the result of copying objects from a storage to another one
+type CopyObjectsResult struct {
+	// ObjectCount is the number of objects copied
+	ObjectCount int64
+	// Size is the total size of objects copied
+	Size int64
+}
+
+// CopyObjects copies all objects of the source storage to the destination storage,
+// then verifies that the destination storage has the same objects with the same sizes.
+func CopyObjects(ctx context.Context, src, dst *StorageBackend, concurrency int) (*CopyObjectsResult, error) {
+	objs, err := listObjectSizes(ctx, src)
+	if err != nil {
+		return nil, fmt.Errorf("list objects of source storage failed, err: %v", err)
+	}
+	keys := make([]string, 0, len(objs))
+	for key := range objs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var mu sync.Mutex
+	var copyErr error
+	workqueue.ParallelizeUntil(ctx, concurrency, len(keys), func(piece int) {
+		if err := copyObject(ctx, src, dst, keys[piece]); err != nil {
+			mu.Lock()
+			defer mu.Unlock()
+			if copyErr == nil {
+				copyErr = err
+			}
+		}
+	})
+	if copyErr != nil {
+		return nil, copyErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return VerifyObjects(ctx, objs, dst)
+}
+
+// VerifyObjects checks that the storage has all the expected objects with the same sizes,
+// the key of expected is the object key and the value is the object size.
+func VerifyObjects(ctx context.Context, expected map[string]int64, b *StorageBackend) (*CopyObjectsResult, error) {
+	objs, err := listObjectSizes(ctx, b)
+	if err != nil {
+		return nil, fmt.Errorf("list objects of destination storage failed, err: %v", err)
+	}
+
+	result := &CopyObjectsResult{}
+	for key, size := range expected {
+		actual, ok := objs[key]
+		if !ok {
+			return nil, fmt.Errorf("object %s is missing in destination storage", key)
+		}
+		if actual != size {
+			return nil, fmt.Errorf("object %s size mismatch, expected %d, actual %d", key, size, actual)
+		}
+		result.ObjectCount++
+		result.Size += size
+	}
+	return result, nil
+}
+
+func copyObject(ctx context.Context, src, dst *StorageBackend, key string) error {
+	r, err := src.NewReader(ctx, key, nil)
+	if err != nil {
+		return fmt.Errorf("open object %s of source storage failed, err: %v", key, err)
+	}
+	defer r.Close()
+
+	// cancel the context to abort the write if the copy fails, so that no partial object is left
+	wctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	w, err := dst.NewWriter(wctx, key, nil)
+	if err != nil {
+		return fmt.Errorf("create object %s of destination storage failed, err: %v", key, err)
+	}
+	if _, err := io.Copy(w, r); err != nil {
+		cancel()
+		w.Close()
+		return fmt.Errorf("copy object %s failed, err: %v", key, err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("close object %s of destination storage failed, err: %v", key, err)
+	}
+	return nil
+}
+
+// listObjectSizes returns the sizes of all the objects in the storage
+func listObjectSizes(ctx context.Context, b *StorageBackend) (map[string]int64, error) {
+	objs := make(map[string]int64)
+	iter := b.List(nil)
+	for {
+		obj, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if obj.IsDir {
+			continue
+		}
+		objs[obj.Key] = obj.Size
+	}
+	return objs, nil
+}
+
+// ReplicaEnvName returns the name of the env var for the replica storage with the index,
+// so that each replica can use its own credential.
+func ReplicaEnvName(index int, name string) string {
+	return fmt.Sprintf("%s_%d_%s", constants.BackupReplicaEnvVarPrefix, index, name)
+}
+
+// GenerateReplicaStorageCertEnv generates the env vars of the credentials of the replica storages in the same way
+// as the backup storage, the env vars of each replica are renamed by ReplicaEnvName so that they don't conflict.
+func GenerateReplicaStorageCertEnv(ns string, replicas []v1alpha1.StorageProvider, secretLister corelisterv1.SecretLister) ([]corev1.EnvVar, string, error) {
+	var envVars []corev1.EnvVar
+	for i := range replicas {
+		if replicas[i].Local != nil {
+			continue
+		}
+		certEnv, reason, err := GenerateStorageCertEnv(ns, false, *replicas[i].DeepCopy(), secretLister)
+		if err != nil {
+			return nil, reason, fmt.Errorf("generate credential env of replica %d failed, err: %v", i, err)
+		}
+		for _, env := range certEnv {
+			env.Name = ReplicaEnvName(i, env.Name)
+			envVars = append(envVars, env)
+		}
+	}
+	return envVars, "", nil
+}
+
+// GetReplicaStorageCredential returns the credential of the replica storage with the index from the env vars
+// generated by GenerateReplicaStorageCertEnv, nil is returned to use the default credential, such as IRSA,
+// if they are not set.
+func GetReplicaStorageCredential(index int, replica v1alpha1.StorageProvider) *StorageCredential {
+	cred := newStorageCredential(GetStorageType(replica), func(key string) string {
+		return os.Getenv(ReplicaEnvName(index, replicaEnvKeys[key]))
+	})
+	if cred.awsCred == nil && len(cred.gcsCredentials) == 0 && cred.azblobCred == nil {
+		return nil
+	}
+	return cred
+}
+
+// replicaEnvKeys maps the keys of the storage secret to the names of the env vars generated by GenerateStorageCertEnv
+var replicaEnvKeys = map[string]string{
+	constants.S3AccessKey:       "AWS_ACCESS_KEY_ID",
+	constants.S3SecretKey:       "AWS_SECRET_ACCESS_KEY",
+	constants.GcsCredentialsKey: "GCS_SERVICE_ACCOUNT_JSON_KEY",
+	constants.AzblobAccountName: constants.AzblobAccountName,
+	constants.AzblobAccountKey:  constants.AzblobAccountKey,
+	constants.AzblobClientID:    constants.AzblobClientID,
+	constants.AzblobClientScrt:  constants.AzblobClientScrt,
+	constants.AzblobTenantID:    constants.AzblobTenantID,
+}
+
+// AppendReplicaLocalVolumes appends the volumes and the volume mounts of the local replica storages,
+// the ones with the same name as the existing volumes are skipped.
+func AppendReplicaLocalVolumes(volumes []corev1.Volume, volumeMounts []corev1.VolumeMount, replicas []v1alpha1.StorageProvider) ([]corev1.Volume, []corev1.VolumeMount) {
+	for i := range replicas {
+		local := replicas[i].Local
+		if local == nil {
+			continue
+		}
+		exist := false
+		for _, volume := range volumes {
+			if volume.Name == local.Volume.Name {
+				exist = true
+				break
+			}
+		}
+		if exist {
+			continue
+		}
+		volumes = append(volumes, local.Volume)
+		volumeMounts = append(volumeMounts, local.VolumeMount)
+	}
+	return volumes, volumeMounts
+}
@@ -0,0 +1,152 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/backup/constants"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisterv1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func newLocalStorageBackend(g *gomega.WithT, dir string) *StorageBackend {
+	b, err := NewStorageBackend(v1alpha1.StorageProvider{
+		Local: &v1alpha1.LocalStorageProvider{
+			VolumeMount: corev1.VolumeMount{MountPath: dir},
+		},
+	}, nil)
+	g.Expect(err).Should(gomega.Succeed())
+	return b
+}
+
+func TestCopyObjects(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	ctx := context.Background()
+
+	srcDir := t.TempDir()
+	files := map[string]string{
+		"backupmeta":        "meta",
+		"1/1_2_default.sst": "data of store 1",
+		"2/2_3_write.sst":   "data of store 2",
+	}
+	var size int64
+	for name, content := range files {
+		p := filepath.Join(srcDir, name)
+		g.Expect(os.MkdirAll(filepath.Dir(p), 0755)).Should(gomega.Succeed())
+		g.Expect(os.WriteFile(p, []byte(content), 0644)).Should(gomega.Succeed())
+		size += int64(len(content))
+	}
+	src := newLocalStorageBackend(g, srcDir)
+	defer src.Close()
+	dstDir := t.TempDir()
+	dst := newLocalStorageBackend(g, dstDir)
+	defer dst.Close()
+
+	result, err := CopyObjects(ctx, src, dst, 2)
+	g.Expect(err).Should(gomega.Succeed())
+	g.Expect(result.ObjectCount).Should(gomega.Equal(int64(len(files))))
+	g.Expect(result.Size).Should(gomega.Equal(size))
+	for name, content := range files {
+		data, err := os.ReadFile(filepath.Join(dstDir, name))
+		g.Expect(err).Should(gomega.Succeed())
+		g.Expect(string(data)).Should(gomega.Equal(content))
+	}
+
+	// copy again is idempotent
+	_, err = CopyObjects(ctx, src, dst, 2)
+	g.Expect(err).Should(gomega.Succeed())
+
+	// verify fails if an object is missing or has a different size
+	expected, err := listObjectSizes(ctx, src)
+	g.Expect(err).Should(gomega.Succeed())
+	g.Expect(os.WriteFile(filepath.Join(dstDir, "backupmeta"), []byte("truncated meta"), 0644)).Should(gomega.Succeed())
+	_, err = VerifyObjects(ctx, expected, dst)
+	g.Expect(err).Should(gomega.HaveOccurred())
+	g.Expect(os.Remove(filepath.Join(dstDir, "backupmeta"))).Should(gomega.Succeed())
+	_, err = VerifyObjects(ctx, expected, dst)
+	g.Expect(err).Should(gomega.HaveOccurred())
+}
+
+func TestGetReplicaStorageCredential(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	s3 := v1alpha1.StorageProvider{S3: &v1alpha1.S3StorageProvider{}}
+	gcs := v1alpha1.StorageProvider{Gcs: &v1alpha1.GcsStorageProvider{}}
+	azblob := v1alpha1.StorageProvider{Azblob: &v1alpha1.AzblobStorageProvider{}}
+
+	g.Expect(GetReplicaStorageCredential(0, s3)).Should(gomega.BeNil())
+
+	t.Setenv(ReplicaEnvName(0, "AWS_ACCESS_KEY_ID"), "ak")
+	t.Setenv(ReplicaEnvName(0, "AWS_SECRET_ACCESS_KEY"), "sk")
+	cred := GetReplicaStorageCredential(0, s3)
+	g.Expect(cred).ShouldNot(gomega.BeNil())
+	value, err := cred.awsCred.Get()
+	g.Expect(err).Should(gomega.Succeed())
+	g.Expect(value.AccessKeyID).Should(gomega.Equal("ak"))
+	g.Expect(value.SecretAccessKey).Should(gomega.Equal("sk"))
+	g.Expect(GetReplicaStorageCredential(1, s3)).Should(gomega.BeNil())
+
+	t.Setenv(ReplicaEnvName(1, "GCS_SERVICE_ACCOUNT_JSON_KEY"), "{}")
+	cred = GetReplicaStorageCredential(1, gcs)
+	g.Expect(cred).ShouldNot(gomega.BeNil())
+	g.Expect(string(cred.gcsCredentials)).Should(gomega.Equal("{}"))
+
+	t.Setenv(ReplicaEnvName(2, "AZURE_STORAGE_ACCOUNT"), "account")
+	g.Expect(GetReplicaStorageCredential(2, azblob)).Should(gomega.BeNil())
+	t.Setenv(ReplicaEnvName(2, "AZURE_STORAGE_KEY"), "key")
+	cred = GetReplicaStorageCredential(2, azblob)
+	g.Expect(cred).ShouldNot(gomega.BeNil())
+	g.Expect(cred.azblobCred.account).Should(gomega.Equal("account"))
+	g.Expect(cred.azblobCred.sharedKey).Should(gomega.Equal("key"))
+}
+
+func TestGenerateReplicaStorageCertEnv(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "gcs-secret"},
+		Data:       map[string][]byte{constants.GcsCredentialsKey: []byte("{}")},
+	}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	g.Expect(indexer.Add(secret)).Should(gomega.Succeed())
+	secretLister := corelisterv1.NewSecretLister(indexer)
+
+	replicas := []v1alpha1.StorageProvider{
+		{Local: &v1alpha1.LocalStorageProvider{}},
+		{Gcs: &v1alpha1.GcsStorageProvider{ProjectId: "project", SecretName: "gcs-secret"}},
+	}
+	envs, _, err := GenerateReplicaStorageCertEnv("ns", replicas, secretLister)
+	g.Expect(err).Should(gomega.Succeed())
+	names := map[string]*corev1.EnvVarSource{}
+	for _, env := range envs {
+		names[env.Name] = env.ValueFrom
+	}
+	g.Expect(names).Should(gomega.HaveKey(ReplicaEnvName(1, "GCS_PROJECT_ID")))
+	g.Expect(names).Should(gomega.HaveKey(ReplicaEnvName(1, "GCS_SERVICE_ACCOUNT_JSON_KEY")))
+	g.Expect(names[ReplicaEnvName(1, "GCS_SERVICE_ACCOUNT_JSON_KEY")].SecretKeyRef.Name).Should(gomega.Equal("gcs-secret"))
+	for name := range names {
+		g.Expect(name).ShouldNot(gomega.HavePrefix(ReplicaEnvName(0, "")))
+	}
+
+	// the secret of the replica is missing
+	replicas[1].Gcs.SecretName = "not-exist"
+	_, _, err = GenerateReplicaStorageCertEnv("ns", replicas, secretLister)
+	g.Expect(err).Should(gomega.HaveOccurred())
+}
@@ -25,10 +25,12 @@ import (
 	"unsafe"
 
 	"github.com/Masterminds/semver"
+	kvbackup "github.com/pingcap/kvproto/pkg/brpb"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/apis/util/config"
 	"github.com/pingcap/tidb-operator/pkg/backup/constants"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/wait"
 	corelisterv1 "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/util/retry"
@@ -438,6 +440,8 @@ func GetBackupDataPath(provider v1alpha1.StorageProvider) (string, string, error
 		backupPath = provider.S3.Path
 	case v1alpha1.BackupStorageTypeGcs:
 		backupPath = provider.Gcs.Path
+	case v1alpha1.BackupStorageTypeAzblob:
+		backupPath = provider.Azblob.Path
 	default:
 		return backupPath, "UnsupportedStorageType", fmt.Errorf("unsupported storage type %s", storageType)
 	}
@@ -473,9 +477,25 @@ func ValidateBackup(backup *v1alpha1.Backup, tikvImage string, tc *v1alpha1.Tidb
 		if reason := validateAccessConfig(backup.Spec.From); reason != "" {
 			return fmt.Errorf(reason, ns, name)
 		}
-		if backup.Spec.StorageSize == "" {
+		if backup.IsDumplingStreaming() {
+			switch st := GetStorageType(backup.Spec.StorageProvider); st {
+			case v1alpha1.BackupStorageTypeS3, v1alpha1.BackupStorageTypeGcs, v1alpha1.BackupStorageTypeAzblob:
+			default:
+				return fmt.Errorf("storage %s is not supported by dumpling stream mode in spec of %s/%s", st, ns, name)
+			}
+		} else if backup.Spec.StorageSize == "" {
 			return fmt.Errorf("missing StorageSize config in spec of %s/%s", ns, name)
 		}
+		if backup.Spec.Dumpling != nil {
+			switch ft := backup.Spec.Dumpling.FileType; ft {
+			case "", v1alpha1.DumplingFileTypeSQL, v1alpha1.DumplingFileTypeCSV:
+			default:
+				return fmt.Errorf("file type %s is not supported by dumpling in spec of %s/%s", ft, ns, name)
+			}
+		}
+		if len(backup.Spec.Replicas) > 0 {
+			return fmt.Errorf("replicas are only supported by BR in spec of %s/%s", ns, name)
+		}
 	} else {
 		if !canSkipSetGCLifeTime(tikvImage) {
 			if reason := validateAccessConfig(backup.Spec.From); reason != "" {
@@ -516,6 +536,10 @@ func ValidateBackup(backup *v1alpha1.Backup, tikvImage string, tc *v1alpha1.Tidb
 			}
 		}
 
+		if err := validateReplicas(backup); err != nil {
+			return err
+		}
+
 		// validate log backup
 		if backup.Spec.Mode == v1alpha1.BackupModeLog {
 			if !isLogBackSupport(tikvImage) {
@@ -561,6 +585,10 @@ func ValidateBackup(backup *v1alpha1.Backup, tikvImage string, tc *v1alpha1.Tidb
 			}
 		}
 	}
+
+	if err := validateObjectLock(backup); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -576,6 +604,14 @@ func ValidateRestore(restore *v1alpha1.Restore, tikvImage string, acrossK8s bool
 		if restore.Spec.StorageSize == "" {
 			return fmt.Errorf("missing StorageSize config in spec of %s/%s", ns, name)
 		}
+		if restore.Spec.BackupScheduleRef != nil {
+			return fmt.Errorf("backupScheduleRef is only supported by BR in spec of %s/%s", ns, name)
+		}
+		if restore.Spec.Lightning != nil {
+			if err := validateLightning(restore); err != nil {
+				return err
+			}
+		}
 	} else {
 		if !canSkipSetGCLifeTime(tikvImage) {
 			if reason := validateAccessConfig(restore.Spec.To); reason != "" {
@@ -597,7 +633,11 @@ func ValidateRestore(restore *v1alpha1.Restore, tikvImage string, acrossK8s bool
 			return fmt.Errorf("DB should be configured for BR with restore type %s in spec of %s/%s", restore.Spec.Type, ns, name)
 		}
 
-		if restore.Spec.Mode == v1alpha1.RestoreModePiTR {
+		if restore.Spec.BackupScheduleRef != nil {
+			if err := validateBackupScheduleRef(restore); err != nil {
+				return err
+			}
+		} else if restore.Spec.Mode == v1alpha1.RestoreModePiTR {
 			_, err := GetStoragePath(restore.Spec.PitrFullBackupStorageProvider)
 			// err is nil when there is a valid storage provider
 			if err == nil && restore.Spec.LogRestoreStartTs != "" {
@@ -638,6 +678,118 @@ func ValidateRestore(restore *v1alpha1.Restore, tikvImage string, acrossK8s bool
 	return nil
 }
 
+// validateBackupScheduleRef checks the restore spec referencing a BackupSchedule.
+// The storage of the backups is resolved from the BackupSchedule, so it is not checked here.
+func validateBackupScheduleRef(restore *v1alpha1.Restore) error {
+	ns := restore.Namespace
+	name := restore.Name
+
+	if restore.Spec.BackupScheduleRef.Name == "" {
+		return fmt.Errorf("name of backupScheduleRef should be configured in spec of %s/%s", ns, name)
+	}
+	if restore.Spec.Mode != v1alpha1.RestoreModePiTR {
+		return fmt.Errorf("backupScheduleRef is only valid for mode of %s in spec of %s/%s", v1alpha1.RestoreModePiTR, ns, name)
+	}
+	if restore.Spec.PitrRestoredTs == "" {
+		return fmt.Errorf("pitrRestoredTs should be configured with backupScheduleRef in spec of %s/%s", ns, name)
+	}
+	if _, err := config.ParseTSString(restore.Spec.PitrRestoredTs); err != nil {
+		return fmt.Errorf("invalid pitrRestoredTs %s in spec of %s/%s, err: %v", restore.Spec.PitrRestoredTs, ns, name, err)
+	}
+	if restore.Spec.LogRestoreStartTs != "" {
+		return fmt.Errorf("backupScheduleRef and logRestoreStartTs option can not co-exists in spec of %s/%s", ns, name)
+	}
+	return nil
+}
+
+// validateLightning checks the TiDB Lightning config of the restore
+func validateLightning(restore *v1alpha1.Restore) error {
+	ns := restore.Namespace
+	name := restore.Name
+	lightning := restore.Spec.Lightning
+
+	switch lightning.Backend {
+	case "", v1alpha1.LightningBackendTiDB:
+		return nil
+	case v1alpha1.LightningBackendLocal:
+	default:
+		return fmt.Errorf("invalid lightning backend %s in spec of %s/%s", lightning.Backend, ns, name)
+	}
+	if lightning.Cluster == "" {
+		return fmt.Errorf("cluster should be configured for lightning local backend in spec of %s/%s", ns, name)
+	}
+	if lightning.DiskQuota != "" {
+		if _, err := resource.ParseQuantity(lightning.DiskQuota); err != nil {
+			return fmt.Errorf("invalid lightning disk quota %s in spec of %s/%s, err: %v", lightning.DiskQuota, ns, name, err)
+		}
+	}
+	return nil
+}
+
+// validateObjectLock validates the object locks of the storage and the replicas of backup
+func validateObjectLock(backup *v1alpha1.Backup) error {
+	ns := backup.Namespace
+	name := backup.Name
+	providers := append([]v1alpha1.StorageProvider{backup.Spec.StorageProvider}, backup.Spec.Replicas...)
+	for _, provider := range providers {
+		lock := GetObjectLock(provider)
+		if lock == nil {
+			continue
+		}
+		if backup.Spec.Mode == v1alpha1.BackupModeLog {
+			return fmt.Errorf("object lock is not supported by log backup in spec of %s/%s", ns, name)
+		}
+		if lock.RetainDays < 1 {
+			return fmt.Errorf("retainDays %d of object lock should be at least 1 in spec of %s/%s", lock.RetainDays, ns, name)
+		}
+		switch lock.Mode {
+		case "", v1alpha1.ObjectLockModeGovernance, v1alpha1.ObjectLockModeCompliance:
+		default:
+			return fmt.Errorf("invalid object lock mode %s in spec of %s/%s", lock.Mode, ns, name)
+		}
+	}
+	return nil
+}
+
+// validateReplicas checks the replica storages of the snapshot backup
+func validateReplicas(backup *v1alpha1.Backup) error {
+	ns := backup.Namespace
+	name := backup.Name
+	if len(backup.Spec.Replicas) == 0 {
+		return nil
+	}
+	if backup.Spec.Mode != "" && backup.Spec.Mode != v1alpha1.BackupModeSnapshot {
+		return fmt.Errorf("replicas are only supported by snapshot backup in spec of %s/%s", ns, name)
+	}
+
+	for i := range backup.Spec.Replicas {
+		replica := &backup.Spec.Replicas[i]
+		var err error
+		switch GetStorageType(*replica) {
+		case v1alpha1.BackupStorageTypeS3:
+			err = validateS3(ns, name, replica.S3)
+		case v1alpha1.BackupStorageTypeGcs:
+			err = validateGcs(ns, name, replica.Gcs)
+		case v1alpha1.BackupStorageTypeAzblob:
+		case v1alpha1.BackupStorageTypeLocal:
+			err = validateLocal(ns, name, replica.Local)
+		default:
+			err = fmt.Errorf("no storage is configured for replica %d in spec of %s/%s", i, ns, name)
+		}
+		if err != nil {
+			return err
+		}
+		replicaPath, err := GetStoragePath(*replica)
+		if err != nil {
+			return fmt.Errorf("get path of replica %d in spec of %s/%s failed, err: %v", i, ns, name, err)
+		}
+		if backupPath, err := GetStoragePath(backup.Spec.StorageProvider); err == nil && backupPath == replicaPath {
+			return fmt.Errorf("replica %d has the same path %s as the backup in spec of %s/%s", i, replicaPath, ns, name)
+		}
+	}
+	return nil
+}
+
 func validateS3(ns, name string, s3 *v1alpha1.S3StorageProvider) error {
 	configuredForBR := fmt.Sprintf("configured for BR in spec of %s/%s", ns, name)
 	if s3.Bucket == "" {
@@ -830,3 +982,16 @@ func GetVolSnapBackupMetaData(r *v1alpha1.Restore, secretLister corelisterv1.Sec
 	}
 	return backupMeta, nil
 }
+
+// GetBRArchiveSize returns the total size of the backup archive.
+func GetBRArchiveSize(meta *kvbackup.BackupMeta) uint64 {
+	if meta.BackupSize != 0 {
+		return meta.BackupSize
+	}
+	// ASSERT: the version of meta must be v1
+	total := uint64(meta.Size())
+	for _, file := range meta.Files {
+		total += file.Size_
+	}
+	return total
+}
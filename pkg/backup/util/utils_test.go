@@ -516,6 +516,22 @@ func TestValidateBackup(t *testing.T) {
 
 	backup.Spec.From.SecretName = "secretName"
 	match("missing StorageSize config in spec of")
+
+	// the stream mode of dumpling does not use the backup volume
+	backup.Spec.Dumpling = &v1alpha1.DumplingConfig{OutputMode: v1alpha1.DumplingOutputModeStream}
+	backup.Spec.Local = &v1alpha1.LocalStorageProvider{}
+	match("storage local is not supported by dumpling stream mode")
+	backup.Spec.Local = nil
+	backup.Spec.Azblob = &v1alpha1.AzblobStorageProvider{Container: "container"}
+	match("")
+	// only the files which can be imported by TiDB Lightning are exported
+	backup.Spec.Dumpling.FileType = "parquet"
+	match("file type parquet is not supported by dumpling")
+	backup.Spec.Dumpling.FileType = v1alpha1.DumplingFileTypeCSV
+	match("")
+	backup.Spec.Azblob = nil
+	backup.Spec.Dumpling = nil
+
 	backup.Spec.StorageSize = "1m"
 	match("")
 
@@ -550,6 +566,29 @@ func TestValidateBackup(t *testing.T) {
 
 	backup.Spec.S3.Endpoint = "s3://localhost:80"
 	match("")
+
+	backup.Spec.Replicas = []v1alpha1.StorageProvider{{}}
+	match("no storage is configured for replica 0")
+
+	backup.Spec.Replicas[0].S3 = backup.Spec.S3.DeepCopy()
+	match("same path")
+
+	backup.Spec.Replicas[0].S3.Bucket = "replica"
+	match("")
+
+	backup.Spec.Mode = v1alpha1.BackupModeLog
+	match("replicas are only supported by snapshot backup")
+	backup.Spec.Mode = v1alpha1.BackupModeSnapshot
+
+	backup.Spec.Replicas[0].S3.ObjectLock = &v1alpha1.ObjectLock{}
+	match("retainDays 0 of object lock should be at least 1")
+
+	backup.Spec.Replicas[0].S3.ObjectLock.RetainDays = 30
+	backup.Spec.Replicas[0].S3.ObjectLock.Mode = v1alpha1.ObjectLockMode("invalid")
+	match("invalid object lock mode")
+
+	backup.Spec.Replicas[0].S3.ObjectLock.Mode = v1alpha1.ObjectLockModeGovernance
+	match("")
 }
 
 func TestValidateRestore(t *testing.T) {
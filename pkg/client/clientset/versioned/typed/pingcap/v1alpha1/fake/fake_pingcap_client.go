@@ -29,6 +29,10 @@ func (c *FakePingcapV1alpha1) Backups(namespace string) v1alpha1.BackupInterface
 	return &FakeBackups{c, namespace}
 }
 
+func (c *FakePingcapV1alpha1) BackupRepositories(namespace string) v1alpha1.BackupRepositoryInterface {
+	return &FakeBackupRepositories{c, namespace}
+}
+
 func (c *FakePingcapV1alpha1) BackupSchedules(namespace string) v1alpha1.BackupScheduleInterface {
 	return &FakeBackupSchedules{c, namespace}
 }
@@ -41,6 +45,14 @@ func (c *FakePingcapV1alpha1) DMClusters(namespace string) v1alpha1.DMClusterInt
 	return &FakeDMClusters{c, namespace}
 }
 
+func (c *FakePingcapV1alpha1) DMSources(namespace string) v1alpha1.DMSourceInterface {
+	return &FakeDMSources{c, namespace}
+}
+
+func (c *FakePingcapV1alpha1) DMTasks(namespace string) v1alpha1.DMTaskInterface {
+	return &FakeDMTasks{c, namespace}
+}
+
 func (c *FakePingcapV1alpha1) DataResources(namespace string) v1alpha1.DataResourceInterface {
 	return &FakeDataResources{c, namespace}
 }
@@ -49,6 +61,10 @@ func (c *FakePingcapV1alpha1) Restores(namespace string) v1alpha1.RestoreInterfa
 	return &FakeRestores{c, namespace}
 }
 
+func (c *FakePingcapV1alpha1) TiCDCChangefeeds(namespace string) v1alpha1.TiCDCChangefeedInterface {
+	return &FakeTiCDCChangefeeds{c, namespace}
+}
+
 func (c *FakePingcapV1alpha1) TidbClusters(namespace string) v1alpha1.TidbClusterInterface {
 	return &FakeTidbClusters{c, namespace}
 }
@@ -73,6 +89,18 @@ func (c *FakePingcapV1alpha1) TidbNGMonitorings(namespace string) v1alpha1.TidbN
 	return &FakeTidbNGMonitorings{c, namespace}
 }
 
+func (c *FakePingcapV1alpha1) TidbPlacementPolicies(namespace string) v1alpha1.TidbPlacementPolicyInterface {
+	return &FakeTidbPlacementPolicies{c, namespace}
+}
+
+func (c *FakePingcapV1alpha1) TidbResourceGroups(namespace string) v1alpha1.TidbResourceGroupInterface {
+	return &FakeTidbResourceGroups{c, namespace}
+}
+
+func (c *FakePingcapV1alpha1) TidbUsers(namespace string) v1alpha1.TidbUserInterface {
+	return &FakeTidbUsers{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakePingcapV1alpha1) RESTClient() rest.Interface {
@@ -17,16 +17,24 @@ package v1alpha1
 
 type BackupExpansion interface{}
 
+type BackupRepositoryExpansion interface{}
+
 type BackupScheduleExpansion interface{}
 
 type CompactBackupExpansion interface{}
 
 type DMClusterExpansion interface{}
 
+type DMSourceExpansion interface{}
+
+type DMTaskExpansion interface{}
+
 type DataResourceExpansion interface{}
 
 type RestoreExpansion interface{}
 
+type TiCDCChangefeedExpansion interface{}
+
 type TidbClusterExpansion interface{}
 
 type TidbClusterAutoScalerExpansion interface{}
@@ -38,3 +46,9 @@ type TidbInitializerExpansion interface{}
 type TidbMonitorExpansion interface{}
 
 type TidbNGMonitoringExpansion interface{}
+
+type TidbPlacementPolicyExpansion interface{}
+
+type TidbResourceGroupExpansion interface{}
+
+type TidbUserExpansion interface{}
@@ -26,17 +26,24 @@ import (
 type PingcapV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	BackupsGetter
+	BackupRepositoriesGetter
 	BackupSchedulesGetter
 	CompactBackupsGetter
 	DMClustersGetter
+	DMSourcesGetter
+	DMTasksGetter
 	DataResourcesGetter
 	RestoresGetter
+	TiCDCChangefeedsGetter
 	TidbClustersGetter
 	TidbClusterAutoScalersGetter
 	TidbDashboardsGetter
 	TidbInitializersGetter
 	TidbMonitorsGetter
 	TidbNGMonitoringsGetter
+	TidbPlacementPoliciesGetter
+	TidbResourceGroupsGetter
+	TidbUsersGetter
 }
 
 // PingcapV1alpha1Client is used to interact with features provided by the pingcap.com group.
@@ -48,6 +55,10 @@ func (c *PingcapV1alpha1Client) Backups(namespace string) BackupInterface {
 	return newBackups(c, namespace)
 }
 
+func (c *PingcapV1alpha1Client) BackupRepositories(namespace string) BackupRepositoryInterface {
+	return newBackupRepositories(c, namespace)
+}
+
 func (c *PingcapV1alpha1Client) BackupSchedules(namespace string) BackupScheduleInterface {
 	return newBackupSchedules(c, namespace)
 }
@@ -60,6 +71,14 @@ func (c *PingcapV1alpha1Client) DMClusters(namespace string) DMClusterInterface
 	return newDMClusters(c, namespace)
 }
 
+func (c *PingcapV1alpha1Client) DMSources(namespace string) DMSourceInterface {
+	return newDMSources(c, namespace)
+}
+
+func (c *PingcapV1alpha1Client) DMTasks(namespace string) DMTaskInterface {
+	return newDMTasks(c, namespace)
+}
+
 func (c *PingcapV1alpha1Client) DataResources(namespace string) DataResourceInterface {
 	return newDataResources(c, namespace)
 }
@@ -68,6 +87,10 @@ func (c *PingcapV1alpha1Client) Restores(namespace string) RestoreInterface {
 	return newRestores(c, namespace)
 }
 
+func (c *PingcapV1alpha1Client) TiCDCChangefeeds(namespace string) TiCDCChangefeedInterface {
+	return newTiCDCChangefeeds(c, namespace)
+}
+
 func (c *PingcapV1alpha1Client) TidbClusters(namespace string) TidbClusterInterface {
 	return newTidbClusters(c, namespace)
 }
@@ -92,6 +115,18 @@ func (c *PingcapV1alpha1Client) TidbNGMonitorings(namespace string) TidbNGMonito
 	return newTidbNGMonitorings(c, namespace)
 }
 
+func (c *PingcapV1alpha1Client) TidbPlacementPolicies(namespace string) TidbPlacementPolicyInterface {
+	return newTidbPlacementPolicies(c, namespace)
+}
+
+func (c *PingcapV1alpha1Client) TidbResourceGroups(namespace string) TidbResourceGroupInterface {
+	return newTidbResourceGroups(c, namespace)
+}
+
+func (c *PingcapV1alpha1Client) TidbUsers(namespace string) TidbUserInterface {
+	return newTidbUsers(c, namespace)
+}
+
 // NewForConfig creates a new PingcapV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -23,16 +23,24 @@ import (
 type Interface interface {
 	// Backups returns a BackupInformer.
 	Backups() BackupInformer
+	// BackupRepositories returns a BackupRepositoryInformer.
+	BackupRepositories() BackupRepositoryInformer
 	// BackupSchedules returns a BackupScheduleInformer.
 	BackupSchedules() BackupScheduleInformer
 	// CompactBackups returns a CompactBackupInformer.
 	CompactBackups() CompactBackupInformer
 	// DMClusters returns a DMClusterInformer.
 	DMClusters() DMClusterInformer
+	// DMSources returns a DMSourceInformer.
+	DMSources() DMSourceInformer
+	// DMTasks returns a DMTaskInformer.
+	DMTasks() DMTaskInformer
 	// DataResources returns a DataResourceInformer.
 	DataResources() DataResourceInformer
 	// Restores returns a RestoreInformer.
 	Restores() RestoreInformer
+	// TiCDCChangefeeds returns a TiCDCChangefeedInformer.
+	TiCDCChangefeeds() TiCDCChangefeedInformer
 	// TidbClusters returns a TidbClusterInformer.
 	TidbClusters() TidbClusterInformer
 	// TidbClusterAutoScalers returns a TidbClusterAutoScalerInformer.
@@ -45,6 +53,12 @@ type Interface interface {
 	TidbMonitors() TidbMonitorInformer
 	// TidbNGMonitorings returns a TidbNGMonitoringInformer.
 	TidbNGMonitorings() TidbNGMonitoringInformer
+	// TidbPlacementPolicies returns a TidbPlacementPolicyInformer.
+	TidbPlacementPolicies() TidbPlacementPolicyInformer
+	// TidbResourceGroups returns a TidbResourceGroupInformer.
+	TidbResourceGroups() TidbResourceGroupInformer
+	// TidbUsers returns a TidbUserInformer.
+	TidbUsers() TidbUserInformer
 }
 
 type version struct {
@@ -63,6 +77,11 @@ func (v *version) Backups() BackupInformer {
 	return &backupInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// BackupRepositories returns a BackupRepositoryInformer.
+func (v *version) BackupRepositories() BackupRepositoryInformer {
+	return &backupRepositoryInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // BackupSchedules returns a BackupScheduleInformer.
 func (v *version) BackupSchedules() BackupScheduleInformer {
 	return &backupScheduleInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -78,6 +97,16 @@ func (v *version) DMClusters() DMClusterInformer {
 	return &dMClusterInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// DMSources returns a DMSourceInformer.
+func (v *version) DMSources() DMSourceInformer {
+	return &dMSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// DMTasks returns a DMTaskInformer.
+func (v *version) DMTasks() DMTaskInformer {
+	return &dMTaskInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // DataResources returns a DataResourceInformer.
 func (v *version) DataResources() DataResourceInformer {
 	return &dataResourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -88,6 +117,11 @@ func (v *version) Restores() RestoreInformer {
 	return &restoreInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// TiCDCChangefeeds returns a TiCDCChangefeedInformer.
+func (v *version) TiCDCChangefeeds() TiCDCChangefeedInformer {
+	return &tiCDCChangefeedInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // TidbClusters returns a TidbClusterInformer.
 func (v *version) TidbClusters() TidbClusterInformer {
 	return &tidbClusterInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -117,3 +151,18 @@ func (v *version) TidbMonitors() TidbMonitorInformer {
 func (v *version) TidbNGMonitorings() TidbNGMonitoringInformer {
 	return &tidbNGMonitoringInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// TidbPlacementPolicies returns a TidbPlacementPolicyInformer.
+func (v *version) TidbPlacementPolicies() TidbPlacementPolicyInformer {
+	return &tidbPlacementPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// TidbResourceGroups returns a TidbResourceGroupInformer.
+func (v *version) TidbResourceGroups() TidbResourceGroupInformer {
+	return &tidbResourceGroupInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// TidbUsers returns a TidbUserInformer.
+func (v *version) TidbUsers() TidbUserInformer {
+	return &tidbUserInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
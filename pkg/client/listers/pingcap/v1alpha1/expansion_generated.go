@@ -23,6 +23,14 @@ type BackupListerExpansion interface{}
 // BackupNamespaceLister.
 type BackupNamespaceListerExpansion interface{}
 
+// BackupRepositoryListerExpansion allows custom methods to be added to
+// BackupRepositoryLister.
+type BackupRepositoryListerExpansion interface{}
+
+// BackupRepositoryNamespaceListerExpansion allows custom methods to be added to
+// BackupRepositoryNamespaceLister.
+type BackupRepositoryNamespaceListerExpansion interface{}
+
 // BackupScheduleListerExpansion allows custom methods to be added to
 // BackupScheduleLister.
 type BackupScheduleListerExpansion interface{}
@@ -47,6 +55,22 @@ type DMClusterListerExpansion interface{}
 // DMClusterNamespaceLister.
 type DMClusterNamespaceListerExpansion interface{}
 
+// DMSourceListerExpansion allows custom methods to be added to
+// DMSourceLister.
+type DMSourceListerExpansion interface{}
+
+// DMSourceNamespaceListerExpansion allows custom methods to be added to
+// DMSourceNamespaceLister.
+type DMSourceNamespaceListerExpansion interface{}
+
+// DMTaskListerExpansion allows custom methods to be added to
+// DMTaskLister.
+type DMTaskListerExpansion interface{}
+
+// DMTaskNamespaceListerExpansion allows custom methods to be added to
+// DMTaskNamespaceLister.
+type DMTaskNamespaceListerExpansion interface{}
+
 // DataResourceListerExpansion allows custom methods to be added to
 // DataResourceLister.
 type DataResourceListerExpansion interface{}
@@ -63,6 +87,14 @@ type RestoreListerExpansion interface{}
 // RestoreNamespaceLister.
 type RestoreNamespaceListerExpansion interface{}
 
+// TiCDCChangefeedListerExpansion allows custom methods to be added to
+// TiCDCChangefeedLister.
+type TiCDCChangefeedListerExpansion interface{}
+
+// TiCDCChangefeedNamespaceListerExpansion allows custom methods to be added to
+// TiCDCChangefeedNamespaceLister.
+type TiCDCChangefeedNamespaceListerExpansion interface{}
+
 // TidbClusterListerExpansion allows custom methods to be added to
 // TidbClusterLister.
 type TidbClusterListerExpansion interface{}
@@ -110,3 +142,27 @@ type TidbNGMonitoringListerExpansion interface{}
 // TidbNGMonitoringNamespaceListerExpansion allows custom methods to be added to
 // TidbNGMonitoringNamespaceLister.
 type TidbNGMonitoringNamespaceListerExpansion interface{}
+
+// TidbPlacementPolicyListerExpansion allows custom methods to be added to
+// TidbPlacementPolicyLister.
+type TidbPlacementPolicyListerExpansion interface{}
+
+// TidbPlacementPolicyNamespaceListerExpansion allows custom methods to be added to
+// TidbPlacementPolicyNamespaceLister.
+type TidbPlacementPolicyNamespaceListerExpansion interface{}
+
+// TidbResourceGroupListerExpansion allows custom methods to be added to
+// TidbResourceGroupLister.
+type TidbResourceGroupListerExpansion interface{}
+
+// TidbResourceGroupNamespaceListerExpansion allows custom methods to be added to
+// TidbResourceGroupNamespaceLister.
+type TidbResourceGroupNamespaceListerExpansion interface{}
+
+// TidbUserListerExpansion allows custom methods to be added to
+// TidbUserLister.
+type TidbUserListerExpansion interface{}
+
+// TidbUserNamespaceListerExpansion allows custom methods to be added to
+// TidbUserNamespaceLister.
+type TidbUserNamespaceListerExpansion interface{}
@@ -22,6 +22,7 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
 	informers "github.com/pingcap/tidb-operator/pkg/client/informers/externalversions/pingcap/v1alpha1"
 	listers "github.com/pingcap/tidb-operator/pkg/client/listers/pingcap/v1alpha1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/tools/cache"
@@ -77,6 +78,11 @@ type BackupUpdateStatus struct {
 	RetryReason *string
 	// OriginalReason is the original reason of backup job or pod failed
 	OriginalReason *string
+
+	// ReplicaStatus is the status of copying the backup data to a replica storage
+	ReplicaStatus *v1alpha1.BackupReplicaStatus
+	// RetainUntil is the date until which some objects of the backup are retained by the object lock
+	RetainUntil *metav1.Time
 }
 
 // BackupConditionUpdaterInterface enables updating Backup conditions.
@@ -195,10 +201,33 @@ func updateBackupStatus(status *v1alpha1.BackupStatus, newStatus *BackupUpdateSt
 	if newStatus.RetryNum != nil || newStatus.RealRetryAt != nil {
 		isUpdate = updateBackoffRetryStatus(status, newStatus)
 	}
+	if newStatus.ReplicaStatus != nil && updateBackupReplicaStatus(status, newStatus.ReplicaStatus) {
+		isUpdate = true
+	}
+	if newStatus.RetainUntil != nil && (status.RetainUntil == nil || !status.RetainUntil.Equal(newStatus.RetainUntil)) {
+		status.RetainUntil = newStatus.RetainUntil
+		isUpdate = true
+	}
 
 	return isUpdate
 }
 
+// updateBackupReplicaStatus updates the status of the replica with the same index, or appends it if not found.
+func updateBackupReplicaStatus(status *v1alpha1.BackupStatus, replicaStatus *v1alpha1.BackupReplicaStatus) bool {
+	for i := range status.Replicas {
+		if status.Replicas[i].Index != replicaStatus.Index {
+			continue
+		}
+		if apiequality.Semantic.DeepEqual(status.Replicas[i], *replicaStatus) {
+			return false
+		}
+		status.Replicas[i] = *replicaStatus
+		return true
+	}
+	status.Replicas = append(status.Replicas, *replicaStatus)
+	return true
+}
+
 // updateSnapshotBackupStatus update snapshot mode backup status.
 func updateSnapshotBackupStatus(backup *v1alpha1.Backup, condition *v1alpha1.BackupCondition, newStatus *BackupUpdateStatus) bool {
 	var isStatusUpdate, isConditionUpdate bool
@@ -0,0 +1,199 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backuprepository
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	kvbackup "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/backup/constants"
+	backuputil "github.com/pingcap/tidb-operator/pkg/backup/util"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+)
+
+const (
+	// logBackupCheckpointDir is the directory which the stores upload the checkpoints of the log backup to
+	logBackupCheckpointDir = "v1/global_checkpoint"
+	// logBackupTruncateSafePointFile is the file which BR records the truncate safepoint of the log backup in,
+	// the typo is in the file name written by BR
+	logBackupTruncateSafePointFile = "v1_stream_trancate_safepoint.txt"
+
+	listPageSize = 1000
+)
+
+// catalogBackup is a backup recognized in the storage of a backup repository
+type catalogBackup struct {
+	// Dir is the sub directory of the backup in the storage prefix
+	Dir  string
+	Mode v1alpha1.BackupMode
+	// CommitTs is the ts of the snapshot, or the truncate safepoint of the log backup
+	CommitTs        uint64
+	LogCheckpointTs uint64
+	Size            int64
+	TimeCompleted   time.Time
+}
+
+// listBackupDirs returns the direct sub directories of the storage prefix
+func listBackupDirs(ctx context.Context, backend *backuputil.StorageBackend) ([]string, error) {
+	var dirs []string
+	iter := backend.ListPage(&blob.ListOptions{Delimiter: "/"})
+	for {
+		objs, err := iter.Next(ctx, listPageSize)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range objs {
+			if obj.IsDir {
+				dirs = append(dirs, strings.TrimSuffix(obj.Key, "/"))
+			}
+		}
+	}
+	return dirs, nil
+}
+
+// recognizeBackup recognizes the backup in the directory by its metadata, nil is returned if there is no backup
+func recognizeBackup(ctx context.Context, backend *backuputil.StorageBackend, dir string) (*catalogBackup, error) {
+	metaKey := path.Join(dir, constants.MetaFile)
+	attrs, err := backend.Attributes(ctx, metaKey)
+	if err != nil {
+		if gcerrors.Code(err) != gcerrors.NotFound {
+			return nil, fmt.Errorf("get attributes of %s failed, err: %v", metaKey, err)
+		}
+		return recognizeLogBackup(ctx, backend, dir)
+	}
+
+	data, err := backend.ReadAll(ctx, metaKey)
+	if err != nil {
+		return nil, fmt.Errorf("read %s failed, err: %v", metaKey, err)
+	}
+	bk := &catalogBackup{
+		Dir:           dir,
+		TimeCompleted: attrs.ModTime,
+	}
+	if err := parseBackupMeta(data, bk); err != nil {
+		return nil, fmt.Errorf("parse %s failed, err: %v", metaKey, err)
+	}
+	return bk, nil
+}
+
+// parseBackupMeta parses the backupmeta written by BR, which is in JSON for the volume-snapshot backups
+// and in protobuf for the snapshot backups
+func parseBackupMeta(data []byte, bk *catalogBackup) error {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		meta := &backuputil.EBSBasedBRMeta{}
+		if err := json.Unmarshal(data, meta); err != nil {
+			return err
+		}
+		if meta.ClusterInfo == nil {
+			return fmt.Errorf("cluster info is missing")
+		}
+		bk.Mode = v1alpha1.BackupModeVolumeSnapshot
+		bk.CommitTs = meta.ClusterInfo.ResolvedTS
+		return nil
+	}
+
+	meta := &kvbackup.BackupMeta{}
+	if err := proto.Unmarshal(data, meta); err != nil {
+		return err
+	}
+	bk.Mode = v1alpha1.BackupModeSnapshot
+	bk.CommitTs = meta.EndVersion
+	bk.Size = int64(backuputil.GetBRArchiveSize(meta))
+	return nil
+}
+
+// recognizeLogBackup recognizes the log backup in the directory by its global checkpoints
+func recognizeLogBackup(ctx context.Context, backend *backuputil.StorageBackend, dir string) (*catalogBackup, error) {
+	checkpoints, err := backend.ListPage(&blob.ListOptions{Prefix: path.Join(dir, logBackupCheckpointDir) + "/"}).Next(ctx, 1)
+	if err == io.EOF || (err == nil && len(checkpoints) == 0) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("list checkpoints of log backup %s failed, err: %v", dir, err)
+	}
+
+	bk := &catalogBackup{
+		Dir:  dir,
+		Mode: v1alpha1.BackupModeLog,
+	}
+	var (
+		checkpointKeys []string
+		hasSafePoint   bool
+	)
+	iter := backend.ListPage(&blob.ListOptions{Prefix: dir + "/"})
+	for {
+		objs, err := iter.Next(ctx, listPageSize)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("list objects of log backup %s failed, err: %v", dir, err)
+		}
+		for _, obj := range objs {
+			bk.Size += obj.Size
+			key := strings.TrimPrefix(obj.Key, dir+"/")
+			switch {
+			case path.Dir(key) == logBackupCheckpointDir && strings.HasSuffix(key, ".ts"):
+				checkpointKeys = append(checkpointKeys, obj.Key)
+				if obj.ModTime.After(bk.TimeCompleted) {
+					bk.TimeCompleted = obj.ModTime
+				}
+			case key == logBackupTruncateSafePointFile:
+				hasSafePoint = true
+			}
+		}
+	}
+
+	// the global checkpoint is the max of the checkpoints uploaded by the stores
+	for _, key := range checkpointKeys {
+		data, err := backend.ReadAll(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("read %s failed, err: %v", key, err)
+		}
+		if len(data) != 8 {
+			return nil, fmt.Errorf("invalid checkpoint %s with length %d", key, len(data))
+		}
+		if ts := binary.LittleEndian.Uint64(data); ts > bk.LogCheckpointTs {
+			bk.LogCheckpointTs = ts
+		}
+	}
+
+	if hasSafePoint {
+		key := path.Join(dir, logBackupTruncateSafePointFile)
+		data, err := backend.ReadAll(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("read %s failed, err: %v", key, err)
+		}
+		bk.CommitTs, err = strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse truncate safepoint %s failed, err: %v", key, err)
+		}
+	}
+	return bk, nil
+}
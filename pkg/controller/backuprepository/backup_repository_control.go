@@ -0,0 +1,335 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backuprepository
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	backuputil "github.com/pingcap/tidb-operator/pkg/backup/util"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	errorutils "k8s.io/apimachinery/pkg/util/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// defaultSyncInterval is the default interval to scan the storage
+	defaultSyncInterval = time.Hour
+
+	// importedReason is the reason of the conditions of the imported backups
+	importedReason = "Imported"
+
+	maxBackupNameLength = 63
+)
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// ControlInterface abstracts the business logic for BackupRepository reconciliation.
+type ControlInterface interface {
+	Reconcile(*v1alpha1.BackupRepository) error
+}
+
+func NewBackupRepositoryControl(deps *controller.Dependencies) ControlInterface {
+	return &defaultBackupRepositoryControl{
+		deps: deps,
+	}
+}
+
+type defaultBackupRepositoryControl struct {
+	deps *controller.Dependencies
+}
+
+func (c *defaultBackupRepositoryControl) Reconcile(repo *v1alpha1.BackupRepository) error {
+	if repo.DeletionTimestamp != nil || repo.Spec.Paused {
+		return nil
+	}
+
+	interval, err := getSyncInterval(repo)
+	if err != nil {
+		// the spec needs to be fixed by the user, so the error is only reported in the status
+		return c.reportError(repo, err)
+	}
+	// the storage is scanned again when the spec changes or the sync interval has passed
+	if repo.Status.ObservedGeneration == repo.Generation && repo.Status.LastSyncTime != nil &&
+		time.Since(repo.Status.LastSyncTime.Time) < interval {
+		return nil
+	}
+
+	oldStatus := repo.Status.DeepCopy()
+	err = c.syncRepository(repo)
+	if err != nil {
+		repo.Status.Error = err.Error()
+	} else {
+		now := metav1.Now()
+		repo.Status.Error = ""
+		repo.Status.LastSyncTime = &now
+		repo.Status.ObservedGeneration = repo.Generation
+	}
+
+	if !apiequality.Semantic.DeepEqual(&repo.Status, oldStatus) {
+		if _, updateErr := c.updateStatus(repo); updateErr != nil {
+			return updateErr
+		}
+	}
+	return err
+}
+
+func (c *defaultBackupRepositoryControl) reportError(repo *v1alpha1.BackupRepository, err error) error {
+	if repo.Status.Error == err.Error() {
+		return nil
+	}
+	repo.Status.Error = err.Error()
+	_, updateErr := c.updateStatus(repo)
+	return updateErr
+}
+
+// syncRepository scans the storage, the recognized backups are adopted if there are already Backup objects
+// of them, otherwise they are imported as new Backup objects.
+func (c *defaultBackupRepositoryControl) syncRepository(repo *v1alpha1.BackupRepository) error {
+	ns := repo.GetNamespace()
+	name := repo.GetName()
+
+	cred := backuputil.GetStorageCredential(ns, repo.Spec.StorageProvider, c.deps.SecretLister)
+	backend, err := backuputil.NewStorageBackend(repo.Spec.StorageProvider, cred)
+	if err != nil {
+		return fmt.Errorf("create storage backend failed, err: %v", err)
+	}
+	defer backend.Close()
+
+	ctx := context.Background()
+	dirs, err := listBackupDirs(ctx, backend)
+	if err != nil {
+		return fmt.Errorf("list backups failed, err: %v", err)
+	}
+
+	backups, err := c.deps.BackupLister.Backups(ns).List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("list backups of namespace %s failed, err: %v", ns, err)
+	}
+	backupsByPath := make(map[string]*v1alpha1.Backup, len(backups))
+	for _, backup := range backups {
+		if backup.Status.BackupPath != "" {
+			backupsByPath[strings.TrimSuffix(backup.Status.BackupPath, "/")] = backup
+		}
+	}
+
+	var (
+		count     int32
+		conflicts []string
+		errs      []error
+	)
+	for _, dir := range dirs {
+		provider := backuputil.AppendStoragePrefix(repo.Spec.StorageProvider, dir)
+		backupPath, err := backuputil.GetStoragePath(provider)
+		if err != nil {
+			return err
+		}
+		backupPath = strings.TrimSuffix(backupPath, "/")
+
+		if existing, ok := backupsByPath[backupPath]; ok {
+			if err := c.adoptBackup(repo, existing); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			count++
+			continue
+		}
+
+		bk, err := recognizeBackup(ctx, backend, dir)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if bk == nil {
+			klog.V(4).Infof("backup repository %s/%s: no backup is recognized in %s, skip it", ns, name, dir)
+			continue
+		}
+
+		backup := buildBackup(repo, bk, provider, backupPath)
+		if _, err := c.deps.BackupLister.Backups(ns).Get(backup.Name); err == nil {
+			// the Backup object of the name belongs to another backup
+			conflicts = append(conflicts, dir)
+			continue
+		} else if !errors.IsNotFound(err) {
+			errs = append(errs, err)
+			continue
+		}
+		if _, err := c.deps.BackupControl.CreateBackup(backup); err != nil {
+			errs = append(errs, fmt.Errorf("import backup %s failed, err: %v", dir, err))
+			continue
+		}
+		klog.Infof("backup repository %s/%s: backup %s is imported as %s", ns, name, dir, backup.Name)
+		count++
+	}
+
+	repo.Status.Backups = count
+	repo.Status.Conflicts = conflicts
+	return errorutils.NewAggregate(errs)
+}
+
+// adoptBackup labels the existing Backup object with the backup repository
+func (c *defaultBackupRepositoryControl) adoptBackup(repo *v1alpha1.BackupRepository, backup *v1alpha1.Backup) error {
+	if backup.Labels[label.BackupRepositoryLabelKey] == repo.Name {
+		return nil
+	}
+	ns := backup.GetNamespace()
+	update := backup.DeepCopy()
+	if update.Labels == nil {
+		update.Labels = map[string]string{}
+	}
+	update.Labels[label.BackupRepositoryLabelKey] = repo.Name
+	if _, err := c.deps.Clientset.PingcapV1alpha1().Backups(ns).Update(context.TODO(), update, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("adopt backup %s/%s failed, err: %v", ns, backup.Name, err)
+	}
+	klog.Infof("backup repository %s/%s: backup %s/%s is adopted", ns, repo.Name, ns, backup.Name)
+	return nil
+}
+
+// buildBackup builds the complete Backup object of the recognized backup
+func buildBackup(repo *v1alpha1.BackupRepository, bk *catalogBackup, provider v1alpha1.StorageProvider, backupPath string) *v1alpha1.Backup {
+	completed := metav1.NewTime(bk.TimeCompleted)
+	backup := &v1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      backupName(repo.Name, bk.Dir),
+			Namespace: repo.Namespace,
+			Labels: map[string]string{
+				label.BackupRepositoryLabelKey: repo.Name,
+			},
+		},
+		Spec: v1alpha1.BackupSpec{
+			Mode:            bk.Mode,
+			StorageProvider: provider,
+			CleanPolicy:     repo.GetCleanPolicy(),
+		},
+		Status: v1alpha1.BackupStatus{
+			BackupPath:         backupPath,
+			TimeCompleted:      completed,
+			BackupSize:         bk.Size,
+			BackupSizeReadable: humanize.Bytes(uint64(bk.Size)),
+			Phase:              v1alpha1.BackupComplete,
+		},
+	}
+	// the recognized backups are all taken by BR, they are cleaned by BR rather than as dumpling backups
+	// even if the cluster they are backed up from is unknown
+	backup.Spec.BR = &v1alpha1.BRConfig{}
+	if repo.Spec.BR != nil {
+		backup.Spec.BR = repo.Spec.BR.DeepCopy()
+	}
+	if bk.CommitTs > 0 {
+		backup.Status.CommitTs = strconv.FormatUint(bk.CommitTs, 10)
+	}
+
+	condition := v1alpha1.BackupCondition{
+		Type:               v1alpha1.BackupComplete,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: completed,
+		Reason:             importedReason,
+		Message:            fmt.Sprintf("imported by backup repository %s", repo.Name),
+	}
+	if bk.Mode == v1alpha1.BackupModeLog {
+		// the imported log backup is not running, so it is imported as stopped
+		backup.Spec.LogSubcommand = v1alpha1.LogStopCommand
+		backup.Status.Phase = v1alpha1.BackupStopped
+		backup.Status.LogCheckpointTs = strconv.FormatUint(bk.LogCheckpointTs, 10)
+		condition.Command = v1alpha1.LogStopCommand
+		backup.Status.LogSubCommandStatuses = map[v1alpha1.LogSubCommandType]v1alpha1.LogSubCommandStatus{
+			v1alpha1.LogStopCommand: {
+				Command:       v1alpha1.LogStopCommand,
+				Phase:         v1alpha1.BackupComplete,
+				TimeCompleted: completed,
+				Conditions:    []v1alpha1.BackupCondition{condition},
+			},
+		}
+	}
+	backup.Status.Conditions = []v1alpha1.BackupCondition{condition}
+	return backup
+}
+
+// backupName returns the name of the Backup object of the backup in the directory,
+// a hash of the directory is appended if the name is too long
+func backupName(repoName, dir string) string {
+	name := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(dir), "-"), "-")
+	name = fmt.Sprintf("%s-%s", repoName, name)
+	if len(name) <= maxBackupNameLength {
+		return name
+	}
+	h := fnv.New32a()
+	h.Write([]byte(dir))
+	suffix := fmt.Sprintf("-%08x", h.Sum32())
+	return strings.TrimRight(name[:maxBackupNameLength-len(suffix)], "-") + suffix
+}
+
+func getSyncInterval(repo *v1alpha1.BackupRepository) (time.Duration, error) {
+	if repo.Spec.SyncInterval == "" {
+		return defaultSyncInterval, nil
+	}
+	interval, err := time.ParseDuration(repo.Spec.SyncInterval)
+	if err != nil {
+		return 0, fmt.Errorf("invalid sync interval %s, err: %v", repo.Spec.SyncInterval, err)
+	}
+	if interval <= 0 {
+		return 0, fmt.Errorf("invalid sync interval %s, it should be positive", repo.Spec.SyncInterval)
+	}
+	return interval, nil
+}
+
+func (c *defaultBackupRepositoryControl) updateStatus(repo *v1alpha1.BackupRepository) (*v1alpha1.BackupRepository, error) {
+	var (
+		ns     = repo.GetNamespace()
+		name   = repo.GetName()
+		status = repo.Status.DeepCopy()
+		update *v1alpha1.BackupRepository
+	)
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var updateErr error
+		update, updateErr = c.deps.Clientset.PingcapV1alpha1().BackupRepositories(ns).UpdateStatus(context.TODO(), repo, metav1.UpdateOptions{})
+		if updateErr == nil {
+			klog.V(4).Infof("BackupRepository: [%s/%s], update status successfully", ns, name)
+			return nil
+		}
+
+		klog.V(4).Infof("BackupRepository: [%s/%s], update status failed, error: %v", ns, name, updateErr)
+
+		if updated, err := c.deps.BackupRepositoryLister.BackupRepositories(ns).Get(name); err == nil {
+			repo = updated.DeepCopy()
+			repo.Status = *status
+		} else {
+			utilruntime.HandleError(fmt.Errorf("error getting updated BackupRepository %s/%s from lister: %v", ns, name, err))
+		}
+
+		return updateErr
+	})
+	if err != nil {
+		klog.Errorf("BackupRepository: [%s/%s], failed to updateStatus, error: %v", ns, name, err)
+	}
+
+	return update, err
+}
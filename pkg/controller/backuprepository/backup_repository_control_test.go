@@ -0,0 +1,214 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backuprepository
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	kvbackup "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/backup/backup"
+	backuputil "github.com/pingcap/tidb-operator/pkg/backup/util"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func writeFile(g *GomegaWithT, name string, data []byte) {
+	g.Expect(os.MkdirAll(filepath.Dir(name), 0755)).To(Succeed())
+	g.Expect(os.WriteFile(name, data, 0644)).To(Succeed())
+}
+
+func writeSnapshotBackup(g *GomegaWithT, dir string, endVersion uint64) {
+	data, err := proto.Marshal(&kvbackup.BackupMeta{EndVersion: endVersion, BackupSize: 1024})
+	g.Expect(err).To(Succeed())
+	writeFile(g, filepath.Join(dir, "backupmeta"), data)
+}
+
+func writeCheckpoint(g *GomegaWithT, dir, store string, ts uint64) {
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, ts)
+	writeFile(g, filepath.Join(dir, "v1", "global_checkpoint", store+".ts"), data)
+}
+
+func TestBackupRepositoryControlReconcile(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	control := NewBackupRepositoryControl(deps)
+	root := t.TempDir()
+	storage := filepath.Join(root, "backups")
+
+	writeSnapshotBackup(g, filepath.Join(storage, "Snapshot_2024"), 100)
+	writeSnapshotBackup(g, filepath.Join(storage, "adopted"), 200)
+	writeSnapshotBackup(g, filepath.Join(storage, "conflict"), 300)
+	ebsMeta, err := json.Marshal(&backuputil.EBSBasedBRMeta{ClusterInfo: &backuputil.ClusterInfo{ResolvedTS: 400}})
+	g.Expect(err).To(Succeed())
+	writeFile(g, filepath.Join(storage, "ebs", "backupmeta"), ebsMeta)
+	writeCheckpoint(g, filepath.Join(storage, "log"), "1", 500)
+	writeCheckpoint(g, filepath.Join(storage, "log"), "2", 600)
+	writeFile(g, filepath.Join(storage, "log", "v1_stream_trancate_safepoint.txt"), []byte("450\n"))
+	writeFile(g, filepath.Join(storage, "unknown", "data"), []byte("data"))
+
+	existing := []*v1alpha1.Backup{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "adopted", Namespace: "ns"},
+			Status:     v1alpha1.BackupStatus{BackupPath: "local://" + filepath.Join(storage, "adopted")},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "repo-conflict", Namespace: "ns"},
+			Status:     v1alpha1.BackupStatus{BackupPath: "local://" + filepath.Join(root, "other")},
+		},
+	}
+	for _, backup := range existing {
+		_, err := deps.Clientset.PingcapV1alpha1().Backups("ns").Create(context.TODO(), backup, metav1.CreateOptions{})
+		g.Expect(err).To(Succeed())
+		g.Expect(deps.InformerFactory.Pingcap().V1alpha1().Backups().Informer().GetIndexer().Add(backup)).To(Succeed())
+	}
+
+	repo := &v1alpha1.BackupRepository{
+		ObjectMeta: metav1.ObjectMeta{Name: "repo", Namespace: "ns", Generation: 1},
+		Spec: v1alpha1.BackupRepositorySpec{
+			StorageProvider: v1alpha1.StorageProvider{
+				Local: &v1alpha1.LocalStorageProvider{
+					VolumeMount: corev1.VolumeMount{MountPath: root},
+					Prefix:      "backups",
+				},
+			},
+			BR: &v1alpha1.BRConfig{Cluster: "tc", ClusterNamespace: "ns"},
+		},
+	}
+	repo, err = deps.Clientset.PingcapV1alpha1().BackupRepositories("ns").Create(context.TODO(), repo, metav1.CreateOptions{})
+	g.Expect(err).To(Succeed())
+
+	g.Expect(control.Reconcile(repo)).To(Succeed())
+	g.Expect(repo.Status.Error).To(BeEmpty())
+	g.Expect(repo.Status.Backups).To(Equal(int32(4)))
+	g.Expect(repo.Status.Conflicts).To(Equal([]string{"conflict"}))
+	g.Expect(repo.Status.ObservedGeneration).To(Equal(int64(1)))
+	g.Expect(repo.Status.LastSyncTime).NotTo(BeNil())
+
+	lister := deps.BackupLister.Backups("ns")
+	snapshot, err := lister.Get("repo-snapshot-2024")
+	g.Expect(err).To(Succeed())
+	g.Expect(snapshot.Labels[label.BackupRepositoryLabelKey]).To(Equal("repo"))
+	g.Expect(snapshot.Spec.Mode).To(Equal(v1alpha1.BackupModeSnapshot))
+	g.Expect(snapshot.Spec.Local.Prefix).To(Equal("backups/Snapshot_2024"))
+	g.Expect(snapshot.Spec.BR.Cluster).To(Equal("tc"))
+	g.Expect(snapshot.Spec.CleanPolicy).To(Equal(v1alpha1.CleanPolicyTypeRetain))
+	g.Expect(snapshot.Status.Phase).To(Equal(v1alpha1.BackupComplete))
+	g.Expect(snapshot.Status.CommitTs).To(Equal("100"))
+	g.Expect(snapshot.Status.BackupSize).To(Equal(int64(1024)))
+	g.Expect(snapshot.Status.BackupPath).To(Equal("local://" + filepath.Join(storage, "Snapshot_2024")))
+	g.Expect(v1alpha1.IsBackupComplete(snapshot)).To(BeTrue())
+
+	ebs, err := lister.Get("repo-ebs")
+	g.Expect(err).To(Succeed())
+	g.Expect(ebs.Spec.Mode).To(Equal(v1alpha1.BackupModeVolumeSnapshot))
+	g.Expect(ebs.Status.CommitTs).To(Equal("400"))
+
+	log, err := lister.Get("repo-log")
+	g.Expect(err).To(Succeed())
+	g.Expect(log.Spec.Mode).To(Equal(v1alpha1.BackupModeLog))
+	g.Expect(log.Spec.LogSubcommand).To(Equal(v1alpha1.LogStopCommand))
+	g.Expect(log.Status.Phase).To(Equal(v1alpha1.BackupStopped))
+	g.Expect(log.Status.CommitTs).To(Equal("450"))
+	g.Expect(log.Status.LogCheckpointTs).To(Equal("600"))
+	g.Expect(v1alpha1.IsBackupComplete(log)).To(BeTrue())
+
+	_, err = lister.Get("repo-unknown")
+	g.Expect(err).To(HaveOccurred())
+	adopted, err := deps.Clientset.PingcapV1alpha1().Backups("ns").Get(context.TODO(), "adopted", metav1.GetOptions{})
+	g.Expect(err).To(Succeed())
+	g.Expect(adopted.Labels[label.BackupRepositoryLabelKey]).To(Equal("repo"))
+
+	// the storage is not scanned again before the sync interval passes
+	writeSnapshotBackup(g, filepath.Join(storage, "new"), 700)
+	g.Expect(control.Reconcile(repo)).To(Succeed())
+	_, err = lister.Get("repo-new")
+	g.Expect(err).To(HaveOccurred())
+
+	// the storage is scanned again when the spec changes
+	repo.Generation = 2
+	g.Expect(control.Reconcile(repo)).To(Succeed())
+	_, err = lister.Get("repo-new")
+	g.Expect(err).To(Succeed())
+	g.Expect(repo.Status.ObservedGeneration).To(Equal(int64(2)))
+
+	// the invalid sync interval is reported in the status
+	repo.Spec.SyncInterval = "1d"
+	g.Expect(control.Reconcile(repo)).To(Succeed())
+	g.Expect(repo.Status.Error).To(ContainSubstring("invalid sync interval"))
+}
+
+func TestCleanImportedLogBackup(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	control := NewBackupRepositoryControl(deps)
+	root := t.TempDir()
+	writeCheckpoint(g, filepath.Join(root, "backups", "log"), "1", 500)
+
+	// the BR config of the imported backups is not set
+	repo := &v1alpha1.BackupRepository{
+		ObjectMeta: metav1.ObjectMeta{Name: "repo", Namespace: "ns", Generation: 1},
+		Spec: v1alpha1.BackupRepositorySpec{
+			StorageProvider: v1alpha1.StorageProvider{
+				Local: &v1alpha1.LocalStorageProvider{
+					VolumeMount: corev1.VolumeMount{MountPath: root},
+					Prefix:      "backups",
+				},
+			},
+			CleanPolicy: v1alpha1.CleanPolicyTypeDelete,
+		},
+	}
+	repo, err := deps.Clientset.PingcapV1alpha1().BackupRepositories("ns").Create(context.TODO(), repo, metav1.CreateOptions{})
+	g.Expect(err).To(Succeed())
+	g.Expect(control.Reconcile(repo)).To(Succeed())
+	g.Expect(repo.Status.Backups).To(Equal(int32(1)))
+
+	log, err := deps.BackupLister.Backups("ns").Get("repo-log")
+	g.Expect(err).To(Succeed())
+	g.Expect(log.Spec.BR).NotTo(BeNil())
+
+	// the imported log backup is stopped, so it's cleaned by the clean job directly
+	log = log.DeepCopy()
+	log.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	cleaner := backup.NewBackupCleaner(deps, controller.NewFakeBackupConditionUpdater(deps.InformerFactory.Pingcap().V1alpha1().Backups()))
+	g.Expect(cleaner.Clean(log)).To(Succeed())
+	_, err = deps.JobLister.Jobs("ns").Get(log.GetCleanJobName())
+	g.Expect(err).To(Succeed())
+}
+
+func TestBackupName(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(backupName("repo", "Full_Backup.2024")).To(Equal("repo-full-backup-2024"))
+
+	long := backupName("repo", strings.Repeat("a", 100))
+	g.Expect(len(long)).To(Equal(maxBackupNameLength))
+	g.Expect(long).To(HavePrefix("repo-aaa"))
+	g.Expect(long).NotTo(Equal(backupName("repo", strings.Repeat("a", 101))))
+}
@@ -32,6 +32,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	corelisterv1 "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/client-go/util/workqueue"
@@ -710,3 +711,17 @@ func GuaranteedUpdate(cli client.Client, obj client.Object, updateFunc func() er
 		return cli.Update(context.TODO(), obj)
 	})
 }
+
+// GetUserAndPasswordFromSecret returns the `user` and `password` stored in the secret,
+// the `user` key is required and the `password` key is optional.
+func GetUserAndPasswordFromSecret(secretLister corelisterv1.SecretLister, ns, name string) (string, string, error) {
+	secret, err := secretLister.Secrets(ns).Get(name)
+	if err != nil {
+		return "", "", fmt.Errorf("get secret %s/%s failed, err: %v", ns, name, err)
+	}
+	user := string(secret.Data["user"])
+	if user == "" {
+		return "", "", fmt.Errorf("key user not found in secret %s/%s", ns, name)
+	}
+	return user, string(secret.Data["password"]), nil
+}
@@ -194,6 +194,7 @@ type Controls struct {
 	CDCControl         TiCDCControlInterface
 	ProxyControl       TiProxyControlInterface
 	TiDBControl        TiDBControlInterface
+	TiDBSQLControl     TiDBSQLControlInterface
 	BackupControl      BackupControlInterface
 	CompactControl     CompactBackupControlInterface
 	RestoreControl     RestoreControlInterface
@@ -241,6 +242,13 @@ type Dependencies struct {
 	TiDBMonitorLister           listers.TidbMonitorLister
 	TiDBNGMonitoringLister      listers.TidbNGMonitoringLister
 	TiDBDashboardLister         listers.TidbDashboardLister
+	TiCDCChangefeedLister       listers.TiCDCChangefeedLister
+	DMSourceLister              listers.DMSourceLister
+	DMTaskLister                listers.DMTaskLister
+	TiDBUserLister              listers.TidbUserLister
+	TiDBPlacementPolicyLister   listers.TidbPlacementPolicyLister
+	TiDBResourceGroupLister     listers.TidbResourceGroupLister
+	BackupRepositoryLister      listers.BackupRepositoryLister
 
 	// Controls
 	Controls
@@ -297,6 +305,7 @@ func newRealControls(
 		CDCControl:         NewDefaultTiCDCControl(secretLister),
 		ProxyControl:       NewDefaultTiProxyControl(),
 		TiDBControl:        NewDefaultTiDBControl(secretLister),
+		TiDBSQLControl:     NewDefaultTiDBSQLControl(secretLister),
 		BackupControl:      NewRealBackupControl(clientset, recorder),
 		CompactControl:     NewRealCompactControl(clientset, recorder),
 		RestoreControl:     NewRealRestoreControl(clientset, restoreLister, recorder),
@@ -388,6 +397,13 @@ func newDependencies(
 		TiDBMonitorLister:           informerFactory.Pingcap().V1alpha1().TidbMonitors().Lister(),
 		TiDBNGMonitoringLister:      informerFactory.Pingcap().V1alpha1().TidbNGMonitorings().Lister(),
 		TiDBDashboardLister:         informerFactory.Pingcap().V1alpha1().TidbDashboards().Lister(),
+		TiCDCChangefeedLister:       informerFactory.Pingcap().V1alpha1().TiCDCChangefeeds().Lister(),
+		DMSourceLister:              informerFactory.Pingcap().V1alpha1().DMSources().Lister(),
+		DMTaskLister:                informerFactory.Pingcap().V1alpha1().DMTasks().Lister(),
+		TiDBUserLister:              informerFactory.Pingcap().V1alpha1().TidbUsers().Lister(),
+		TiDBPlacementPolicyLister:   informerFactory.Pingcap().V1alpha1().TidbPlacementPolicies().Lister(),
+		TiDBResourceGroupLister:     informerFactory.Pingcap().V1alpha1().TidbResourceGroups().Lister(),
+		BackupRepositoryLister:      informerFactory.Pingcap().V1alpha1().BackupRepositories().Lister(),
 
 		AWSConfig: cfg,
 	}, nil
@@ -458,6 +474,7 @@ func newFakeControl(kubeClientset kubernetes.Interface, informerFactory informer
 		TiDBClusterControl: NewFakeTidbClusterControl(informerFactory.Pingcap().V1alpha1().TidbClusters()),
 		CDCControl:         NewFakeTiCDCControl(),
 		TiDBControl:        NewFakeTiDBControl(kubeInformerFactory.Core().V1().Secrets().Lister()),
+		TiDBSQLControl:     NewFakeTiDBSQLControl(),
 		BackupControl:      NewFakeBackupControl(informerFactory.Pingcap().V1alpha1().Backups()),
 		ProxyControl:       NewFakeTiProxyControl(),
 		SecretControl:      NewFakeSecretControl(kubeInformerFactory.Core().V1().Secrets()),
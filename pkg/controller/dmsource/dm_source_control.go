@@ -0,0 +1,229 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmsource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/dmapi"
+	"github.com/pingcap/tidb-operator/pkg/third_party/k8s"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+)
+
+// ControlInterface abstracts the business logic for DMSource reconciliation.
+type ControlInterface interface {
+	Reconcile(*v1alpha1.DMSource) error
+}
+
+func NewDMSourceControl(deps *controller.Dependencies) ControlInterface {
+	return &defaultDMSourceControl{
+		deps: deps,
+	}
+}
+
+type defaultDMSourceControl struct {
+	deps *controller.Dependencies
+}
+
+func (c *defaultDMSourceControl) Reconcile(source *v1alpha1.DMSource) error {
+	if source.DeletionTimestamp != nil {
+		return c.deleteSource(source)
+	}
+
+	if err := c.addProtectionFinalizer(source); err != nil {
+		return err
+	}
+
+	oldStatus := source.Status.DeepCopy()
+	err := c.syncSource(source)
+	if err != nil {
+		source.Status.Error = err.Error()
+	}
+
+	if !apiequality.Semantic.DeepEqual(&source.Status, oldStatus) {
+		now := metav1.Now()
+		source.Status.LastUpdateTime = &now
+		if _, updateErr := c.updateStatus(source); updateErr != nil {
+			return updateErr
+		}
+	}
+	// the status of the source is refreshed when the informer resyncs
+	return err
+}
+
+// syncSource creates or updates the source in DM according to the spec, and refreshes the status from DM.
+func (c *defaultDMSourceControl) syncSource(source *v1alpha1.DMSource) error {
+	ns := source.GetNamespace()
+	name := source.GetName()
+	sourceName := source.GetSourceName()
+
+	dc, err := c.getDMCluster(source)
+	if err != nil {
+		return err
+	}
+	masterClient := controller.GetMasterClient(c.deps.DMMasterControl, dc)
+
+	user, password, err := controller.GetUserAndPasswordFromSecret(c.deps.SecretLister, ns, source.Spec.SecretName)
+	if err != nil {
+		return err
+	}
+	desired := &dmapi.Source{
+		SourceName:  sourceName,
+		Host:        source.Spec.Host,
+		Port:        int(source.Spec.Port),
+		User:        user,
+		Password:    password,
+		EnableGTID:  source.Spec.EnableGTID,
+		Enable:      true,
+		RelayConfig: &dmapi.RelayConfig{EnableRelay: source.Spec.EnableRelay},
+	}
+
+	current, err := masterClient.GetSource(sourceName)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		klog.Infof("dm source %s/%s: create source %s", ns, name, sourceName)
+		if err := masterClient.CreateSource(desired); err != nil {
+			return err
+		}
+		source.Status.ObservedGeneration = source.Generation
+	} else if source.Status.ObservedGeneration != source.Generation {
+		klog.Infof("dm source %s/%s: update source %s", ns, name, sourceName)
+		if err := masterClient.UpdateSource(desired); err != nil {
+			return err
+		}
+		source.Status.ObservedGeneration = source.Generation
+	}
+
+	current, err = masterClient.GetSource(sourceName)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return fmt.Errorf("source %s not found in dm", sourceName)
+	}
+	source.Status.WorkerName = ""
+	source.Status.RelayStage = ""
+	source.Status.Error = ""
+	for _, status := range current.StatusList {
+		source.Status.WorkerName = status.WorkerName
+		if status.RelayStatus != nil {
+			source.Status.RelayStage = status.RelayStatus.Stage
+		}
+		if status.ErrorMsg != "" {
+			source.Status.Error = status.ErrorMsg
+		}
+	}
+	return nil
+}
+
+// deleteSource deletes the source from DM and removes the protection finalizer from the DMSource
+func (c *defaultDMSourceControl) deleteSource(source *v1alpha1.DMSource) error {
+	ns := source.GetNamespace()
+	name := source.GetName()
+	if !k8s.ContainsString(source.Finalizers, label.DMSourceProtectionFinalizer, nil) {
+		return nil
+	}
+
+	dc, err := c.getDMCluster(source)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	// the source is deleted with the dm-master if the dmcluster is deleted
+	if dc != nil && dc.Spec.Master.Replicas > 0 {
+		if err := controller.GetMasterClient(c.deps.DMMasterControl, dc).DeleteSource(source.GetSourceName()); err != nil {
+			return err
+		}
+		klog.Infof("dm source %s/%s: source %s is deleted", ns, name, source.GetSourceName())
+	}
+
+	source.Finalizers = k8s.RemoveString(source.Finalizers, label.DMSourceProtectionFinalizer, nil)
+	_, err = c.deps.Clientset.PingcapV1alpha1().DMSources(ns).Update(context.TODO(), source, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("remove dm source %s/%s protection finalizers failed, err: %v", ns, name, err)
+	}
+	return nil
+}
+
+// addProtectionFinalizer adds the finalizer to delete the source from DM when the DMSource is deleted
+func (c *defaultDMSourceControl) addProtectionFinalizer(source *v1alpha1.DMSource) error {
+	ns := source.GetNamespace()
+	name := source.GetName()
+	if k8s.ContainsString(source.Finalizers, label.DMSourceProtectionFinalizer, nil) {
+		return nil
+	}
+
+	source.Finalizers = append(source.Finalizers, label.DMSourceProtectionFinalizer)
+	updated, err := c.deps.Clientset.PingcapV1alpha1().DMSources(ns).Update(context.TODO(), source, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("add dm source %s/%s protection finalizers failed, err: %v", ns, name, err)
+	}
+	source.ObjectMeta = updated.ObjectMeta
+	return nil
+}
+
+func (c *defaultDMSourceControl) getDMCluster(source *v1alpha1.DMSource) (*v1alpha1.DMCluster, error) {
+	dcNs := source.GetClusterNamespace()
+	dcName := source.Spec.Cluster.Name
+	dc, err := c.deps.DMClusterLister.DMClusters(dcNs).Get(dcName)
+	if err != nil {
+		return nil, fmt.Errorf("get dmcluster %s/%s failed, err: %w", dcNs, dcName, err)
+	}
+	return dc, nil
+}
+
+func (c *defaultDMSourceControl) updateStatus(source *v1alpha1.DMSource) (*v1alpha1.DMSource, error) {
+	var (
+		ns     = source.GetNamespace()
+		name   = source.GetName()
+		status = source.Status.DeepCopy()
+		update *v1alpha1.DMSource
+	)
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var updateErr error
+		update, updateErr = c.deps.Clientset.PingcapV1alpha1().DMSources(ns).UpdateStatus(context.TODO(), source, metav1.UpdateOptions{})
+		if updateErr == nil {
+			klog.V(4).Infof("DMSource: [%s/%s], update status successfully", ns, name)
+			return nil
+		}
+
+		klog.V(4).Infof("DMSource: [%s/%s], update status failed, error: %v", ns, name, updateErr)
+
+		if updated, err := c.deps.DMSourceLister.DMSources(ns).Get(name); err == nil {
+			source = updated.DeepCopy()
+			source.Status = *status
+		} else {
+			utilruntime.HandleError(fmt.Errorf("error getting updated DMSource %s/%s from lister: %v", ns, name, err))
+		}
+
+		return updateErr
+	})
+	if err != nil {
+		klog.Errorf("DMSource: [%s/%s], failed to updateStatus, error: %v", ns, name, err)
+	}
+
+	return update, err
+}
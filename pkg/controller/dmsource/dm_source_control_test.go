@@ -0,0 +1,128 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmsource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/dmapi"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDMSourceControlReconcile(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	control := NewDMSourceControl(deps)
+
+	dc := &v1alpha1.DMCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "dc", Namespace: "ns"},
+		Spec: v1alpha1.DMClusterSpec{
+			Master: v1alpha1.MasterSpec{Replicas: 1},
+		},
+	}
+	g.Expect(deps.InformerFactory.Pingcap().V1alpha1().DMClusters().Informer().GetIndexer().Add(dc)).To(Succeed())
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "upstream", Namespace: "ns"},
+		Data: map[string][]byte{
+			"user":     []byte("root"),
+			"password": []byte("pass"),
+		},
+	}
+	g.Expect(deps.KubeInformerFactory.Core().V1().Secrets().Informer().GetIndexer().Add(secret)).To(Succeed())
+
+	source := &v1alpha1.DMSource{
+		ObjectMeta: metav1.ObjectMeta{Name: "mysql-01", Namespace: "ns", Generation: 1},
+		Spec: v1alpha1.DMSourceSpec{
+			Cluster:    v1alpha1.ClusterRef{Name: "dc"},
+			Host:       "mysql",
+			Port:       3306,
+			SecretName: "upstream",
+			EnableGTID: true,
+		},
+	}
+	source, err := deps.Clientset.PingcapV1alpha1().DMSources("ns").Create(context.TODO(), source, metav1.CreateOptions{})
+	g.Expect(err).To(Succeed())
+
+	var current *dmapi.Source
+	var calls []string
+	masterClient := controller.NewFakeMasterClient(deps.DMMasterControl.(*dmapi.FakeMasterControl), dc)
+	masterClient.AddReaction(dmapi.GetSourceActionType, func(action *dmapi.Action) (interface{}, error) {
+		return current, nil
+	})
+	masterClient.AddReaction(dmapi.CreateSourceActionType, func(action *dmapi.Action) (interface{}, error) {
+		calls = append(calls, "create")
+		current = action.Source
+		current.StatusList = []dmapi.SourceStatus{{SourceName: action.Name, WorkerName: "dm-worker-0"}}
+		return nil, nil
+	})
+	masterClient.AddReaction(dmapi.UpdateSourceActionType, func(action *dmapi.Action) (interface{}, error) {
+		calls = append(calls, "update")
+		current.Host = action.Source.Host
+		return nil, nil
+	})
+	masterClient.AddReaction(dmapi.DeleteSourceActionType, func(action *dmapi.Action) (interface{}, error) {
+		calls = append(calls, "delete")
+		current = nil
+		return nil, nil
+	})
+
+	// create the source
+	g.Expect(control.Reconcile(source)).To(Succeed())
+	g.Expect(calls).To(Equal([]string{"create"}))
+	g.Expect(current.SourceName).To(Equal("mysql-01"))
+	g.Expect(current.User).To(Equal("root"))
+	g.Expect(current.Password).To(Equal("pass"))
+	g.Expect(current.EnableGTID).To(BeTrue())
+	source, err = deps.Clientset.PingcapV1alpha1().DMSources("ns").Get(context.TODO(), "mysql-01", metav1.GetOptions{})
+	g.Expect(err).To(Succeed())
+	g.Expect(source.Finalizers).To(ContainElement(label.DMSourceProtectionFinalizer))
+	g.Expect(source.Status.WorkerName).To(Equal("dm-worker-0"))
+	g.Expect(source.Status.ObservedGeneration).To(Equal(int64(1)))
+
+	// nothing changed
+	calls = nil
+	g.Expect(control.Reconcile(source)).To(Succeed())
+	g.Expect(calls).To(BeEmpty())
+
+	// update the source
+	source.Spec.Host = "mysql-new"
+	source.Generation = 2
+	g.Expect(control.Reconcile(source)).To(Succeed())
+	g.Expect(calls).To(Equal([]string{"update"}))
+	g.Expect(current.Host).To(Equal("mysql-new"))
+	g.Expect(source.Status.ObservedGeneration).To(Equal(int64(2)))
+
+	// the error reported by dm is set to the status
+	current.StatusList[0].ErrorMsg = "access denied"
+	g.Expect(control.Reconcile(source)).To(Succeed())
+	g.Expect(source.Status.Error).To(Equal("access denied"))
+
+	// delete the source
+	calls = nil
+	now := metav1.Now()
+	source.DeletionTimestamp = &now
+	g.Expect(control.Reconcile(source)).To(Succeed())
+	g.Expect(calls).To(Equal([]string{"delete"}))
+	source, err = deps.Clientset.PingcapV1alpha1().DMSources("ns").Get(context.TODO(), "mysql-01", metav1.GetOptions{})
+	g.Expect(err).To(Succeed())
+	g.Expect(source.Finalizers).NotTo(ContainElement(label.DMSourceProtectionFinalizer))
+}
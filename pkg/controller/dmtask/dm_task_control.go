@@ -0,0 +1,371 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmtask
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/dmapi"
+	"github.com/pingcap/tidb-operator/pkg/third_party/k8s"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+)
+
+// ControlInterface abstracts the business logic for DMTask reconciliation.
+type ControlInterface interface {
+	Reconcile(*v1alpha1.DMTask) error
+}
+
+func NewDMTaskControl(deps *controller.Dependencies) ControlInterface {
+	return &defaultDMTaskControl{
+		deps: deps,
+	}
+}
+
+type defaultDMTaskControl struct {
+	deps *controller.Dependencies
+}
+
+func (c *defaultDMTaskControl) Reconcile(task *v1alpha1.DMTask) error {
+	if task.DeletionTimestamp != nil {
+		return c.deleteTask(task)
+	}
+
+	if err := c.addProtectionFinalizer(task); err != nil {
+		return err
+	}
+
+	oldStatus := task.Status.DeepCopy()
+	err := c.syncTask(task)
+	if err != nil {
+		task.Status.Errors = []string{err.Error()}
+	}
+
+	if !apiequality.Semantic.DeepEqual(&task.Status, oldStatus) {
+		now := metav1.Now()
+		task.Status.LastUpdateTime = &now
+		if _, updateErr := c.updateStatus(task); updateErr != nil {
+			return updateErr
+		}
+	}
+	// the status of the task is refreshed when the informer resyncs
+	return err
+}
+
+// syncTask creates, updates, starts or stops the task in DM according to the spec,
+// and refreshes the status from DM.
+func (c *defaultDMTaskControl) syncTask(task *v1alpha1.DMTask) error {
+	ns := task.GetNamespace()
+	name := task.GetName()
+	taskName := task.GetTaskName()
+
+	dc, err := c.getDMCluster(task)
+	if err != nil {
+		return err
+	}
+	masterClient := controller.GetMasterClient(c.deps.DMMasterControl, dc)
+
+	desired, err := c.desiredTask(task)
+	if err != nil {
+		return err
+	}
+
+	current, err := masterClient.GetTask(taskName)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		klog.Infof("dm task %s/%s: create task %s", ns, name, taskName)
+		if err := masterClient.CreateTask(desired); err != nil {
+			return err
+		}
+		task.Status.ObservedGeneration = task.Generation
+		if !task.Spec.Paused {
+			if err := masterClient.StartTask(taskName); err != nil {
+				return err
+			}
+		}
+		return c.refreshStatus(task, masterClient)
+	}
+
+	stopped := isTaskStopped(current)
+	if task.Status.ObservedGeneration != task.Generation {
+		// a task can only be updated when it is stopped
+		klog.Infof("dm task %s/%s: update task %s", ns, name, taskName)
+		if !stopped {
+			if err := masterClient.StopTask(taskName); err != nil {
+				return err
+			}
+			stopped = true
+		}
+		if err := masterClient.UpdateTask(desired); err != nil {
+			return err
+		}
+		task.Status.ObservedGeneration = task.Generation
+	}
+
+	switch {
+	case task.Spec.Paused && !stopped:
+		klog.Infof("dm task %s/%s: stop task %s", ns, name, taskName)
+		if err := masterClient.StopTask(taskName); err != nil {
+			return err
+		}
+	case !task.Spec.Paused && stopped:
+		klog.Infof("dm task %s/%s: start task %s", ns, name, taskName)
+		if err := masterClient.StartTask(taskName); err != nil {
+			return err
+		}
+	}
+
+	return c.refreshStatus(task, masterClient)
+}
+
+// refreshStatus sets the stage, lag and errors of the subtasks reported by DM to the status
+func (c *defaultDMTaskControl) refreshStatus(task *v1alpha1.DMTask, masterClient dmapi.MasterClient) error {
+	current, err := masterClient.GetTask(task.GetTaskName())
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return fmt.Errorf("task %s not found in dm", task.GetTaskName())
+	}
+
+	var maxLag int64
+	var subTasks []v1alpha1.DMSubTaskStatus
+	var errs []string
+	for _, st := range current.StatusList {
+		status := v1alpha1.DMSubTaskStatus{
+			SourceName:          st.SourceName,
+			WorkerName:          st.WorkerName,
+			Stage:               v1alpha1.DMTaskStage(st.Stage),
+			Unit:                st.Unit,
+			UnresolvedDDLLockID: st.UnresolvedDDLLockID,
+		}
+		if st.ErrorMsg != "" {
+			errs = append(errs, fmt.Sprintf("source %s: %s", st.SourceName, st.ErrorMsg))
+		}
+		if st.SyncStatus != nil {
+			status.SecondsBehindMaster = st.SyncStatus.SecondsBehindMaster
+			if st.SyncStatus.SecondsBehindMaster > maxLag {
+				maxLag = st.SyncStatus.SecondsBehindMaster
+			}
+			for _, ddl := range st.SyncStatus.BlockingDDLs {
+				errs = append(errs, fmt.Sprintf("source %s: DDL %q is blocked", st.SourceName, ddl))
+			}
+			for _, group := range st.SyncStatus.UnresolvedGroups {
+				errs = append(errs, fmt.Sprintf("source %s: shard DDLs of %s are unresolved, unsynced tables: %v",
+					st.SourceName, group.Target, group.Unsynced))
+			}
+		}
+		subTasks = append(subTasks, status)
+	}
+
+	task.Status.Stage = taskStage(subTasks)
+	task.Status.SubTasks = subTasks
+	task.Status.Errors = errs
+	task.Status.Lag = ""
+	if len(subTasks) > 0 {
+		task.Status.Lag = (time.Duration(maxLag) * time.Second).String()
+	}
+	return nil
+}
+
+// desiredTask converts the spec to the task of the OpenAPI
+func (c *defaultDMTaskControl) desiredTask(task *v1alpha1.DMTask) (*dmapi.Task, error) {
+	user, password, err := controller.GetUserAndPasswordFromSecret(c.deps.SecretLister, task.GetNamespace(), task.Spec.Target.SecretName)
+	if err != nil {
+		return nil, err
+	}
+
+	desired := &dmapi.Task{
+		Name:        task.GetTaskName(),
+		TaskMode:    string(task.Spec.TaskMode),
+		ShardMode:   task.Spec.ShardMode,
+		MetaSchema:  task.Spec.MetaSchema,
+		OnDuplicate: task.Spec.OnDuplicate,
+		TargetConfig: dmapi.TaskTargetDataBase{
+			Host:     task.Spec.Target.Host,
+			Port:     int(task.Spec.Target.Port),
+			User:     user,
+			Password: password,
+		},
+		TableMigrateRules: []dmapi.TaskTableMigrateRule{},
+	}
+	if desired.TaskMode == "" {
+		desired.TaskMode = string(v1alpha1.DMTaskModeAll)
+	}
+	if desired.OnDuplicate == "" {
+		desired.OnDuplicate = "overwrite"
+	}
+	for _, s := range task.Spec.Sources {
+		desired.SourceConfig.SourceConf = append(desired.SourceConfig.SourceConf, dmapi.TaskSourceConf{
+			SourceName: s.SourceName,
+			BinlogName: s.BinlogName,
+			BinlogPos:  s.BinlogPos,
+			BinlogGTID: s.BinlogGTID,
+		})
+	}
+	for _, rule := range task.Spec.TableMigrateRules {
+		r := dmapi.TaskTableMigrateRule{
+			Source: dmapi.TaskTableMigrateSource{
+				SourceName: rule.Source.SourceName,
+				Schema:     rule.Source.Schema,
+				Table:      rule.Source.Table,
+			},
+		}
+		if rule.Target != nil {
+			r.Target = &dmapi.TaskTableMigrateTarget{
+				Schema: rule.Target.Schema,
+				Table:  rule.Target.Table,
+			}
+		}
+		desired.TableMigrateRules = append(desired.TableMigrateRules, r)
+	}
+	return desired, nil
+}
+
+// deleteTask deletes the task from DM and removes the protection finalizer from the DMTask
+func (c *defaultDMTaskControl) deleteTask(task *v1alpha1.DMTask) error {
+	ns := task.GetNamespace()
+	name := task.GetName()
+	if !k8s.ContainsString(task.Finalizers, label.DMTaskProtectionFinalizer, nil) {
+		return nil
+	}
+
+	dc, err := c.getDMCluster(task)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	// the task is deleted with the dm-master if the dmcluster is deleted
+	if dc != nil && dc.Spec.Master.Replicas > 0 {
+		if err := controller.GetMasterClient(c.deps.DMMasterControl, dc).DeleteTask(task.GetTaskName()); err != nil {
+			return err
+		}
+		klog.Infof("dm task %s/%s: task %s is deleted", ns, name, task.GetTaskName())
+	}
+
+	task.Finalizers = k8s.RemoveString(task.Finalizers, label.DMTaskProtectionFinalizer, nil)
+	_, err = c.deps.Clientset.PingcapV1alpha1().DMTasks(ns).Update(context.TODO(), task, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("remove dm task %s/%s protection finalizers failed, err: %v", ns, name, err)
+	}
+	return nil
+}
+
+// addProtectionFinalizer adds the finalizer to delete the task from DM when the DMTask is deleted
+func (c *defaultDMTaskControl) addProtectionFinalizer(task *v1alpha1.DMTask) error {
+	ns := task.GetNamespace()
+	name := task.GetName()
+	if k8s.ContainsString(task.Finalizers, label.DMTaskProtectionFinalizer, nil) {
+		return nil
+	}
+
+	task.Finalizers = append(task.Finalizers, label.DMTaskProtectionFinalizer)
+	updated, err := c.deps.Clientset.PingcapV1alpha1().DMTasks(ns).Update(context.TODO(), task, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("add dm task %s/%s protection finalizers failed, err: %v", ns, name, err)
+	}
+	task.ObjectMeta = updated.ObjectMeta
+	return nil
+}
+
+func (c *defaultDMTaskControl) getDMCluster(task *v1alpha1.DMTask) (*v1alpha1.DMCluster, error) {
+	dcNs := task.GetClusterNamespace()
+	dcName := task.Spec.Cluster.Name
+	dc, err := c.deps.DMClusterLister.DMClusters(dcNs).Get(dcName)
+	if err != nil {
+		return nil, fmt.Errorf("get dmcluster %s/%s failed, err: %w", dcNs, dcName, err)
+	}
+	return dc, nil
+}
+
+func (c *defaultDMTaskControl) updateStatus(task *v1alpha1.DMTask) (*v1alpha1.DMTask, error) {
+	var (
+		ns     = task.GetNamespace()
+		name   = task.GetName()
+		status = task.Status.DeepCopy()
+		update *v1alpha1.DMTask
+	)
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var updateErr error
+		update, updateErr = c.deps.Clientset.PingcapV1alpha1().DMTasks(ns).UpdateStatus(context.TODO(), task, metav1.UpdateOptions{})
+		if updateErr == nil {
+			klog.V(4).Infof("DMTask: [%s/%s], update status successfully", ns, name)
+			return nil
+		}
+
+		klog.V(4).Infof("DMTask: [%s/%s], update status failed, error: %v", ns, name, updateErr)
+
+		if updated, err := c.deps.DMTaskLister.DMTasks(ns).Get(name); err == nil {
+			task = updated.DeepCopy()
+			task.Status = *status
+		} else {
+			utilruntime.HandleError(fmt.Errorf("error getting updated DMTask %s/%s from lister: %v", ns, name, err))
+		}
+
+		return updateErr
+	})
+	if err != nil {
+		klog.Errorf("DMTask: [%s/%s], failed to updateStatus, error: %v", ns, name, err)
+	}
+
+	return update, err
+}
+
+// isTaskStopped returns true if none of the subtasks is running or paused by errors
+func isTaskStopped(task *dmapi.Task) bool {
+	for _, st := range task.StatusList {
+		switch v1alpha1.DMTaskStage(st.Stage) {
+		case v1alpha1.DMTaskStageRunning, v1alpha1.DMTaskStagePaused:
+			return false
+		}
+	}
+	return true
+}
+
+// taskStage returns the stage of the subtasks if they are in the same stage, otherwise the paused stage
+// takes precedence as the subtask is paused by errors, and then the running stage.
+func taskStage(subTasks []v1alpha1.DMSubTaskStatus) v1alpha1.DMTaskStage {
+	if len(subTasks) == 0 {
+		return ""
+	}
+	stage := subTasks[0].Stage
+	mixed, hasRunning := false, false
+	for _, st := range subTasks {
+		if st.Stage == v1alpha1.DMTaskStagePaused {
+			return v1alpha1.DMTaskStagePaused
+		}
+		if st.Stage == v1alpha1.DMTaskStageRunning {
+			hasRunning = true
+		}
+		if st.Stage != stage {
+			mixed = true
+		}
+	}
+	if mixed && hasRunning {
+		return v1alpha1.DMTaskStageRunning
+	}
+	return stage
+}
@@ -0,0 +1,191 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmtask
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/dmapi"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDMTaskControlReconcile(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	control := NewDMTaskControl(deps)
+
+	dc := &v1alpha1.DMCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "dc", Namespace: "ns"},
+		Spec: v1alpha1.DMClusterSpec{
+			Master: v1alpha1.MasterSpec{Replicas: 1},
+		},
+	}
+	g.Expect(deps.InformerFactory.Pingcap().V1alpha1().DMClusters().Informer().GetIndexer().Add(dc)).To(Succeed())
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "downstream", Namespace: "ns"},
+		Data:       map[string][]byte{"user": []byte("root")},
+	}
+	g.Expect(deps.KubeInformerFactory.Core().V1().Secrets().Informer().GetIndexer().Add(secret)).To(Succeed())
+
+	task := &v1alpha1.DMTask{
+		ObjectMeta: metav1.ObjectMeta{Name: "task", Namespace: "ns", Generation: 1},
+		Spec: v1alpha1.DMTaskSpec{
+			Cluster:  v1alpha1.ClusterRef{Name: "dc"},
+			TaskMode: v1alpha1.DMTaskModeIncremental,
+			Target: v1alpha1.DMTaskTarget{
+				Host:       "tidb",
+				Port:       4000,
+				SecretName: "downstream",
+			},
+			Sources: []v1alpha1.DMTaskSource{{SourceName: "mysql-01", BinlogGTID: "uuid:1-100"}},
+			TableMigrateRules: []v1alpha1.DMTableMigrateRule{{
+				Source: v1alpha1.DMTableMigrateSource{SourceName: "mysql-01", Schema: "db_*", Table: "*"},
+				Target: &v1alpha1.DMTableMigrateTarget{Schema: "db"},
+			}},
+		},
+	}
+	task, err := deps.Clientset.PingcapV1alpha1().DMTasks("ns").Create(context.TODO(), task, metav1.CreateOptions{})
+	g.Expect(err).To(Succeed())
+
+	var current *dmapi.Task
+	var calls []string
+	setStage := func(stage v1alpha1.DMTaskStage) {
+		current.StatusList = []dmapi.SubTaskStatus{{
+			Name:       "task",
+			SourceName: "mysql-01",
+			WorkerName: "dm-worker-0",
+			Stage:      string(stage),
+			Unit:       "Sync",
+			SyncStatus: &dmapi.SyncStatus{SecondsBehindMaster: 5},
+		}}
+	}
+	masterClient := controller.NewFakeMasterClient(deps.DMMasterControl.(*dmapi.FakeMasterControl), dc)
+	masterClient.AddReaction(dmapi.GetTaskActionType, func(action *dmapi.Action) (interface{}, error) {
+		return current, nil
+	})
+	masterClient.AddReaction(dmapi.CreateTaskActionType, func(action *dmapi.Action) (interface{}, error) {
+		calls = append(calls, "create")
+		current = action.Task
+		setStage(v1alpha1.DMTaskStageStopped)
+		return nil, nil
+	})
+	masterClient.AddReaction(dmapi.UpdateTaskActionType, func(action *dmapi.Action) (interface{}, error) {
+		calls = append(calls, "update")
+		current.TaskMode = action.Task.TaskMode
+		return nil, nil
+	})
+	masterClient.AddReaction(dmapi.StartTaskActionType, func(action *dmapi.Action) (interface{}, error) {
+		calls = append(calls, "start")
+		setStage(v1alpha1.DMTaskStageRunning)
+		return nil, nil
+	})
+	masterClient.AddReaction(dmapi.StopTaskActionType, func(action *dmapi.Action) (interface{}, error) {
+		calls = append(calls, "stop")
+		setStage(v1alpha1.DMTaskStageStopped)
+		return nil, nil
+	})
+	masterClient.AddReaction(dmapi.DeleteTaskActionType, func(action *dmapi.Action) (interface{}, error) {
+		calls = append(calls, "delete")
+		current = nil
+		return nil, nil
+	})
+
+	// create and start the task
+	g.Expect(control.Reconcile(task)).To(Succeed())
+	g.Expect(calls).To(Equal([]string{"create", "start"}))
+	g.Expect(current.TaskMode).To(Equal("incremental"))
+	g.Expect(current.OnDuplicate).To(Equal("overwrite"))
+	g.Expect(current.TargetConfig.User).To(Equal("root"))
+	g.Expect(current.SourceConfig.SourceConf).To(Equal([]dmapi.TaskSourceConf{{SourceName: "mysql-01", BinlogGTID: "uuid:1-100"}}))
+	g.Expect(current.TableMigrateRules).To(HaveLen(1))
+	g.Expect(current.TableMigrateRules[0].Target.Schema).To(Equal("db"))
+	task, err = deps.Clientset.PingcapV1alpha1().DMTasks("ns").Get(context.TODO(), "task", metav1.GetOptions{})
+	g.Expect(err).To(Succeed())
+	g.Expect(task.Finalizers).To(ContainElement(label.DMTaskProtectionFinalizer))
+	g.Expect(task.Status.Stage).To(Equal(v1alpha1.DMTaskStageRunning))
+	g.Expect(task.Status.Lag).To(Equal("5s"))
+	g.Expect(task.Status.SubTasks).To(HaveLen(1))
+	g.Expect(task.Status.Errors).To(BeEmpty())
+
+	// nothing changed
+	calls = nil
+	g.Expect(control.Reconcile(task)).To(Succeed())
+	g.Expect(calls).To(BeEmpty())
+
+	// update the task
+	task.Spec.TaskMode = v1alpha1.DMTaskModeAll
+	task.Generation = 2
+	g.Expect(control.Reconcile(task)).To(Succeed())
+	g.Expect(calls).To(Equal([]string{"stop", "update", "start"}))
+	g.Expect(current.TaskMode).To(Equal("all"))
+	g.Expect(task.Status.ObservedGeneration).To(Equal(int64(2)))
+
+	// the unresolved errors are set to the status
+	current.StatusList[0].Stage = string(v1alpha1.DMTaskStagePaused)
+	current.StatusList[0].ErrorMsg = "duplicate entry"
+	current.StatusList[0].SyncStatus.UnresolvedGroups = []dmapi.ShardingGroup{{Target: "`db`.`t`", Unsynced: []string{"`db_1`.`t`"}}}
+	g.Expect(control.Reconcile(task)).To(Succeed())
+	g.Expect(task.Status.Stage).To(Equal(v1alpha1.DMTaskStagePaused))
+	g.Expect(task.Status.Errors).To(HaveLen(2))
+	g.Expect(task.Status.Errors[0]).To(ContainSubstring("duplicate entry"))
+	g.Expect(task.Status.Errors[1]).To(ContainSubstring("shard DDLs of `db`.`t` are unresolved"))
+
+	// stop the task
+	calls = nil
+	task.Spec.Paused = true
+	g.Expect(control.Reconcile(task)).To(Succeed())
+	g.Expect(calls).To(Equal([]string{"stop"}))
+	g.Expect(task.Status.Stage).To(Equal(v1alpha1.DMTaskStageStopped))
+
+	// delete the task
+	calls = nil
+	now := metav1.Now()
+	task.DeletionTimestamp = &now
+	g.Expect(control.Reconcile(task)).To(Succeed())
+	g.Expect(calls).To(Equal([]string{"delete"}))
+	task, err = deps.Clientset.PingcapV1alpha1().DMTasks("ns").Get(context.TODO(), "task", metav1.GetOptions{})
+	g.Expect(err).To(Succeed())
+	g.Expect(task.Finalizers).NotTo(ContainElement(label.DMTaskProtectionFinalizer))
+}
+
+func TestTaskStage(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cases := []struct {
+		stages []v1alpha1.DMTaskStage
+		expect v1alpha1.DMTaskStage
+	}{
+		{stages: nil, expect: ""},
+		{stages: []v1alpha1.DMTaskStage{"Running", "Running"}, expect: "Running"},
+		{stages: []v1alpha1.DMTaskStage{"Running", "Paused"}, expect: "Paused"},
+		{stages: []v1alpha1.DMTaskStage{"Finished", "Running"}, expect: "Running"},
+		{stages: []v1alpha1.DMTaskStage{"Stopped", "Stopped"}, expect: "Stopped"},
+		{stages: []v1alpha1.DMTaskStage{"Finished", "Finished"}, expect: "Finished"},
+	}
+	for _, c := range cases {
+		var subTasks []v1alpha1.DMSubTaskStatus
+		for _, stage := range c.stages {
+			subTasks = append(subTasks, v1alpha1.DMSubTaskStatus{Stage: stage})
+		}
+		g.Expect(taskStage(subTasks)).To(Equal(c.expect), "stages: %v", c.stages)
+	}
+}
@@ -16,9 +16,11 @@ package controller
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
@@ -49,6 +51,40 @@ type drainCaptureResp struct {
 	CurrentTableCount int `json:"current_table_count"`
 }
 
+// ChangefeedConfig is the config of a changefeed in the TiCDC open API
+type ChangefeedConfig struct {
+	ID            string                   `json:"changefeed_id,omitempty"`
+	SinkURI       string                   `json:"sink_uri,omitempty"`
+	StartTs       uint64                   `json:"start_ts,omitempty"`
+	TargetTs      uint64                   `json:"target_ts,omitempty"`
+	ReplicaConfig *ChangefeedReplicaConfig `json:"replica_config,omitempty"`
+}
+
+// ChangefeedReplicaConfig is the replica config of a changefeed in the TiCDC open API
+type ChangefeedReplicaConfig struct {
+	Filter *ChangefeedFilterConfig `json:"filter,omitempty"`
+}
+
+// ChangefeedFilterConfig is the filter config of a changefeed in the TiCDC open API
+type ChangefeedFilterConfig struct {
+	Rules            []string `json:"rules,omitempty"`
+	IgnoreTxnStartTs []uint64 `json:"ignore_txn_start_ts,omitempty"`
+}
+
+// ChangefeedInfo is the changefeed info returned by the TiCDC open API
+type ChangefeedInfo struct {
+	ID           string           `json:"id"`
+	State        string           `json:"state"`
+	CheckpointTs uint64           `json:"checkpoint_ts"`
+	Error        *ChangefeedError `json:"error,omitempty"`
+}
+
+// ChangefeedError is the error of a changefeed returned by the TiCDC open API
+type ChangefeedError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
 // TiCDCControlInterface is the interface that knows how to manage ticdc captures
 type TiCDCControlInterface interface {
 	// GetStatus returns ticdc's status
@@ -65,6 +101,19 @@ type TiCDCControlInterface interface {
 	// IsHealthy gets the healthy status of TiCDC cluster.
 	// Returns true if the TiCDC cluster is heathy.
 	IsHealthy(tc *v1alpha1.TidbCluster, ordinal int32) (ok bool, err error)
+	// GetChangefeed gets the changefeed through the TiCDC open API.
+	// Returns nil if the changefeed does not exist.
+	GetChangefeed(tc *v1alpha1.TidbCluster, id string) (*ChangefeedInfo, error)
+	// CreateChangefeed creates a changefeed through the TiCDC open API.
+	CreateChangefeed(tc *v1alpha1.TidbCluster, config *ChangefeedConfig) error
+	// UpdateChangefeed updates a stopped changefeed through the TiCDC open API.
+	UpdateChangefeed(tc *v1alpha1.TidbCluster, config *ChangefeedConfig) error
+	// PauseChangefeed pauses a changefeed through the TiCDC open API.
+	PauseChangefeed(tc *v1alpha1.TidbCluster, id string) error
+	// ResumeChangefeed resumes a stopped changefeed through the TiCDC open API.
+	ResumeChangefeed(tc *v1alpha1.TidbCluster, id string) error
+	// RemoveChangefeed removes a changefeed through the TiCDC open API.
+	RemoveChangefeed(tc *v1alpha1.TidbCluster, id string) error
 }
 
 // defaultTiCDCControl is default implementation of TiCDCControlInterface.
@@ -269,6 +318,128 @@ func (c *defaultTiCDCControl) IsHealthy(tc *v1alpha1.TidbCluster, ordinal int32)
 	return true, nil
 }
 
+// changefeedNotExistsErrCode is the error code returned by the TiCDC open API if the changefeed does not exist
+const changefeedNotExistsErrCode = "CDC:ErrChangeFeedNotExists"
+
+// ChangefeedAPIError is the error returned by the TiCDC open API with an error response
+type ChangefeedAPIError struct {
+	StatusCode int    `json:"-"`
+	URL        string `json:"-"`
+	ErrorCode  string `json:"error_code"`
+	ErrorMsg   string `json:"error_msg"`
+}
+
+func (e *ChangefeedAPIError) Error() string {
+	return fmt.Sprintf("Error response %d URL %s, error code: %s, error msg: %s", e.StatusCode, e.URL, e.ErrorCode, e.ErrorMsg)
+}
+
+// isChangefeedNotExist returns true if the error is returned by the TiCDC open API because the changefeed does not exist
+func isChangefeedNotExist(err error) bool {
+	var apiErr *ChangefeedAPIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.ErrorCode == changefeedNotExistsErrCode
+}
+
+// changefeedURL returns the url of the changefeed in the TiCDC open API, the request is sent to the first
+// capture and forwarded to the owner by TiCDC.
+func (c *defaultTiCDCControl) changefeedURL(tc *v1alpha1.TidbCluster, id string, action string) string {
+	apiURL := fmt.Sprintf("%s/api/v2/changefeeds", c.getBaseURL(tc, 0))
+	if id != "" {
+		apiURL = fmt.Sprintf("%s/%s", apiURL, url.PathEscape(id))
+	}
+	if action != "" {
+		apiURL = fmt.Sprintf("%s/%s", apiURL, action)
+	}
+	return apiURL
+}
+
+func (c *defaultTiCDCControl) GetChangefeed(tc *v1alpha1.TidbCluster, id string) (*ChangefeedInfo, error) {
+	body, err := c.doChangefeedRequest(tc, http.MethodGet, c.changefeedURL(tc, id, ""), nil)
+	if err != nil {
+		if isChangefeedNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	info := &ChangefeedInfo{}
+	if err := json.Unmarshal(body, info); err != nil {
+		return nil, fmt.Errorf("ticdc get changefeed %s failed, unmarshal response error: %v", id, err)
+	}
+	return info, nil
+}
+
+func (c *defaultTiCDCControl) CreateChangefeed(tc *v1alpha1.TidbCluster, config *ChangefeedConfig) error {
+	_, err := c.doChangefeedRequest(tc, http.MethodPost, c.changefeedURL(tc, "", ""), config)
+	return err
+}
+
+func (c *defaultTiCDCControl) UpdateChangefeed(tc *v1alpha1.TidbCluster, config *ChangefeedConfig) error {
+	// the changefeed id is in the url when updating
+	payload := *config
+	payload.ID = ""
+	_, err := c.doChangefeedRequest(tc, http.MethodPut, c.changefeedURL(tc, config.ID, ""), &payload)
+	return err
+}
+
+func (c *defaultTiCDCControl) PauseChangefeed(tc *v1alpha1.TidbCluster, id string) error {
+	_, err := c.doChangefeedRequest(tc, http.MethodPost, c.changefeedURL(tc, id, "pause"), nil)
+	return err
+}
+
+func (c *defaultTiCDCControl) ResumeChangefeed(tc *v1alpha1.TidbCluster, id string) error {
+	_, err := c.doChangefeedRequest(tc, http.MethodPost, c.changefeedURL(tc, id, "resume"), struct{}{})
+	return err
+}
+
+func (c *defaultTiCDCControl) RemoveChangefeed(tc *v1alpha1.TidbCluster, id string) error {
+	_, err := c.doChangefeedRequest(tc, http.MethodDelete, c.changefeedURL(tc, id, ""), nil)
+	if isChangefeedNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// doChangefeedRequest sends the request to the TiCDC open API and returns the response body,
+// ChangefeedAPIError is returned if TiCDC responds with an error code
+func (c *defaultTiCDCControl) doChangefeedRequest(tc *v1alpha1.TidbCluster, method, apiURL string, payload interface{}) ([]byte, error) {
+	httpClient, err := c.getHTTPClient(tc)
+	if err != nil {
+		return nil, err
+	}
+
+	var reqBody io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("ticdc changefeed request failed, marshal request error: %v", err)
+		}
+		reqBody = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, apiURL, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("ticdc changefeed request %s %s failed, error: %v", method, apiURL, err)
+	}
+	defer httputil.DeferClose(res.Body)
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("ticdc changefeed request %s %s failed, read response error: %v", method, apiURL, err)
+	}
+	if res.StatusCode >= 400 {
+		apiErr := &ChangefeedAPIError{StatusCode: res.StatusCode, URL: apiURL}
+		if err := json.Unmarshal(body, apiErr); err != nil || apiErr.ErrorCode == "" {
+			return nil, fmt.Errorf("ticdc changefeed request %s %s failed, error response %d, body response: %s", method, apiURL, res.StatusCode, string(body))
+		}
+		return nil, apiErr
+	}
+	return body, nil
+}
+
 func (c *defaultTiCDCControl) getBaseURL(tc *v1alpha1.TidbCluster, ordinal int32) string {
 	if c.testURL != "" {
 		return c.testURL
@@ -347,6 +518,13 @@ type FakeTiCDCControl struct {
 	DrainCaptureFn func(tc *v1alpha1.TidbCluster, ordinal int32) (tableCount int, retry bool, err error)
 	ResignOwnerFn  func(tc *v1alpha1.TidbCluster, ordinal int32) (ok bool, err error)
 	IsHealthyFn    func(tc *v1alpha1.TidbCluster, ordinal int32) (ok bool, err error)
+
+	GetChangefeedFn    func(tc *v1alpha1.TidbCluster, id string) (*ChangefeedInfo, error)
+	CreateChangefeedFn func(tc *v1alpha1.TidbCluster, config *ChangefeedConfig) error
+	UpdateChangefeedFn func(tc *v1alpha1.TidbCluster, config *ChangefeedConfig) error
+	PauseChangefeedFn  func(tc *v1alpha1.TidbCluster, id string) error
+	ResumeChangefeedFn func(tc *v1alpha1.TidbCluster, id string) error
+	RemoveChangefeedFn func(tc *v1alpha1.TidbCluster, id string) error
 }
 
 // NewFakeTiCDCControl returns a FakeTiCDCControl instance
@@ -381,3 +559,45 @@ func (c *FakeTiCDCControl) IsHealthy(tc *v1alpha1.TidbCluster, ordinal int32) (b
 	}
 	return c.IsHealthyFn(tc, ordinal)
 }
+
+func (c *FakeTiCDCControl) GetChangefeed(tc *v1alpha1.TidbCluster, id string) (*ChangefeedInfo, error) {
+	if c.GetChangefeedFn == nil {
+		return nil, fmt.Errorf("undefined GetChangefeed")
+	}
+	return c.GetChangefeedFn(tc, id)
+}
+
+func (c *FakeTiCDCControl) CreateChangefeed(tc *v1alpha1.TidbCluster, config *ChangefeedConfig) error {
+	if c.CreateChangefeedFn == nil {
+		return fmt.Errorf("undefined CreateChangefeed")
+	}
+	return c.CreateChangefeedFn(tc, config)
+}
+
+func (c *FakeTiCDCControl) UpdateChangefeed(tc *v1alpha1.TidbCluster, config *ChangefeedConfig) error {
+	if c.UpdateChangefeedFn == nil {
+		return fmt.Errorf("undefined UpdateChangefeed")
+	}
+	return c.UpdateChangefeedFn(tc, config)
+}
+
+func (c *FakeTiCDCControl) PauseChangefeed(tc *v1alpha1.TidbCluster, id string) error {
+	if c.PauseChangefeedFn == nil {
+		return fmt.Errorf("undefined PauseChangefeed")
+	}
+	return c.PauseChangefeedFn(tc, id)
+}
+
+func (c *FakeTiCDCControl) ResumeChangefeed(tc *v1alpha1.TidbCluster, id string) error {
+	if c.ResumeChangefeedFn == nil {
+		return fmt.Errorf("undefined ResumeChangefeed")
+	}
+	return c.ResumeChangefeedFn(tc, id)
+}
+
+func (c *FakeTiCDCControl) RemoveChangefeed(tc *v1alpha1.TidbCluster, id string) error {
+	if c.RemoveChangefeedFn == nil {
+		return fmt.Errorf("undefined RemoveChangefeed")
+	}
+	return c.RemoveChangefeedFn(tc, id)
+}
@@ -15,6 +15,7 @@ package controller
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -594,3 +595,88 @@ func TestTiCDCControllerIsHealthy(t *testing.T) {
 		svr.Close()
 	}
 }
+
+func TestTiCDCControllerChangefeed(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cdc := defaultTiCDCControl{}
+	tc := getTidbCluster()
+
+	var requests []string
+	var config ChangefeedConfig
+	mux := http.NewServeMux()
+	svr := httptest.NewServer(mux)
+	defer svr.Close()
+	mux.HandleFunc("/api/v2/changefeeds", func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		body, err := io.ReadAll(req.Body)
+		g.Expect(err).Should(BeNil())
+		g.Expect(json.Unmarshal(body, &config)).Should(Succeed())
+	})
+	mux.HandleFunc("/api/v2/changefeeds/", func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		switch req.URL.Path {
+		case "/api/v2/changefeeds/not-exist":
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error_msg":"changefeed not exists","error_code":"CDC:ErrChangeFeedNotExists"}`)
+		case "/api/v2/changefeeds/failed":
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"error_msg":"get ErrChangeFeedNotExists of etcd failed","error_code":"CDC:ErrPDEtcdAPIError"}`)
+		case "/api/v2/changefeeds/unknown":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, "404 page not found")
+		case "/api/v2/changefeeds/cf":
+			if req.Method == http.MethodGet {
+				fmt.Fprint(w, `{"id":"cf","state":"normal","checkpoint_ts":446267361755037697}`)
+			}
+		}
+	})
+	cdc.testURL = svr.URL
+
+	info, err := cdc.GetChangefeed(tc, "not-exist")
+	g.Expect(err).Should(BeNil())
+	g.Expect(info).Should(BeNil())
+	g.Expect(cdc.RemoveChangefeed(tc, "not-exist")).Should(Succeed())
+
+	// only the error code of the response is matched
+	_, err = cdc.GetChangefeed(tc, "failed")
+	var apiErr *ChangefeedAPIError
+	g.Expect(errors.As(err, &apiErr)).Should(BeTrue())
+	g.Expect(apiErr.ErrorCode).Should(Equal("CDC:ErrPDEtcdAPIError"))
+	_, err = cdc.GetChangefeed(tc, "unknown")
+	g.Expect(err).ShouldNot(BeNil())
+	g.Expect(errors.As(err, &apiErr)).Should(BeFalse())
+
+	info, err = cdc.GetChangefeed(tc, "cf")
+	g.Expect(err).Should(BeNil())
+	g.Expect(info.State).Should(Equal("normal"))
+	g.Expect(info.CheckpointTs).Should(Equal(uint64(446267361755037697)))
+
+	err = cdc.CreateChangefeed(tc, &ChangefeedConfig{
+		ID:      "cf",
+		SinkURI: "blackhole://",
+		ReplicaConfig: &ChangefeedReplicaConfig{
+			Filter: &ChangefeedFilterConfig{Rules: []string{"test.*"}},
+		},
+	})
+	g.Expect(err).Should(BeNil())
+	g.Expect(config.ID).Should(Equal("cf"))
+	g.Expect(config.ReplicaConfig.Filter.Rules).Should(ConsistOf("test.*"))
+
+	g.Expect(cdc.UpdateChangefeed(tc, &ChangefeedConfig{ID: "cf", SinkURI: "blackhole://"})).Should(Succeed())
+	g.Expect(cdc.PauseChangefeed(tc, "cf")).Should(Succeed())
+	g.Expect(cdc.ResumeChangefeed(tc, "cf")).Should(Succeed())
+	g.Expect(cdc.RemoveChangefeed(tc, "cf")).Should(Succeed())
+	g.Expect(requests).Should(Equal([]string{
+		"GET /api/v2/changefeeds/not-exist",
+		"DELETE /api/v2/changefeeds/not-exist",
+		"GET /api/v2/changefeeds/failed",
+		"GET /api/v2/changefeeds/unknown",
+		"GET /api/v2/changefeeds/cf",
+		"POST /api/v2/changefeeds",
+		"PUT /api/v2/changefeeds/cf",
+		"POST /api/v2/changefeeds/cf/pause",
+		"POST /api/v2/changefeeds/cf/resume",
+		"DELETE /api/v2/changefeeds/cf",
+	}))
+}
@@ -0,0 +1,341 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ticdcchangefeed
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/third_party/k8s"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// sinkUserKey and sinkPasswordKey are the keys of the sink credentials in the secret
+	sinkUserKey     = "user"
+	sinkPasswordKey = "password"
+
+	// physicalShiftBits is the number of the logical bits of a TSO
+	physicalShiftBits = 18
+)
+
+// ControlInterface abstracts the business logic for TiCDCChangefeed reconciliation.
+type ControlInterface interface {
+	Reconcile(*v1alpha1.TiCDCChangefeed) error
+}
+
+func NewTiCDCChangefeedControl(deps *controller.Dependencies) ControlInterface {
+	return &defaultTiCDCChangefeedControl{
+		deps: deps,
+	}
+}
+
+type defaultTiCDCChangefeedControl struct {
+	deps *controller.Dependencies
+}
+
+func (c *defaultTiCDCChangefeedControl) Reconcile(cf *v1alpha1.TiCDCChangefeed) error {
+	if cf.DeletionTimestamp != nil {
+		return c.removeChangefeed(cf)
+	}
+
+	if err := c.addProtectionFinalizer(cf); err != nil {
+		return err
+	}
+
+	oldStatus := cf.Status.DeepCopy()
+	err := c.syncChangefeed(cf)
+	if err != nil {
+		cf.Status.Error = err.Error()
+	}
+
+	if !apiequality.Semantic.DeepEqual(&cf.Status, oldStatus) {
+		now := metav1.Now()
+		cf.Status.LastUpdateTime = &now
+		if _, updateErr := c.updateStatus(cf); updateErr != nil {
+			return updateErr
+		}
+	}
+	// the checkpoint and the lag of the changefeed are refreshed when the informer resyncs
+	return err
+}
+
+// syncChangefeed creates, updates, pauses or resumes the changefeed according to the spec,
+// and refreshes the status from TiCDC.
+func (c *defaultTiCDCChangefeedControl) syncChangefeed(cf *v1alpha1.TiCDCChangefeed) error {
+	ns := cf.GetNamespace()
+	name := cf.GetName()
+	id := cf.GetChangefeedID()
+
+	tc, err := c.getTidbCluster(cf)
+	if err != nil {
+		return err
+	}
+	if tc.Spec.TiCDC == nil || tc.TiCDCDeployDesiredReplicas() == 0 {
+		return fmt.Errorf("tidbcluster %s/%s has no ticdc captures", tc.Namespace, tc.Name)
+	}
+
+	config, secretHash, err := c.changefeedConfig(cf)
+	if err != nil {
+		return err
+	}
+
+	cdc := c.deps.CDCControl
+	info, err := cdc.GetChangefeed(tc, id)
+	if err != nil {
+		return err
+	}
+	if info == nil {
+		klog.Infof("ticdc changefeed %s/%s: create changefeed %s", ns, name, id)
+		if err := cdc.CreateChangefeed(tc, config); err != nil {
+			return err
+		}
+		if cf.Spec.Paused {
+			if err := cdc.PauseChangefeed(tc, id); err != nil {
+				return err
+			}
+		}
+		cf.Status.ObservedGeneration = cf.Generation
+		cf.Status.SinkSecretHash = secretHash
+		return c.refreshStatus(cf, tc)
+	}
+
+	stopped := info.State == string(v1alpha1.TiCDCChangefeedStateStopped)
+	if cf.Status.ObservedGeneration != cf.Generation || cf.Status.SinkSecretHash != secretHash {
+		// a changefeed can only be updated when it is stopped
+		klog.Infof("ticdc changefeed %s/%s: update changefeed %s", ns, name, id)
+		if !stopped {
+			if err := cdc.PauseChangefeed(tc, id); err != nil {
+				return err
+			}
+			stopped = true
+		}
+		// the start ts can't be updated
+		config.StartTs = 0
+		if err := cdc.UpdateChangefeed(tc, config); err != nil {
+			return err
+		}
+		cf.Status.ObservedGeneration = cf.Generation
+		cf.Status.SinkSecretHash = secretHash
+	}
+
+	switch {
+	case cf.Spec.Paused && !stopped && isChangefeedRunning(info.State):
+		klog.Infof("ticdc changefeed %s/%s: pause changefeed %s", ns, name, id)
+		if err := cdc.PauseChangefeed(tc, id); err != nil {
+			return err
+		}
+	case !cf.Spec.Paused && stopped:
+		klog.Infof("ticdc changefeed %s/%s: resume changefeed %s", ns, name, id)
+		if err := cdc.ResumeChangefeed(tc, id); err != nil {
+			return err
+		}
+	}
+
+	return c.refreshStatus(cf, tc)
+}
+
+// refreshStatus sets the state, checkpoint and error of the changefeed reported by TiCDC to the status
+func (c *defaultTiCDCChangefeedControl) refreshStatus(cf *v1alpha1.TiCDCChangefeed, tc *v1alpha1.TidbCluster) error {
+	info, err := c.deps.CDCControl.GetChangefeed(tc, cf.GetChangefeedID())
+	if err != nil {
+		return err
+	}
+	if info == nil {
+		return fmt.Errorf("changefeed %s not found in ticdc", cf.GetChangefeedID())
+	}
+
+	cf.Status.State = v1alpha1.TiCDCChangefeedState(info.State)
+	cf.Status.Error = ""
+	if info.Error != nil {
+		cf.Status.Error = fmt.Sprintf("%s: %s", info.Error.Code, info.Error.Message)
+	}
+	if info.CheckpointTs != cf.Status.CheckpointTs {
+		cf.Status.CheckpointTs = info.CheckpointTs
+		checkpointTime := metav1.NewTime(tsoToTime(info.CheckpointTs))
+		cf.Status.CheckpointTime = &checkpointTime
+	}
+	if cf.Status.CheckpointTime != nil {
+		cf.Status.Lag = time.Since(cf.Status.CheckpointTime.Time).Round(time.Second).String()
+	}
+	return nil
+}
+
+// removeChangefeed removes the changefeed from TiCDC and the protection finalizer from the TiCDCChangefeed
+func (c *defaultTiCDCChangefeedControl) removeChangefeed(cf *v1alpha1.TiCDCChangefeed) error {
+	ns := cf.GetNamespace()
+	name := cf.GetName()
+	if !k8s.ContainsString(cf.Finalizers, label.TiCDCChangefeedProtectionFinalizer, nil) {
+		return nil
+	}
+
+	tc, err := c.getTidbCluster(cf)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	// the changefeed is removed with the ticdc captures if the tidbcluster is deleted
+	if tc != nil && tc.Spec.TiCDC != nil && tc.TiCDCDeployDesiredReplicas() > 0 {
+		if err := c.deps.CDCControl.RemoveChangefeed(tc, cf.GetChangefeedID()); err != nil {
+			return err
+		}
+		klog.Infof("ticdc changefeed %s/%s: changefeed %s is removed", ns, name, cf.GetChangefeedID())
+	}
+
+	cf.Finalizers = k8s.RemoveString(cf.Finalizers, label.TiCDCChangefeedProtectionFinalizer, nil)
+	_, err = c.deps.Clientset.PingcapV1alpha1().TiCDCChangefeeds(ns).Update(context.TODO(), cf, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("remove ticdc changefeed %s/%s protection finalizers failed, err: %v", ns, name, err)
+	}
+	return nil
+}
+
+// addProtectionFinalizer adds the finalizer to remove the changefeed from TiCDC when the TiCDCChangefeed is deleted
+func (c *defaultTiCDCChangefeedControl) addProtectionFinalizer(cf *v1alpha1.TiCDCChangefeed) error {
+	ns := cf.GetNamespace()
+	name := cf.GetName()
+	if k8s.ContainsString(cf.Finalizers, label.TiCDCChangefeedProtectionFinalizer, nil) {
+		return nil
+	}
+
+	cf.Finalizers = append(cf.Finalizers, label.TiCDCChangefeedProtectionFinalizer)
+	updated, err := c.deps.Clientset.PingcapV1alpha1().TiCDCChangefeeds(ns).Update(context.TODO(), cf, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("add ticdc changefeed %s/%s protection finalizers failed, err: %v", ns, name, err)
+	}
+	cf.ObjectMeta = updated.ObjectMeta
+	return nil
+}
+
+func (c *defaultTiCDCChangefeedControl) getTidbCluster(cf *v1alpha1.TiCDCChangefeed) (*v1alpha1.TidbCluster, error) {
+	tcNs := cf.GetClusterNamespace()
+	tcName := cf.Spec.Cluster.Name
+	tc, err := c.deps.TiDBClusterLister.TidbClusters(tcNs).Get(tcName)
+	if err != nil {
+		return nil, fmt.Errorf("get tidbcluster %s/%s failed, err: %w", tcNs, tcName, err)
+	}
+	return tc, nil
+}
+
+// changefeedConfig converts the spec to the changefeed config of the TiCDC open API,
+// the hash of the credentials in the sink secret is returned too
+func (c *defaultTiCDCChangefeedControl) changefeedConfig(cf *v1alpha1.TiCDCChangefeed) (*controller.ChangefeedConfig, string, error) {
+	sinkURI, secretHash, err := c.sinkURI(cf)
+	if err != nil {
+		return nil, "", err
+	}
+
+	config := &controller.ChangefeedConfig{
+		ID:       cf.GetChangefeedID(),
+		SinkURI:  sinkURI,
+		StartTs:  cf.Spec.StartTs,
+		TargetTs: cf.Spec.TargetTs,
+	}
+	if cf.Spec.Filter != nil {
+		config.ReplicaConfig = &controller.ChangefeedReplicaConfig{
+			Filter: &controller.ChangefeedFilterConfig{
+				Rules:            cf.Spec.Filter.Rules,
+				IgnoreTxnStartTs: cf.Spec.Filter.IgnoreTxnStartTs,
+			},
+		}
+	}
+	return config, secretHash, nil
+}
+
+// sinkURI returns the sink URI with the user and password in the sink secret, and the hash of them.
+// The hash is empty if the sink secret is not set.
+func (c *defaultTiCDCChangefeedControl) sinkURI(cf *v1alpha1.TiCDCChangefeed) (string, string, error) {
+	if cf.Spec.SinkSecretName == "" {
+		return cf.Spec.SinkURI, "", nil
+	}
+
+	ns := cf.GetNamespace()
+	secret, err := c.deps.SecretLister.Secrets(ns).Get(cf.Spec.SinkSecretName)
+	if err != nil {
+		return "", "", fmt.Errorf("get sink secret %s/%s failed, err: %v", ns, cf.Spec.SinkSecretName, err)
+	}
+	u, err := url.Parse(cf.Spec.SinkURI)
+	if err != nil {
+		return "", "", fmt.Errorf("parse sink uri of ticdc changefeed %s/%s failed, err: %v", ns, cf.Name, err)
+	}
+	user := string(secret.Data[sinkUserKey])
+	if user == "" {
+		return "", "", fmt.Errorf("key %s not found in sink secret %s/%s", sinkUserKey, ns, cf.Spec.SinkSecretName)
+	}
+	if password, ok := secret.Data[sinkPasswordKey]; ok {
+		u.User = url.UserPassword(user, string(password))
+	} else {
+		u.User = url.User(user)
+	}
+	return u.String(), fmt.Sprintf("%x", sha256.Sum256([]byte(u.User.String()))), nil
+}
+
+func (c *defaultTiCDCChangefeedControl) updateStatus(cf *v1alpha1.TiCDCChangefeed) (*v1alpha1.TiCDCChangefeed, error) {
+	var (
+		ns     = cf.GetNamespace()
+		name   = cf.GetName()
+		status = cf.Status.DeepCopy()
+		update *v1alpha1.TiCDCChangefeed
+	)
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var updateErr error
+		update, updateErr = c.deps.Clientset.PingcapV1alpha1().TiCDCChangefeeds(ns).UpdateStatus(context.TODO(), cf, metav1.UpdateOptions{})
+		if updateErr == nil {
+			klog.V(4).Infof("TiCDCChangefeed: [%s/%s], update status successfully", ns, name)
+			return nil
+		}
+
+		klog.V(4).Infof("TiCDCChangefeed: [%s/%s], update status failed, error: %v", ns, name, updateErr)
+
+		if updated, err := c.deps.TiCDCChangefeedLister.TiCDCChangefeeds(ns).Get(name); err == nil {
+			cf = updated.DeepCopy()
+			cf.Status = *status
+		} else {
+			utilruntime.HandleError(fmt.Errorf("error getting updated TiCDCChangefeed %s/%s from lister: %v", ns, name, err))
+		}
+
+		return updateErr
+	})
+	if err != nil {
+		klog.Errorf("TiCDCChangefeed: [%s/%s], failed to updateStatus, error: %v", ns, name, err)
+	}
+
+	return update, err
+}
+
+// isChangefeedRunning returns whether the changefeed can be paused
+func isChangefeedRunning(state string) bool {
+	switch v1alpha1.TiCDCChangefeedState(state) {
+	case v1alpha1.TiCDCChangefeedStateNormal, v1alpha1.TiCDCChangefeedStateWarning, v1alpha1.TiCDCChangefeedStateError:
+		return true
+	}
+	return false
+}
+
+// tsoToTime returns the physical time of the TSO
+func tsoToTime(ts uint64) time.Time {
+	return time.UnixMilli(int64(ts >> physicalShiftBits))
+}
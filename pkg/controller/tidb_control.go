@@ -19,10 +19,12 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	httputil "github.com/pingcap/tidb-operator/pkg/util/http"
+	"github.com/prometheus/common/expfmt"
 	corelisterv1 "k8s.io/client-go/listers/core/v1"
 )
 
@@ -31,12 +33,21 @@ const (
 	// NotDDLOwnerError is the error message which was returned when the tidb node is not a ddl owner
 	NotDDLOwnerError = "This node is not a ddl owner, can't be resigned."
 	timeout          = 5 * time.Second
+
+	metricNameQueryTotal = "tidb_server_query_total"
 )
 
 type DBInfo struct {
 	IsOwner bool `json:"is_owner"`
 }
 
+// ServerStatus is the status returned by the status port of tidb
+type ServerStatus struct {
+	Connections int    `json:"connections"`
+	Version     string `json:"version"`
+	GitHash     string `json:"git_hash"`
+}
+
 // TiDBControlInterface is the interface that knows how to manage tidb peers
 type TiDBControlInterface interface {
 	// GetHealth returns tidb's health info
@@ -45,6 +56,15 @@ type TiDBControlInterface interface {
 	GetInfo(tc *v1alpha1.TidbCluster, ordinal int32) (*DBInfo, error)
 	// SetServerLabels update TiDB's labels config
 	SetServerLabels(tc *v1alpha1.TidbCluster, ordinal int32, labels map[string]string) error
+	// GetStatus returns tidb's server status, including the SQL connection count
+	GetStatus(tc *v1alpha1.TidbCluster, ordinal int32) (*ServerStatus, error)
+	// GetQueryTotal returns the total number of queries handled by tidb since it started
+	GetQueryTotal(tc *v1alpha1.TidbCluster, ordinal int32) (float64, error)
+	// SetSettings changes the settings that TiDB supports changing online through the status API,
+	// the keys of settings are the form parameters of the `/settings` API such as `log_level`
+	SetSettings(tc *v1alpha1.TidbCluster, ordinal int32, settings map[string]string) error
+	// GetConfig returns tidb's running config
+	GetConfig(tc *v1alpha1.TidbCluster, ordinal int32) (map[string]interface{}, error)
 }
 
 // defaultTiDBControl is default implementation of TiDBControlInterface.
@@ -121,6 +141,94 @@ func (c *defaultTiDBControl) SetServerLabels(tc *v1alpha1.TidbCluster, ordinal i
 	return err
 }
 
+func (c *defaultTiDBControl) GetStatus(tc *v1alpha1.TidbCluster, ordinal int32) (*ServerStatus, error) {
+	httpClient, err := c.getHTTPClient(tc)
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%s/status", c.getBaseURL(tc, ordinal))
+	body, err := getBodyOK(httpClient, url)
+	if err != nil {
+		return nil, err
+	}
+	status := &ServerStatus{}
+	if err := json.Unmarshal(body, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+func (c *defaultTiDBControl) GetQueryTotal(tc *v1alpha1.TidbCluster, ordinal int32) (float64, error) {
+	httpClient, err := c.getHTTPClient(tc)
+	if err != nil {
+		return 0, err
+	}
+
+	url := fmt.Sprintf("%s/metrics", c.getBaseURL(tc, ordinal))
+	body, err := getBodyOK(httpClient, url)
+	if err != nil {
+		return 0, err
+	}
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	family, ok := families[metricNameQueryTotal]
+	if !ok {
+		return 0, fmt.Errorf("metric %s not found for %s", metricNameQueryTotal, url)
+	}
+	var total float64
+	for _, m := range family.GetMetric() {
+		total += m.GetCounter().GetValue()
+	}
+	return total, nil
+}
+
+// SetSettings changes TiDB's online settings
+func (c *defaultTiDBControl) SetSettings(tc *v1alpha1.TidbCluster, ordinal int32, settings map[string]string) error {
+	httpClient, err := c.getHTTPClient(tc)
+	if err != nil {
+		return err
+	}
+
+	form := url.Values{}
+	for k, v := range settings {
+		form.Set(k, v)
+	}
+
+	apiURL := fmt.Sprintf("%s/settings", c.getBaseURL(tc, ordinal))
+	res, err := httpClient.PostForm(apiURL, form)
+	if err != nil {
+		return err
+	}
+	defer httputil.DeferClose(res.Body)
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("Error response %s:%v URL %s", string(body), res.StatusCode, apiURL)
+	}
+	return nil
+}
+
+func (c *defaultTiDBControl) GetConfig(tc *v1alpha1.TidbCluster, ordinal int32) (map[string]interface{}, error) {
+	httpClient, err := c.getHTTPClient(tc)
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%s/config", c.getBaseURL(tc, ordinal))
+	body, err := getBodyOK(httpClient, url)
+	if err != nil {
+		return nil, err
+	}
+	config := map[string]interface{}{}
+	if err := json.Unmarshal(body, &config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
 func getBodyOK(httpClient *http.Client, apiURL string) ([]byte, error) {
 	res, err := httpClient.Get(apiURL)
 	if err != nil {
@@ -162,6 +270,11 @@ type FakeTiDBControl struct {
 	tiDBInfo       *DBInfo
 	getInfoError   error
 	setLabelsError error
+	serverStatus   map[string]*ServerStatus
+	queryTotal     map[string]float64
+	settings       map[string]map[string]string
+	settingsError  error
+	config         map[string]map[string]interface{}
 }
 
 // NewFakeTiDBControl returns a FakeTiDBControl instance
@@ -178,6 +291,31 @@ func (c *FakeTiDBControl) SetLabelsErr(err error) {
 	c.setLabelsError = err
 }
 
+// SetServerStatus set server status for FakeTiDBControl
+func (c *FakeTiDBControl) SetServerStatus(serverStatus map[string]*ServerStatus) {
+	c.serverStatus = serverStatus
+}
+
+// SetQueryTotal set query total for FakeTiDBControl
+func (c *FakeTiDBControl) SetQueryTotal(queryTotal map[string]float64) {
+	c.queryTotal = queryTotal
+}
+
+// SetConfig set running config for FakeTiDBControl
+func (c *FakeTiDBControl) SetConfig(config map[string]map[string]interface{}) {
+	c.config = config
+}
+
+// SetSettingsErr sets the error returned by SetSettings
+func (c *FakeTiDBControl) SetSettingsErr(err error) {
+	c.settingsError = err
+}
+
+// GetSettings returns the settings applied to the pod by SetSettings
+func (c *FakeTiDBControl) GetSettings(podName string) map[string]string {
+	return c.settings[podName]
+}
+
 func (c *FakeTiDBControl) GetHealth(tc *v1alpha1.TidbCluster, ordinal int32) (bool, error) {
 	podName := fmt.Sprintf("%s-%d", TiDBMemberName(tc.GetName()), ordinal)
 	if c.healthInfo == nil {
@@ -196,3 +334,44 @@ func (c *FakeTiDBControl) GetInfo(tc *v1alpha1.TidbCluster, ordinal int32) (*DBI
 func (c *FakeTiDBControl) SetServerLabels(tc *v1alpha1.TidbCluster, ordinal int32, labels map[string]string) error {
 	return c.setLabelsError
 }
+
+func (c *FakeTiDBControl) GetStatus(tc *v1alpha1.TidbCluster, ordinal int32) (*ServerStatus, error) {
+	podName := fmt.Sprintf("%s-%d", TiDBMemberName(tc.GetName()), ordinal)
+	if status, ok := c.serverStatus[podName]; ok {
+		return status, nil
+	}
+	return nil, fmt.Errorf("status of %s not found", podName)
+}
+
+func (c *FakeTiDBControl) GetQueryTotal(tc *v1alpha1.TidbCluster, ordinal int32) (float64, error) {
+	podName := fmt.Sprintf("%s-%d", TiDBMemberName(tc.GetName()), ordinal)
+	if total, ok := c.queryTotal[podName]; ok {
+		return total, nil
+	}
+	return 0, fmt.Errorf("query total of %s not found", podName)
+}
+
+func (c *FakeTiDBControl) SetSettings(tc *v1alpha1.TidbCluster, ordinal int32, settings map[string]string) error {
+	if c.settingsError != nil {
+		return c.settingsError
+	}
+	podName := fmt.Sprintf("%s-%d", TiDBMemberName(tc.GetName()), ordinal)
+	if c.settings == nil {
+		c.settings = map[string]map[string]string{}
+	}
+	if c.settings[podName] == nil {
+		c.settings[podName] = map[string]string{}
+	}
+	for k, v := range settings {
+		c.settings[podName][k] = v
+	}
+	return nil
+}
+
+func (c *FakeTiDBControl) GetConfig(tc *v1alpha1.TidbCluster, ordinal int32) (map[string]interface{}, error) {
+	podName := fmt.Sprintf("%s-%d", TiDBMemberName(tc.GetName()), ordinal)
+	if config, ok := c.config[podName]; ok {
+		return config, nil
+	}
+	return nil, fmt.Errorf("config of %s not found", podName)
+}
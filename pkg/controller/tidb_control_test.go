@@ -370,3 +370,75 @@ func fakeSecret(fakeClient *fake.Clientset) {
 		}, nil
 	})
 }
+
+func TestSetSettings(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cases := []struct {
+		caseName string
+		settings map[string]string
+		failed   bool
+	}{
+		{
+			caseName: "SetSettingsSuccessfully",
+			failed:   false,
+			settings: map[string]string{"log_level": "warn", "tidb_general_log": "1"},
+		},
+		{
+			caseName: "SetSettingsFailed",
+			failed:   true,
+			settings: map[string]string{"log_level": "unknown"},
+		},
+	}
+
+	for _, c := range cases {
+		svc := getClientServer(func(w http.ResponseWriter, request *http.Request) {
+			g.Expect(request.Method).To(Equal(http.MethodPost), "check method")
+			g.Expect(request.URL.Path).To(Equal("/settings"), "check url")
+			g.Expect(request.ParseForm()).NotTo(HaveOccurred())
+			for k, v := range c.settings {
+				g.Expect(request.PostForm.Get(k)).To(Equal(v), "check form value %s", k)
+			}
+
+			if c.failed {
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		})
+		defer svc.Close()
+
+		fakeClient := &fake.Clientset{}
+		informer := kubeinformers.NewSharedInformerFactory(fakeClient, 0)
+		control := NewDefaultTiDBControl(informer.Core().V1().Secrets().Lister())
+		control.testURL = svc.URL
+		tc := getTidbCluster()
+		err := control.SetSettings(tc, 0, c.settings)
+		if c.failed {
+			g.Expect(err).To(HaveOccurred(), c.caseName)
+		} else {
+			g.Expect(err).NotTo(HaveOccurred(), c.caseName)
+		}
+	}
+}
+
+func TestGetConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	svc := getClientServer(func(w http.ResponseWriter, request *http.Request) {
+		g.Expect(request.Method).To(Equal(http.MethodGet), "check method")
+		g.Expect(request.URL.Path).To(Equal("/config"), "check url")
+		w.Header().Set("Content-Type", ContentTypeJSON)
+		w.Write([]byte(`{"log":{"level":"info"},"oom-action":"cancel"}`))
+	})
+	defer svc.Close()
+
+	fakeClient := &fake.Clientset{}
+	informer := kubeinformers.NewSharedInformerFactory(fakeClient, 0)
+	control := NewDefaultTiDBControl(informer.Core().V1().Secrets().Lister())
+	control.testURL = svc.URL
+	config, err := control.GetConfig(getTidbCluster(), 0)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(config).To(Equal(map[string]interface{}{
+		"log":        map[string]interface{}{"level": "info"},
+		"oom-action": "cancel",
+	}))
+}
@@ -0,0 +1,197 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	"github.com/pingcap/tidb-operator/pkg/util"
+	corelisterv1 "k8s.io/client-go/listers/core/v1"
+)
+
+// SQLExecutor executes SQL statements in a session of TiDB
+type SQLExecutor interface {
+	// Exec executes the statement, the args are interpolated into the statement by the client
+	Exec(query string, args ...interface{}) error
+	// Query executes the query and returns the rows, the columns are returned as strings and NULL as empty
+	Query(query string, args ...interface{}) ([][]string, error)
+	Close() error
+}
+
+// TiDBSQLControlInterface is the interface that knows how to connect to the TiDB service of a cluster
+type TiDBSQLControlInterface interface {
+	// Open opens a session to the TiDB service with the user, TLS is used if the TLS client is enabled
+	// for TiDB, the client certificate is read from tlsClientSecretName or the default TiDB client secret.
+	Open(tc *v1alpha1.TidbCluster, user, password string, tlsClientSecretName *string) (SQLExecutor, error)
+}
+
+// QuoteIdentifier quotes the identifier such as a database or a placement policy name with backticks
+func QuoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+// defaultTiDBSQLControl is default implementation of TiDBSQLControlInterface.
+type defaultTiDBSQLControl struct {
+	secretLister corelisterv1.SecretLister
+}
+
+// NewDefaultTiDBSQLControl returns a defaultTiDBSQLControl instance
+func NewDefaultTiDBSQLControl(secretLister corelisterv1.SecretLister) TiDBSQLControlInterface {
+	return &defaultTiDBSQLControl{secretLister: secretLister}
+}
+
+func (c *defaultTiDBSQLControl) Open(tc *v1alpha1.TidbCluster, user, password string, tlsClientSecretName *string) (SQLExecutor, error) {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	host := fmt.Sprintf("%s-tidb.%s.svc", tcName, ns)
+
+	cfg := mysql.NewConfig()
+	cfg.User = user
+	cfg.Passwd = password
+	cfg.Net = "tcp"
+	cfg.Addr = fmt.Sprintf("%s:%d", host, tc.Spec.TiDB.GetServicePort())
+	cfg.Timeout = timeout
+	cfg.InterpolateParams = true
+	if tc.Spec.TiDB.IsTLSClientEnabled() && !tc.SkipTLSWhenConnectTiDB() {
+		secretName := util.TiDBClientTLSSecretName(tcName, tlsClientSecretName)
+		tlsConfig, err := pdapi.GetTLSConfig(c.secretLister, pdapi.Namespace(ns), secretName)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.ServerName = host
+		cfg.TLS = tlsConfig
+	}
+
+	connector, err := mysql.NewConnector(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("create connector to tidb cluster %s/%s failed, err: %v", ns, tcName, err)
+	}
+	db := sql.OpenDB(connector)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("cannot connect to tidb cluster %s/%s, err: %v", ns, tcName, err)
+	}
+	return &sqlExecutor{db: db}, nil
+}
+
+type sqlExecutor struct {
+	db *sql.DB
+}
+
+func (e *sqlExecutor) Exec(query string, args ...interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	_, err := e.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (e *sqlExecutor) Query(query string, args ...interface{}) ([][]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	rows, err := e.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	var result [][]string
+	for rows.Next() {
+		values := make([]sql.NullString, len(columns))
+		dest := make([]interface{}, len(columns))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		row := make([]string, len(columns))
+		for i, v := range values {
+			row[i] = v.String
+		}
+		result = append(result, row)
+	}
+	return result, rows.Err()
+}
+
+func (e *sqlExecutor) Close() error {
+	return e.db.Close()
+}
+
+// FakeTiDBSQLControl is a fake implementation of TiDBSQLControlInterface.
+type FakeTiDBSQLControl struct {
+	// Statements records the executed statements with the args
+	Statements []string
+	// ExecErrFn returns the error of the statement if it is set
+	ExecErrFn func(query string, args ...interface{}) error
+	// QueryFn returns the rows of the query if it is set
+	QueryFn func(query string, args ...interface{}) ([][]string, error)
+	openErr error
+}
+
+// NewFakeTiDBSQLControl returns a FakeTiDBSQLControl instance
+func NewFakeTiDBSQLControl() *FakeTiDBSQLControl {
+	return &FakeTiDBSQLControl{}
+}
+
+func (c *FakeTiDBSQLControl) SetOpenError(err error) {
+	c.openErr = err
+}
+
+func (c *FakeTiDBSQLControl) Open(_ *v1alpha1.TidbCluster, _, _ string, _ *string) (SQLExecutor, error) {
+	if c.openErr != nil {
+		return nil, c.openErr
+	}
+	return &fakeSQLExecutor{control: c}, nil
+}
+
+type fakeSQLExecutor struct {
+	control *FakeTiDBSQLControl
+}
+
+func (e *fakeSQLExecutor) Exec(query string, args ...interface{}) error {
+	if e.control.ExecErrFn != nil {
+		if err := e.control.ExecErrFn(query, args...); err != nil {
+			return err
+		}
+	}
+	stmt := query
+	if len(args) > 0 {
+		stmt = fmt.Sprintf("%s %v", query, args)
+	}
+	e.control.Statements = append(e.control.Statements, stmt)
+	return nil
+}
+
+func (e *fakeSQLExecutor) Query(query string, args ...interface{}) ([][]string, error) {
+	if e.control.QueryFn != nil {
+		return e.control.QueryFn(query, args...)
+	}
+	return nil, nil
+}
+
+func (e *fakeSQLExecutor) Close() error {
+	return nil
+}
@@ -0,0 +1,133 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/third_party/k8s"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+)
+
+// TiDBSQLObject is the object applied to a TidbCluster through the SQL interface of TiDB with an admin account,
+// such as TidbUser, TidbPlacementPolicy and TidbResourceGroup
+type TiDBSQLObject interface {
+	metav1.Object
+	// GetClusterNamespace returns the namespace of the TidbCluster, defaults to the namespace of the object
+	GetClusterNamespace() string
+	GetClusterName() string
+	// GetAdminSecretName returns the name of the secret which stores the `user` and `password` of the admin account
+	GetAdminSecretName() string
+	GetTLSClientSecretName() *string
+}
+
+// UpdateFunc updates the object by the typed client, such as TidbUsers(ns).Update
+type UpdateFunc[T TiDBSQLObject] func(ctx context.Context, obj T, opts metav1.UpdateOptions) (T, error)
+
+// GetTidbClusterOf returns the TidbCluster the object is applied to
+func GetTidbClusterOf(deps *Dependencies, obj TiDBSQLObject) (*v1alpha1.TidbCluster, error) {
+	tcNs := obj.GetClusterNamespace()
+	tcName := obj.GetClusterName()
+	tc, err := deps.TiDBClusterLister.TidbClusters(tcNs).Get(tcName)
+	if err != nil {
+		return nil, fmt.Errorf("get tidbcluster %s/%s failed, err: %w", tcNs, tcName, err)
+	}
+	return tc, nil
+}
+
+// OpenTiDBSQL opens a session to the TiDB of the cluster with the admin account of the object
+func OpenTiDBSQL(deps *Dependencies, obj TiDBSQLObject, tc *v1alpha1.TidbCluster) (SQLExecutor, error) {
+	if tc.Spec.TiDB == nil {
+		return nil, fmt.Errorf("tidbcluster %s/%s has no tidb", tc.Namespace, tc.Name)
+	}
+	if obj.GetAdminSecretName() == "" {
+		return nil, fmt.Errorf("adminSecretName is required to connect to tidbcluster %s/%s", tc.Namespace, tc.Name)
+	}
+	adminUser, adminPassword, err := GetUserAndPasswordFromSecret(deps.SecretLister, obj.GetNamespace(), obj.GetAdminSecretName())
+	if err != nil {
+		return nil, err
+	}
+	return deps.TiDBSQLControl.Open(tc, adminUser, adminPassword, obj.GetTLSClientSecretName())
+}
+
+// AddProtectionFinalizer adds the finalizer to the object if it's not added, the finalizer
+// prevents the object from being deleted before it's removed from the TidbCluster
+func AddProtectionFinalizer[T TiDBSQLObject](kind string, obj T, finalizer string, update UpdateFunc[T]) error {
+	if k8s.ContainsString(obj.GetFinalizers(), finalizer, nil) {
+		return nil
+	}
+
+	obj.SetFinalizers(append(obj.GetFinalizers(), finalizer))
+	updated, err := update(context.TODO(), obj, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("add %s %s/%s protection finalizers failed, err: %v", kind, obj.GetNamespace(), obj.GetName(), err)
+	}
+	obj.SetResourceVersion(updated.GetResourceVersion())
+	return nil
+}
+
+// RemoveProtectionFinalizer removes the finalizer from the object after it's removed from the TidbCluster
+func RemoveProtectionFinalizer[T TiDBSQLObject](kind string, obj T, finalizer string, update UpdateFunc[T]) error {
+	obj.SetFinalizers(k8s.RemoveString(obj.GetFinalizers(), finalizer, nil))
+	if _, err := update(context.TODO(), obj, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("remove %s %s/%s protection finalizers failed, err: %v", kind, obj.GetNamespace(), obj.GetName(), err)
+	}
+	return nil
+}
+
+// UpdateTiDBSQLObjectStatus updates the status of the object, on conflict the status is applied to
+// the latest object from the lister by withStatus and updated again
+func UpdateTiDBSQLObjectStatus[T TiDBSQLObject](
+	kind string,
+	obj T,
+	updateStatus UpdateFunc[T],
+	get func(name string) (T, error),
+	withStatus func(latest, obj T) T,
+) (T, error) {
+	var (
+		ns      = obj.GetNamespace()
+		name    = obj.GetName()
+		current = obj
+		update  T
+	)
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var updateErr error
+		update, updateErr = updateStatus(context.TODO(), current, metav1.UpdateOptions{})
+		if updateErr == nil {
+			klog.V(4).Infof("%s: [%s/%s], update status successfully", kind, ns, name)
+			return nil
+		}
+
+		klog.V(4).Infof("%s: [%s/%s], update status failed, error: %v", kind, ns, name, updateErr)
+
+		if updated, err := get(name); err == nil {
+			current = withStatus(updated, obj)
+		} else {
+			utilruntime.HandleError(fmt.Errorf("error getting updated %s %s/%s from lister: %v", kind, ns, name, err))
+		}
+
+		return updateErr
+	})
+	if err != nil {
+		klog.Errorf("%s: [%s/%s], failed to updateStatus, error: %v", kind, ns, name, err)
+	}
+
+	return update, err
+}
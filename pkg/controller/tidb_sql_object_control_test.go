@@ -0,0 +1,83 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned/fake"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	core "k8s.io/client-go/testing"
+)
+
+func TestAddProtectionFinalizer(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	user := &v1alpha1.TidbUser{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "user"}}
+	cli := fake.NewSimpleClientset(user.DeepCopy())
+	update := cli.PingcapV1alpha1().TidbUsers("ns").Update
+
+	g.Expect(AddProtectionFinalizer("tidb user", user, "finalizer", update)).Should(Succeed())
+	g.Expect(user.Finalizers).Should(ConsistOf("finalizer"))
+	got, err := cli.PingcapV1alpha1().TidbUsers("ns").Get(context.TODO(), "user", metav1.GetOptions{})
+	g.Expect(err).Should(Succeed())
+	g.Expect(got.Finalizers).Should(ConsistOf("finalizer"))
+
+	// the finalizer is added only once
+	g.Expect(AddProtectionFinalizer("tidb user", user, "finalizer", update)).Should(Succeed())
+	g.Expect(user.Finalizers).Should(ConsistOf("finalizer"))
+
+	g.Expect(RemoveProtectionFinalizer("tidb user", user, "finalizer", update)).Should(Succeed())
+	got, err = cli.PingcapV1alpha1().TidbUsers("ns").Get(context.TODO(), "user", metav1.GetOptions{})
+	g.Expect(err).Should(Succeed())
+	g.Expect(got.Finalizers).Should(BeEmpty())
+}
+
+func TestUpdateTiDBSQLObjectStatus(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	user := &v1alpha1.TidbUser{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "user"}}
+	cli := fake.NewSimpleClientset(user.DeepCopy())
+	conflicted := false
+	cli.PrependReactor("update", "tidbusers", func(action core.Action) (bool, runtime.Object, error) {
+		if !conflicted {
+			conflicted = true
+			return true, nil, apierrors.NewConflict(schema.GroupResource{Resource: "tidbusers"}, "user", nil)
+		}
+		return false, nil, nil
+	})
+
+	latest := user.DeepCopy()
+	latest.Labels = map[string]string{"latest": "true"}
+	user.Status.Error = "error"
+	updated, err := UpdateTiDBSQLObjectStatus("TidbUser", user,
+		cli.PingcapV1alpha1().TidbUsers("ns").UpdateStatus,
+		func(string) (*v1alpha1.TidbUser, error) { return latest, nil },
+		func(latest, user *v1alpha1.TidbUser) *v1alpha1.TidbUser {
+			updated := latest.DeepCopy()
+			updated.Status = user.Status
+			return updated
+		})
+	g.Expect(err).Should(Succeed())
+	g.Expect(conflicted).Should(BeTrue())
+	// the status is applied to the latest object on conflict
+	g.Expect(updated.Labels).Should(HaveKeyWithValue("latest", "true"))
+	g.Expect(updated.Status.Error).Should(Equal("error"))
+}
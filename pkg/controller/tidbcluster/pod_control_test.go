@@ -46,6 +46,14 @@ func (c *kvClient) GetLeaderCount() (int, error) {
 	return int(count), nil
 }
 
+func (c *kvClient) UpdateConfig(config map[string]interface{}) error {
+	return nil
+}
+
+func (c *kvClient) GetConfig() (map[string]interface{}, error) {
+	return nil, nil
+}
+
 func TestTiKVPodSyncForEviction(t *testing.T) {
 	interval := time.Millisecond * 100
 	timeout := time.Minute * 1
@@ -58,6 +58,8 @@ func NewDefaultTidbClusterControl(
 	tiflashMemberManager manager.Manager,
 	ticdcMemberManager manager.Manager,
 	discoveryManager member.TidbDiscoveryManager,
+	tlsCertManager manager.Manager,
+	pdbManager manager.Manager,
 	tidbClusterStatusManager manager.Manager,
 	conditionUpdater TidbClusterConditionUpdater,
 	recorder record.EventRecorder) ControlInterface {
@@ -78,6 +80,8 @@ func NewDefaultTidbClusterControl(
 		tiflashMemberManager:     tiflashMemberManager,
 		ticdcMemberManager:       ticdcMemberManager,
 		discoveryManager:         discoveryManager,
+		tlsCertManager:           tlsCertManager,
+		pdbManager:               pdbManager,
 		tidbClusterStatusManager: tidbClusterStatusManager,
 		conditionUpdater:         conditionUpdater,
 		recorder:                 recorder,
@@ -101,6 +105,8 @@ type defaultTidbClusterControl struct {
 	tiflashMemberManager     manager.Manager
 	ticdcMemberManager       manager.Manager
 	discoveryManager         member.TidbDiscoveryManager
+	tlsCertManager           manager.Manager
+	pdbManager               manager.Manager
 	tidbClusterStatusManager manager.Manager
 	conditionUpdater         TidbClusterConditionUpdater
 	recorder                 record.EventRecorder
@@ -174,6 +180,19 @@ func (c *defaultTidbClusterControl) updateTidbCluster(tc *v1alpha1.TidbCluster)
 		}
 	}
 
+	// issue or renew the certificates of the components when the BuiltIn TLS issuer is used,
+	// discovery and the components mount them so this must be done before them
+	if err := c.tlsCertManager.Sync(tc); err != nil {
+		metrics.ClusterUpdateErrors.WithLabelValues(ns, tcName, "tls_cert").Inc()
+		return err
+	}
+
+	// create or delete the PodDisruptionBudgets of the components
+	if err := c.pdbManager.Sync(tc); err != nil {
+		metrics.ClusterUpdateErrors.WithLabelValues(ns, tcName, "pdb").Inc()
+		return err
+	}
+
 	// reconcile TiDB discovery service
 	if err := c.discoveryManager.Reconcile(tc); err != nil {
 		metrics.ClusterUpdateErrors.WithLabelValues(ns, tcName, "discovery").Inc()
@@ -323,6 +323,8 @@ func newFakeTidbClusterControl() (
 	tiproxyMemberManager := mm.NewFakeTiProxyMemberManager()
 	ticdcMemberManager := mm.NewFakeTiCDCMemberManager()
 	discoveryManager := mm.NewFakeDiscoveryManger()
+	tlsCertManager := mm.NewFakeTLSCertManager()
+	pdbManager := mm.NewFakePDBManager()
 	statusManager := mm.NewFakeTidbClusterStatusManager()
 	pvcResizer := mm.NewFakePVCResizer()
 	pvcReplacer := volumes.NewFakePVCReplacer()
@@ -343,6 +345,8 @@ func newFakeTidbClusterControl() (
 		tiflashMemberManager,
 		ticdcMemberManager,
 		discoveryManager,
+		tlsCertManager,
+		pdbManager,
 		statusManager,
 		&tidbClusterConditionUpdater{},
 		recorder,
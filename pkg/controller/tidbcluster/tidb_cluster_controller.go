@@ -70,6 +70,8 @@ func NewController(deps *controller.Dependencies) *Controller {
 			mm.NewTiFlashMemberManager(deps, mm.NewTiFlashFailover(deps), mm.NewTiFlashScaler(deps), mm.NewTiFlashUpgrader(deps), suspender, podVolumeModifier),
 			mm.NewTiCDCMemberManager(deps, mm.NewTiCDCScaler(deps), mm.NewTiCDCUpgrader(deps), suspender, podVolumeModifier),
 			mm.NewTidbDiscoveryManager(deps),
+			mm.NewTLSCertManager(deps),
+			mm.NewPDBManager(deps),
 			mm.NewTidbClusterStatusManager(deps),
 			&tidbClusterConditionUpdater{},
 			deps.Recorder,
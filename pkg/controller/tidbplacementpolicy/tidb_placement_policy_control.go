@@ -0,0 +1,465 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tidbplacementpolicy
+
+import (
+	stderrors "errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	v1alpha1validation "github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1/validation"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	"github.com/pingcap/tidb-operator/pkg/third_party/k8s"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// errPlacementPolicyInUse is the error number returned by TiDB when dropping a placement policy
+	// which is still attached to databases, tables or partitions
+	errPlacementPolicyInUse = 8241
+
+	placementPolicyQuery = "SELECT POLICY_NAME FROM information_schema.placement_policies WHERE LOWER(POLICY_NAME) = LOWER(?)"
+)
+
+// ControlInterface abstracts the business logic for TidbPlacementPolicy reconciliation.
+type ControlInterface interface {
+	Reconcile(*v1alpha1.TidbPlacementPolicy) error
+}
+
+func NewTidbPlacementPolicyControl(deps *controller.Dependencies) ControlInterface {
+	return &defaultTidbPlacementPolicyControl{
+		deps: deps,
+	}
+}
+
+type defaultTidbPlacementPolicyControl struct {
+	deps *controller.Dependencies
+}
+
+func (c *defaultTidbPlacementPolicyControl) Reconcile(policy *v1alpha1.TidbPlacementPolicy) error {
+	oldStatus := policy.Status.DeepCopy()
+	var err error
+	if policy.DeletionTimestamp != nil {
+		if err = c.removePlacement(policy); err == nil {
+			return nil
+		}
+	} else {
+		if err := c.addProtectionFinalizer(policy); err != nil {
+			return err
+		}
+		err = c.syncPlacement(policy)
+	}
+
+	if err != nil {
+		policy.Status.Error = err.Error()
+		if controller.IsIgnoreError(err) {
+			c.deps.Recorder.Event(policy, corev1.EventTypeWarning, "PlacementPolicyInUse", err.Error())
+		}
+	} else {
+		policy.Status.Error = ""
+	}
+
+	if !apiequality.Semantic.DeepEqual(&policy.Status, oldStatus) {
+		now := metav1.Now()
+		policy.Status.LastSyncTime = &now
+		if _, updateErr := c.updateStatus(policy); updateErr != nil {
+			return updateErr
+		}
+	}
+	// the drift is checked again when the informer resyncs, so is the placement policy in use
+	return err
+}
+
+// syncPlacement applies the rule groups and the placement policies when the spec changes, otherwise
+// the actual rules are compared with the declared rules and the differences are reported in the status.
+func (c *defaultTidbPlacementPolicyControl) syncPlacement(policy *v1alpha1.TidbPlacementPolicy) error {
+	if errs := v1alpha1validation.ValidateTidbPlacementPolicy(policy); len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+	if err := c.checkOwnership(policy); err != nil {
+		return err
+	}
+
+	tc, err := controller.GetTidbClusterOf(c.deps, policy)
+	if err != nil {
+		return err
+	}
+
+	specChanged := policy.Status.ObservedGeneration != policy.Generation
+	drift, err := c.syncRuleGroups(policy, tc, specChanged)
+	if err != nil {
+		return err
+	}
+	if err := c.syncPolicies(policy, tc, specChanged); err != nil {
+		return err
+	}
+
+	policy.Status.Drifted = len(drift) > 0
+	policy.Status.Drift = drift
+	policy.Status.ObservedGeneration = policy.Generation
+	return nil
+}
+
+// syncRuleGroups applies the rule groups which are changed or drift from the actual rules if the drift
+// should be corrected, and returns the drift of the other rule groups.
+func (c *defaultTidbPlacementPolicyControl) syncRuleGroups(policy *v1alpha1.TidbPlacementPolicy, tc *v1alpha1.TidbCluster, specChanged bool) ([]string, error) {
+	ns := policy.GetNamespace()
+	name := policy.GetName()
+	pdClient := controller.GetPDClient(c.deps.PDControl, tc)
+
+	declared := ruleGroupIDs(policy.Spec.RuleGroups)
+	for _, id := range policy.Status.RuleGroups {
+		if k8s.ContainsString(declared, id, nil) {
+			continue
+		}
+		if err := pdClient.DeletePlacementRuleBundle(id); err != nil {
+			return nil, fmt.Errorf("delete placement rule group %s failed, err: %v", id, err)
+		}
+		klog.Infof("tidb placement policy %s/%s: placement rule group %s is deleted", ns, name, id)
+	}
+	applied := policy.Status.RuleGroups
+	// the rule groups created by others are not taken over
+	for _, id := range declared {
+		if k8s.ContainsString(applied, id, nil) {
+			continue
+		}
+		actual, err := pdClient.GetPlacementRuleBundle(id)
+		if err != nil {
+			return nil, fmt.Errorf("get placement rule group %s failed, err: %v", id, err)
+		}
+		if len(actual.Rules) > 0 {
+			return nil, fmt.Errorf("placement rule group %s already exists and is not created by the tidb placement policy", id)
+		}
+	}
+	// the rule groups are recorded before applying, so they are deleted if some of them are removed from the spec
+	// before they are applied successfully
+	policy.Status.RuleGroups = mergeStrings(applied, declared)
+
+	var drift []string
+	for _, group := range policy.Spec.RuleGroups {
+		desired := newPlacementRuleBundle(group)
+		actual, err := pdClient.GetPlacementRuleBundle(group.ID)
+		if err != nil {
+			return nil, fmt.Errorf("get placement rule group %s failed, err: %v", group.ID, err)
+		}
+		diff := diffPlacementRuleBundle(desired, actual)
+		if len(diff) == 0 {
+			continue
+		}
+		if !specChanged && k8s.ContainsString(applied, group.ID, nil) && !policy.Spec.CorrectDrift {
+			drift = append(drift, diff...)
+			continue
+		}
+		if err := pdClient.SetPlacementRuleBundle(desired); err != nil {
+			return nil, fmt.Errorf("set placement rule group %s failed, err: %v", group.ID, err)
+		}
+		klog.Infof("tidb placement policy %s/%s: placement rule group %s is applied, diff: %v", ns, name, group.ID, diff)
+	}
+	policy.Status.RuleGroups = declared
+	return drift, nil
+}
+
+// syncPolicies creates or alters the placement policies which are changed and drops the removed ones
+func (c *defaultTidbPlacementPolicyControl) syncPolicies(policy *v1alpha1.TidbPlacementPolicy, tc *v1alpha1.TidbCluster, specChanged bool) error {
+	ns := policy.GetNamespace()
+	name := policy.GetName()
+	if len(policy.Spec.Policies) == 0 && len(policy.Status.Policies) == 0 {
+		return nil
+	}
+
+	declared := make([]string, 0, len(policy.Spec.Policies))
+	for _, p := range policy.Spec.Policies {
+		if _, _, err := placementOptions(p); err != nil {
+			return err
+		}
+		declared = append(declared, p.Name)
+	}
+
+	db, err := controller.OpenTiDBSQL(c.deps, policy, tc)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	for _, policyName := range policy.Status.Policies {
+		if k8s.ContainsString(declared, policyName, nil) {
+			continue
+		}
+		err := db.Exec(fmt.Sprintf("DROP PLACEMENT POLICY IF EXISTS %s", controller.QuoteIdentifier(policyName)))
+		var mysqlErr *mysql.MySQLError
+		if stderrors.As(err, &mysqlErr) && mysqlErr.Number == errPlacementPolicyInUse {
+			// retrying does not help until the user detaches the policy, it's dropped again when the informer resyncs
+			return controller.IgnoreErrorf("placement policy %s is still in use, detach it from the databases, tables and partitions to drop it", policyName)
+		}
+		if err != nil {
+			return fmt.Errorf("drop placement policy %s failed, err: %v", policyName, err)
+		}
+		klog.Infof("tidb placement policy %s/%s: placement policy %s is dropped", ns, name, policyName)
+	}
+	applied := policy.Status.Policies
+	// the placement policies created by others are not taken over
+	for _, policyName := range declared {
+		if k8s.ContainsString(applied, policyName, nil) {
+			continue
+		}
+		rows, err := db.Query(placementPolicyQuery, policyName)
+		if err != nil {
+			return fmt.Errorf("query placement policy %s failed, err: %v", policyName, err)
+		}
+		if len(rows) > 0 {
+			return fmt.Errorf("placement policy %s already exists and is not created by the tidb placement policy", policyName)
+		}
+	}
+	policy.Status.Policies = mergeStrings(applied, declared)
+
+	for _, p := range policy.Spec.Policies {
+		if !specChanged && k8s.ContainsString(applied, p.Name, nil) {
+			continue
+		}
+		options, args, _ := placementOptions(p)
+		policyName := controller.QuoteIdentifier(p.Name)
+		if err := db.Exec(fmt.Sprintf("CREATE PLACEMENT POLICY IF NOT EXISTS %s %s", policyName, options), args...); err != nil {
+			return fmt.Errorf("create placement policy %s failed, err: %v", p.Name, err)
+		}
+		if err := db.Exec(fmt.Sprintf("ALTER PLACEMENT POLICY %s %s", policyName, options), args...); err != nil {
+			return fmt.Errorf("alter placement policy %s failed, err: %v", p.Name, err)
+		}
+		klog.Infof("tidb placement policy %s/%s: placement policy %s is applied", ns, name, p.Name)
+	}
+	policy.Status.Policies = declared
+	return nil
+}
+
+// checkOwnership checks the rule groups and the placement policies which are not applied by the policy yet
+// are not declared or applied by the other TidbPlacementPolicies of the same cluster
+func (c *defaultTidbPlacementPolicyControl) checkOwnership(policy *v1alpha1.TidbPlacementPolicy) error {
+	others, err := c.deps.TiDBPlacementPolicyLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("list tidb placement policies failed, err: %v", err)
+	}
+	for _, other := range others {
+		if other.Namespace == policy.Namespace && other.Name == policy.Name {
+			continue
+		}
+		if other.GetClusterNamespace() != policy.GetClusterNamespace() || other.GetClusterName() != policy.GetClusterName() {
+			continue
+		}
+		otherRuleGroups := mergeStrings(other.Status.RuleGroups, ruleGroupIDs(other.Spec.RuleGroups))
+		for _, id := range ruleGroupIDs(policy.Spec.RuleGroups) {
+			if !k8s.ContainsString(policy.Status.RuleGroups, id, nil) && k8s.ContainsString(otherRuleGroups, id, nil) {
+				return fmt.Errorf("placement rule group %s is managed by tidb placement policy %s/%s", id, other.Namespace, other.Name)
+			}
+		}
+		otherPolicies := mergeStrings(other.Status.Policies, policyNames(other.Spec.Policies))
+		for _, policyName := range policyNames(policy.Spec.Policies) {
+			if !k8s.ContainsString(policy.Status.Policies, policyName, nil) && k8s.ContainsString(otherPolicies, policyName, nil) {
+				return fmt.Errorf("placement policy %s is managed by tidb placement policy %s/%s", policyName, other.Namespace, other.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// removePlacement deletes the rule groups and drops the placement policies, then removes the protection finalizer
+func (c *defaultTidbPlacementPolicyControl) removePlacement(policy *v1alpha1.TidbPlacementPolicy) error {
+	ns := policy.GetNamespace()
+	if !k8s.ContainsString(policy.Finalizers, label.TiDBPlacementPolicyProtectionFinalizer, nil) {
+		return nil
+	}
+
+	tc, err := controller.GetTidbClusterOf(c.deps, policy)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	// the placement is removed with the cluster if the tidbcluster is deleted
+	if tc != nil {
+		policy.Spec.RuleGroups = nil
+		policy.Spec.Policies = nil
+		if _, err := c.syncRuleGroups(policy, tc, true); err != nil {
+			return err
+		}
+		if err := c.syncPolicies(policy, tc, true); err != nil {
+			return err
+		}
+	}
+
+	return controller.RemoveProtectionFinalizer("tidb placement policy", policy, label.TiDBPlacementPolicyProtectionFinalizer,
+		c.deps.Clientset.PingcapV1alpha1().TidbPlacementPolicies(ns).Update)
+}
+
+// addProtectionFinalizer adds the finalizer to remove the placement when the TidbPlacementPolicy is deleted
+func (c *defaultTidbPlacementPolicyControl) addProtectionFinalizer(policy *v1alpha1.TidbPlacementPolicy) error {
+	return controller.AddProtectionFinalizer("tidb placement policy", policy, label.TiDBPlacementPolicyProtectionFinalizer,
+		c.deps.Clientset.PingcapV1alpha1().TidbPlacementPolicies(policy.GetNamespace()).Update)
+}
+
+func (c *defaultTidbPlacementPolicyControl) updateStatus(policy *v1alpha1.TidbPlacementPolicy) (*v1alpha1.TidbPlacementPolicy, error) {
+	ns := policy.GetNamespace()
+	return controller.UpdateTiDBSQLObjectStatus("TidbPlacementPolicy", policy,
+		c.deps.Clientset.PingcapV1alpha1().TidbPlacementPolicies(ns).UpdateStatus,
+		c.deps.TiDBPlacementPolicyLister.TidbPlacementPolicies(ns).Get,
+		func(latest, policy *v1alpha1.TidbPlacementPolicy) *v1alpha1.TidbPlacementPolicy {
+			updated := latest.DeepCopy()
+			updated.Status = *policy.Status.DeepCopy()
+			return updated
+		})
+}
+
+// newPlacementRuleBundle converts the declared rule group to the PD placement rule bundle
+func newPlacementRuleBundle(group v1alpha1.PlacementRuleGroup) *pdapi.PlacementRuleBundle {
+	bundle := &pdapi.PlacementRuleBundle{
+		ID:       group.ID,
+		Index:    group.Index,
+		Override: group.Override,
+		Rules:    make([]*pdapi.PlacementRule, 0, len(group.Rules)),
+	}
+	for _, rule := range group.Rules {
+		r := &pdapi.PlacementRule{
+			GroupID:        group.ID,
+			ID:             rule.ID,
+			Index:          rule.Index,
+			Override:       rule.Override,
+			StartKeyHex:    strings.ToLower(rule.StartKeyHex),
+			EndKeyHex:      strings.ToLower(rule.EndKeyHex),
+			Role:           string(rule.Role),
+			Count:          rule.Count,
+			LocationLabels: rule.LocationLabels,
+			IsolationLevel: rule.IsolationLevel,
+		}
+		for _, lc := range rule.LabelConstraints {
+			r.LabelConstraints = append(r.LabelConstraints, pdapi.PlacementLabelConstraint{
+				Key:    lc.Key,
+				Op:     lc.Op,
+				Values: lc.Values,
+			})
+		}
+		bundle.Rules = append(bundle.Rules, r)
+	}
+	return bundle
+}
+
+// diffPlacementRuleBundle returns the differences between the desired and the actual rule groups
+func diffPlacementRuleBundle(desired, actual *pdapi.PlacementRuleBundle) []string {
+	var diff []string
+	if actual.Index != desired.Index {
+		diff = append(diff, fmt.Sprintf("rule group %s: index is %d, expected %d", desired.ID, actual.Index, desired.Index))
+	}
+	if actual.Override != desired.Override {
+		diff = append(diff, fmt.Sprintf("rule group %s: override is %t, expected %t", desired.ID, actual.Override, desired.Override))
+	}
+
+	actualRules := make(map[string]*pdapi.PlacementRule, len(actual.Rules))
+	for _, rule := range actual.Rules {
+		actualRules[rule.ID] = rule
+	}
+	for _, rule := range desired.Rules {
+		actualRule, ok := actualRules[rule.ID]
+		if !ok {
+			diff = append(diff, fmt.Sprintf("rule group %s: rule %s is missing", desired.ID, rule.ID))
+			continue
+		}
+		delete(actualRules, rule.ID)
+		normalized := *actualRule
+		normalized.StartKeyHex = strings.ToLower(normalized.StartKeyHex)
+		normalized.EndKeyHex = strings.ToLower(normalized.EndKeyHex)
+		if !apiequality.Semantic.DeepEqual(&normalized, rule) {
+			diff = append(diff, fmt.Sprintf("rule group %s: rule %s is changed", desired.ID, rule.ID))
+		}
+	}
+	for _, rule := range actual.Rules {
+		if _, ok := actualRules[rule.ID]; ok {
+			diff = append(diff, fmt.Sprintf("rule group %s: rule %s is unexpected", desired.ID, rule.ID))
+		}
+	}
+	return diff
+}
+
+// placementOptions returns the options of the placement policy, the string options are passed as the args
+func placementOptions(p v1alpha1.PlacementPolicy) (string, []interface{}, error) {
+	var (
+		options []string
+		args    []interface{}
+	)
+	for _, opt := range []struct {
+		name  string
+		value string
+	}{
+		{"PRIMARY_REGION", p.PrimaryRegion},
+		{"REGIONS", p.Regions},
+		{"SCHEDULE", p.Schedule},
+		{"CONSTRAINTS", p.Constraints},
+		{"LEADER_CONSTRAINTS", p.LeaderConstraints},
+		{"FOLLOWER_CONSTRAINTS", p.FollowerConstraints},
+		{"VOTER_CONSTRAINTS", p.VoterConstraints},
+		{"LEARNER_CONSTRAINTS", p.LearnerConstraints},
+		{"SURVIVAL_PREFERENCES", p.SurvivalPreferences},
+	} {
+		if opt.value != "" {
+			options = append(options, opt.name+"=?")
+			args = append(args, opt.value)
+		}
+	}
+	for _, opt := range []struct {
+		name  string
+		value *int32
+	}{
+		{"FOLLOWERS", p.Followers},
+		{"VOTERS", p.Voters},
+		{"LEARNERS", p.Learners},
+	} {
+		if opt.value != nil {
+			options = append(options, fmt.Sprintf("%s=%d", opt.name, *opt.value))
+		}
+	}
+	if len(options) == 0 {
+		return "", nil, fmt.Errorf("placement policy %s has no options", p.Name)
+	}
+	return strings.Join(options, " "), args, nil
+}
+
+func ruleGroupIDs(groups []v1alpha1.PlacementRuleGroup) []string {
+	ids := make([]string, 0, len(groups))
+	for _, group := range groups {
+		ids = append(ids, group.ID)
+	}
+	return ids
+}
+
+func policyNames(policies []v1alpha1.PlacementPolicy) []string {
+	names := make([]string, 0, len(policies))
+	for _, p := range policies {
+		names = append(names, p.Name)
+	}
+	return names
+}
+
+func mergeStrings(a, b []string) []string {
+	merged := append([]string(nil), a...)
+	for _, s := range b {
+		if !k8s.ContainsString(merged, s, nil) {
+			merged = append(merged, s)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,294 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tidbplacementpolicy
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+)
+
+func TestTidbPlacementPolicyControlReconcile(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	control := NewTidbPlacementPolicyControl(deps)
+	sqlControl := deps.TiDBSQLControl.(*controller.FakeTiDBSQLControl)
+
+	tc := &v1alpha1.TidbCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "tc", Namespace: "ns"},
+		Spec: v1alpha1.TidbClusterSpec{
+			PD:   &v1alpha1.PDSpec{Replicas: 3},
+			TiDB: &v1alpha1.TiDBSpec{Replicas: 1},
+		},
+	}
+	g.Expect(deps.InformerFactory.Pingcap().V1alpha1().TidbClusters().Informer().GetIndexer().Add(tc)).To(Succeed())
+	g.Expect(deps.KubeInformerFactory.Core().V1().Secrets().Informer().GetIndexer().Add(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "admin", Namespace: "ns"},
+		Data:       map[string][]byte{"user": []byte("root"), "password": []byte("admin")},
+	})).To(Succeed())
+
+	bundles := map[string]*pdapi.PlacementRuleBundle{}
+	var calls []string
+	pdClient := controller.NewFakePDClient(deps.PDControl.(*pdapi.FakePDControl), tc)
+	pdClient.AddReaction(pdapi.GetPlacementRuleBundleActionType, func(action *pdapi.Action) (interface{}, error) {
+		if bundle, ok := bundles[action.Name]; ok {
+			return bundle, nil
+		}
+		return &pdapi.PlacementRuleBundle{ID: action.Name}, nil
+	})
+	pdClient.AddReaction(pdapi.SetPlacementRuleBundleActionType, func(action *pdapi.Action) (interface{}, error) {
+		calls = append(calls, "set "+action.Name)
+		bundles[action.Name] = action.RuleBundle
+		return nil, nil
+	})
+	pdClient.AddReaction(pdapi.DeletePlacementRuleBundleActionType, func(action *pdapi.Action) (interface{}, error) {
+		calls = append(calls, "delete "+action.Name)
+		delete(bundles, action.Name)
+		return nil, nil
+	})
+
+	policy := &v1alpha1.TidbPlacementPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "placement", Namespace: "ns", Generation: 1},
+		Spec: v1alpha1.TidbPlacementPolicySpec{
+			Cluster: v1alpha1.TidbClusterRef{Name: "tc"},
+			RuleGroups: []v1alpha1.PlacementRuleGroup{
+				{
+					ID:    "zone-a",
+					Index: 10,
+					Rules: []v1alpha1.PlacementRule{
+						{
+							ID:          "learner",
+							StartKeyHex: "7480000000000000FF",
+							Role:        v1alpha1.PlacementRuleRoleLearner,
+							Count:       1,
+							LabelConstraints: []v1alpha1.PlacementLabelConstraint{
+								{Key: "zone", Op: "in", Values: []string{"zone-a"}},
+							},
+						},
+					},
+				},
+			},
+			Policies: []v1alpha1.PlacementPolicy{
+				{Name: "multi_region", PrimaryRegion: "us-east-1", Regions: "us-east-1,us-west-1", Followers: pointer.Int32(4)},
+			},
+			AdminSecretName: "admin",
+		},
+	}
+	policy, err := deps.Clientset.PingcapV1alpha1().TidbPlacementPolicies("ns").Create(context.TODO(), policy, metav1.CreateOptions{})
+	g.Expect(err).To(Succeed())
+
+	// apply the rule groups and policies
+	g.Expect(control.Reconcile(policy)).To(Succeed())
+	g.Expect(calls).To(Equal([]string{"set zone-a"}))
+	g.Expect(bundles["zone-a"].Index).To(Equal(10))
+	g.Expect(bundles["zone-a"].Rules[0].StartKeyHex).To(Equal("7480000000000000ff"))
+	g.Expect(sqlControl.Statements).To(Equal([]string{
+		"CREATE PLACEMENT POLICY IF NOT EXISTS `multi_region` PRIMARY_REGION=? REGIONS=? FOLLOWERS=4 [us-east-1 us-east-1,us-west-1]",
+		"ALTER PLACEMENT POLICY `multi_region` PRIMARY_REGION=? REGIONS=? FOLLOWERS=4 [us-east-1 us-east-1,us-west-1]",
+	}))
+	policy, err = deps.Clientset.PingcapV1alpha1().TidbPlacementPolicies("ns").Get(context.TODO(), "placement", metav1.GetOptions{})
+	g.Expect(err).To(Succeed())
+	g.Expect(policy.Finalizers).To(ContainElement(label.TiDBPlacementPolicyProtectionFinalizer))
+	g.Expect(policy.Status.RuleGroups).To(Equal([]string{"zone-a"}))
+	g.Expect(policy.Status.Policies).To(Equal([]string{"multi_region"}))
+	g.Expect(policy.Status.ObservedGeneration).To(Equal(int64(1)))
+	g.Expect(policy.Status.Drifted).To(BeFalse())
+
+	// nothing changed
+	calls = nil
+	sqlControl.Statements = nil
+	g.Expect(control.Reconcile(policy)).To(Succeed())
+	g.Expect(calls).To(BeEmpty())
+	g.Expect(sqlControl.Statements).To(BeEmpty())
+
+	// the drift is reported
+	bundles["zone-a"] = &pdapi.PlacementRuleBundle{
+		ID:    "zone-a",
+		Index: 10,
+		Rules: []*pdapi.PlacementRule{
+			{GroupID: "zone-a", ID: "learner", StartKeyHex: "7480000000000000FF", Role: "learner", Count: 2,
+				LabelConstraints: []pdapi.PlacementLabelConstraint{{Key: "zone", Op: "in", Values: []string{"zone-a"}}}},
+			{GroupID: "zone-a", ID: "manual", Role: "voter", Count: 1},
+		},
+	}
+	g.Expect(control.Reconcile(policy)).To(Succeed())
+	g.Expect(calls).To(BeEmpty())
+	g.Expect(policy.Status.Drifted).To(BeTrue())
+	g.Expect(policy.Status.Drift).To(Equal([]string{
+		"rule group zone-a: rule learner is changed",
+		"rule group zone-a: rule manual is unexpected",
+	}))
+
+	// the drift is corrected
+	policy.Spec.CorrectDrift = true
+	g.Expect(control.Reconcile(policy)).To(Succeed())
+	g.Expect(calls).To(Equal([]string{"set zone-a"}))
+	g.Expect(bundles["zone-a"].Rules).To(HaveLen(1))
+	g.Expect(policy.Status.Drifted).To(BeFalse())
+	g.Expect(policy.Status.Drift).To(BeEmpty())
+
+	// the removed rule groups and policies are deleted
+	calls = nil
+	policy.Spec.RuleGroups = []v1alpha1.PlacementRuleGroup{
+		{ID: "zone-b", Rules: []v1alpha1.PlacementRule{{ID: "voter", Role: v1alpha1.PlacementRuleRoleVoter, Count: 3}}},
+	}
+	policy.Spec.Policies = nil
+	policy.Generation = 2
+	g.Expect(control.Reconcile(policy)).To(Succeed())
+	g.Expect(calls).To(Equal([]string{"delete zone-a", "set zone-b"}))
+	g.Expect(sqlControl.Statements).To(Equal([]string{"DROP PLACEMENT POLICY IF EXISTS `multi_region`"}))
+	g.Expect(policy.Status.RuleGroups).To(Equal([]string{"zone-b"}))
+	g.Expect(policy.Status.Policies).To(BeEmpty())
+
+	// a policy without options is reported in the status
+	policy.Spec.Policies = []v1alpha1.PlacementPolicy{{Name: "empty"}}
+	policy.Generation = 3
+	g.Expect(control.Reconcile(policy)).NotTo(Succeed())
+	g.Expect(policy.Status.Error).To(ContainSubstring("has no options"))
+
+	// remove the placement
+	calls = nil
+	policy.Spec.Policies = nil
+	now := metav1.Now()
+	policy.DeletionTimestamp = &now
+	g.Expect(control.Reconcile(policy)).To(Succeed())
+	g.Expect(calls).To(Equal([]string{"delete zone-b"}))
+	policy, err = deps.Clientset.PingcapV1alpha1().TidbPlacementPolicies("ns").Get(context.TODO(), "placement", metav1.GetOptions{})
+	g.Expect(err).To(Succeed())
+	g.Expect(policy.Finalizers).NotTo(ContainElement(label.TiDBPlacementPolicyProtectionFinalizer))
+}
+
+func TestTidbPlacementPolicyControlOwnership(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	control := NewTidbPlacementPolicyControl(deps)
+	sqlControl := deps.TiDBSQLControl.(*controller.FakeTiDBSQLControl)
+	recorder := deps.Recorder.(*record.FakeRecorder)
+
+	tc := &v1alpha1.TidbCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "tc", Namespace: "ns"},
+		Spec: v1alpha1.TidbClusterSpec{
+			PD:   &v1alpha1.PDSpec{Replicas: 3},
+			TiDB: &v1alpha1.TiDBSpec{Replicas: 1},
+		},
+	}
+	g.Expect(deps.InformerFactory.Pingcap().V1alpha1().TidbClusters().Informer().GetIndexer().Add(tc)).To(Succeed())
+	g.Expect(deps.KubeInformerFactory.Core().V1().Secrets().Informer().GetIndexer().Add(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "admin", Namespace: "ns"},
+		Data:       map[string][]byte{"user": []byte("root"), "password": []byte("admin")},
+	})).To(Succeed())
+
+	bundles := map[string]*pdapi.PlacementRuleBundle{
+		"manual": {ID: "manual", Rules: []*pdapi.PlacementRule{{GroupID: "manual", ID: "voter", Role: "voter", Count: 3}}},
+	}
+	pdClient := controller.NewFakePDClient(deps.PDControl.(*pdapi.FakePDControl), tc)
+	pdClient.AddReaction(pdapi.GetPlacementRuleBundleActionType, func(action *pdapi.Action) (interface{}, error) {
+		if bundle, ok := bundles[action.Name]; ok {
+			return bundle, nil
+		}
+		return &pdapi.PlacementRuleBundle{ID: action.Name}, nil
+	})
+	pdClient.AddReaction(pdapi.SetPlacementRuleBundleActionType, func(action *pdapi.Action) (interface{}, error) {
+		bundles[action.Name] = action.RuleBundle
+		return nil, nil
+	})
+	pdClient.AddReaction(pdapi.DeletePlacementRuleBundleActionType, func(action *pdapi.Action) (interface{}, error) {
+		delete(bundles, action.Name)
+		return nil, nil
+	})
+	sqlControl.QueryFn = func(query string, args ...interface{}) ([][]string, error) {
+		if args[0] == "manual_policy" {
+			return [][]string{{"manual_policy"}}, nil
+		}
+		return nil, nil
+	}
+
+	// the rule groups and policies of another TidbPlacementPolicy of the same cluster are not taken over
+	g.Expect(deps.InformerFactory.Pingcap().V1alpha1().TidbPlacementPolicies().Informer().GetIndexer().Add(&v1alpha1.TidbPlacementPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "ns"},
+		Spec:       v1alpha1.TidbPlacementPolicySpec{Cluster: v1alpha1.TidbClusterRef{Name: "tc"}},
+		Status:     v1alpha1.TidbPlacementPolicyStatus{RuleGroups: []string{"zone-a"}},
+	})).To(Succeed())
+
+	policy := &v1alpha1.TidbPlacementPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "placement", Namespace: "ns", Generation: 1},
+		Spec: v1alpha1.TidbPlacementPolicySpec{
+			Cluster: v1alpha1.TidbClusterRef{Name: "tc"},
+			RuleGroups: []v1alpha1.PlacementRuleGroup{
+				{ID: "zone-a", Rules: []v1alpha1.PlacementRule{{ID: "voter", Role: v1alpha1.PlacementRuleRoleVoter, Count: 3}}},
+			},
+			AdminSecretName: "admin",
+		},
+	}
+	policy, err := deps.Clientset.PingcapV1alpha1().TidbPlacementPolicies("ns").Create(context.TODO(), policy, metav1.CreateOptions{})
+	g.Expect(err).To(Succeed())
+	g.Expect(control.Reconcile(policy)).To(MatchError(ContainSubstring("placement rule group zone-a is managed by tidb placement policy ns/other")))
+	g.Expect(policy.Status.RuleGroups).To(BeEmpty())
+
+	// the rule groups created by others are not taken over
+	policy.Spec.RuleGroups[0].ID = "manual"
+	g.Expect(control.Reconcile(policy)).To(MatchError(ContainSubstring("placement rule group manual already exists")))
+	g.Expect(policy.Status.RuleGroups).To(BeEmpty())
+	g.Expect(bundles["manual"].Rules).To(HaveLen(1))
+
+	// the placement policies created by others are not taken over
+	policy.Spec.RuleGroups = nil
+	policy.Spec.Policies = []v1alpha1.PlacementPolicy{{Name: "manual_policy", PrimaryRegion: "us-east-1", Regions: "us-east-1"}}
+	g.Expect(control.Reconcile(policy)).To(MatchError(ContainSubstring("placement policy manual_policy already exists")))
+	g.Expect(policy.Status.Policies).To(BeEmpty())
+	g.Expect(sqlControl.Statements).To(BeEmpty())
+
+	policy.Spec.Policies[0].Name = "multi_region"
+	g.Expect(control.Reconcile(policy)).To(Succeed())
+	g.Expect(policy.Status.Policies).To(Equal([]string{"multi_region"}))
+
+	// the policy still in use is reported in the status and the events instead of being retried
+	sqlControl.ExecErrFn = func(query string, args ...interface{}) error {
+		if strings.HasPrefix(query, "DROP PLACEMENT POLICY") {
+			return &mysql.MySQLError{Number: errPlacementPolicyInUse, Message: "Placement policy 'multi_region' is still in use"}
+		}
+		return nil
+	}
+	now := metav1.Now()
+	policy.DeletionTimestamp = &now
+	err = control.Reconcile(policy)
+	g.Expect(controller.IsIgnoreError(err)).To(BeTrue())
+	g.Expect(recorder.Events).To(Receive(ContainSubstring("PlacementPolicyInUse")))
+	policy, err = deps.Clientset.PingcapV1alpha1().TidbPlacementPolicies("ns").Get(context.TODO(), "placement", metav1.GetOptions{})
+	g.Expect(err).To(Succeed())
+	g.Expect(policy.Status.Error).To(ContainSubstring("placement policy multi_region is still in use"))
+	g.Expect(policy.Finalizers).To(ContainElement(label.TiDBPlacementPolicyProtectionFinalizer))
+
+	// the finalizer is removed after the policy is detached
+	sqlControl.ExecErrFn = nil
+	policy.DeletionTimestamp = &now
+	g.Expect(control.Reconcile(policy)).To(Succeed())
+	policy, err = deps.Clientset.PingcapV1alpha1().TidbPlacementPolicies("ns").Get(context.TODO(), "placement", metav1.GetOptions{})
+	g.Expect(err).To(Succeed())
+	g.Expect(policy.Finalizers).NotTo(ContainElement(label.TiDBPlacementPolicyProtectionFinalizer))
+}
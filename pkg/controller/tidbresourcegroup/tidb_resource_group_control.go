@@ -0,0 +1,328 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tidbresourcegroup
+
+import (
+	stderrors "errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/third_party/k8s"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// defaultResourceGroup is the resource group the users are bound to by default
+	defaultResourceGroup = "default"
+	// defaultPriority is the default priority of a resource group
+	defaultPriority = "MEDIUM"
+
+	// errCannotUser is the error number returned by TiDB when altering a user which does not exist
+	errCannotUser = 1396
+
+	resourceGroupQuery = "SELECT RU_PER_SEC, PRIORITY, BURSTABLE, QUERY_LIMIT FROM information_schema.resource_groups WHERE NAME = ?"
+	ruConsumptionQuery = "SELECT total_ru, end_time FROM mysql.request_unit_by_group WHERE resource_group = ? ORDER BY end_time DESC LIMIT 1"
+)
+
+// ControlInterface abstracts the business logic for TidbResourceGroup reconciliation.
+type ControlInterface interface {
+	Reconcile(*v1alpha1.TidbResourceGroup) error
+}
+
+func NewTidbResourceGroupControl(deps *controller.Dependencies) ControlInterface {
+	return &defaultTidbResourceGroupControl{
+		deps: deps,
+	}
+}
+
+type defaultTidbResourceGroupControl struct {
+	deps *controller.Dependencies
+}
+
+func (c *defaultTidbResourceGroupControl) Reconcile(rg *v1alpha1.TidbResourceGroup) error {
+	if rg.DeletionTimestamp != nil {
+		return c.dropResourceGroup(rg)
+	}
+
+	if err := c.addProtectionFinalizer(rg); err != nil {
+		return err
+	}
+
+	oldStatus := rg.Status.DeepCopy()
+	err := c.syncResourceGroup(rg)
+	if err != nil {
+		rg.Status.Error = err.Error()
+	} else {
+		rg.Status.Error = ""
+	}
+
+	if !apiequality.Semantic.DeepEqual(&rg.Status, oldStatus) {
+		now := metav1.Now()
+		rg.Status.LastSyncTime = &now
+		if _, updateErr := c.updateStatus(rg); updateErr != nil {
+			return updateErr
+		}
+	}
+	// the drift and the RU consumption are checked again when the informer resyncs
+	return err
+}
+
+// syncResourceGroup creates the resource group or alters it when the spec changes, otherwise the actual
+// settings are compared with the declared settings and the differences are reported in the status.
+func (c *defaultTidbResourceGroupControl) syncResourceGroup(rg *v1alpha1.TidbResourceGroup) error {
+	ns := rg.GetNamespace()
+	name := rg.GetName()
+	groupName := rg.GetGroupName()
+
+	db, err := c.openDB(rg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	rows, err := db.Query(resourceGroupQuery, strings.ToLower(groupName))
+	if err != nil {
+		return fmt.Errorf("query resource group %s failed, err: %v", groupName, err)
+	}
+	specChanged := rg.Status.ObservedGeneration != rg.Generation
+	var drift []string
+	if len(rows) == 0 {
+		options, args := resourceGroupOptions(rg.Spec, false)
+		stmt := fmt.Sprintf("CREATE RESOURCE GROUP IF NOT EXISTS %s %s", controller.QuoteIdentifier(groupName), options)
+		if err := db.Exec(stmt, args...); err != nil {
+			return fmt.Errorf("create resource group %s failed, err: %v", groupName, err)
+		}
+		klog.Infof("tidb resource group %s/%s: resource group %s is created", ns, name, groupName)
+	} else {
+		diff := diffResourceGroup(rg.Spec, rows[0])
+		if specChanged || (len(diff) > 0 && rg.Spec.CorrectDrift) {
+			options, args := resourceGroupOptions(rg.Spec, true)
+			stmt := fmt.Sprintf("ALTER RESOURCE GROUP %s %s", controller.QuoteIdentifier(groupName), options)
+			if err := db.Exec(stmt, args...); err != nil {
+				return fmt.Errorf("alter resource group %s failed, err: %v", groupName, err)
+			}
+			klog.Infof("tidb resource group %s/%s: resource group %s is altered, diff: %v", ns, name, groupName, diff)
+		} else {
+			drift = diff
+		}
+	}
+
+	if err := c.syncUsers(db, rg, specChanged); err != nil {
+		return err
+	}
+
+	// the RU consumption is only available since TiDB v7.6, the error is ignored for the earlier versions
+	rows, err = db.Query(ruConsumptionQuery, strings.ToLower(groupName))
+	if err != nil {
+		klog.V(4).Infof("tidb resource group %s/%s: query RU consumption failed, err: %v", ns, name, err)
+	} else if len(rows) > 0 && len(rows[0]) == 2 {
+		rg.Status.RUConsumption = rows[0][0]
+		rg.Status.RUConsumptionPeriodEnd = rows[0][1]
+	}
+
+	rg.Status.Drifted = len(drift) > 0
+	rg.Status.Drift = drift
+	rg.Status.ObservedGeneration = rg.Generation
+	return nil
+}
+
+// syncUsers binds the users to the resource group and binds the removed users to the default resource group
+func (c *defaultTidbResourceGroupControl) syncUsers(db controller.SQLExecutor, rg *v1alpha1.TidbResourceGroup, specChanged bool) error {
+	groupName := rg.GetGroupName()
+	for _, user := range rg.Status.Users {
+		if containsUser(rg.Spec.Users, user) {
+			continue
+		}
+		if err := unbindUser(db, user); err != nil {
+			return err
+		}
+	}
+	bound := rg.Status.Users
+	// the users are recorded before binding, so they are unbound if some of them are removed from the spec
+	// before they are bound successfully
+	rg.Status.Users = mergeUsers(bound, rg.Spec.Users)
+
+	for _, user := range rg.Spec.Users {
+		if !specChanged && containsUser(bound, user) {
+			continue
+		}
+		stmt := fmt.Sprintf("ALTER USER ?@? RESOURCE GROUP %s", controller.QuoteIdentifier(groupName))
+		if err := db.Exec(stmt, user.Name, user.GetHost()); err != nil {
+			return fmt.Errorf("bind user %s@%s to resource group %s failed, err: %v", user.Name, user.GetHost(), groupName, err)
+		}
+	}
+	rg.Status.Users = append([]v1alpha1.TidbResourceGroupUser(nil), rg.Spec.Users...)
+	return nil
+}
+
+// dropResourceGroup unbinds the users and drops the resource group, then removes the protection finalizer
+func (c *defaultTidbResourceGroupControl) dropResourceGroup(rg *v1alpha1.TidbResourceGroup) error {
+	ns := rg.GetNamespace()
+	name := rg.GetName()
+	groupName := rg.GetGroupName()
+	if !k8s.ContainsString(rg.Finalizers, label.TiDBResourceGroupProtectionFinalizer, nil) {
+		return nil
+	}
+
+	db, err := c.openDB(rg)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	// the resource group is dropped with the cluster if the tidbcluster is deleted
+	if db != nil {
+		defer db.Close()
+		for _, user := range mergeUsers(rg.Status.Users, rg.Spec.Users) {
+			if err := unbindUser(db, user); err != nil {
+				return err
+			}
+		}
+		// the default resource group can not be dropped
+		if !strings.EqualFold(groupName, defaultResourceGroup) {
+			if err := db.Exec(fmt.Sprintf("DROP RESOURCE GROUP IF EXISTS %s", controller.QuoteIdentifier(groupName))); err != nil {
+				return fmt.Errorf("drop resource group %s failed, err: %v", groupName, err)
+			}
+			klog.Infof("tidb resource group %s/%s: resource group %s is dropped", ns, name, groupName)
+		}
+	}
+
+	return controller.RemoveProtectionFinalizer("tidb resource group", rg, label.TiDBResourceGroupProtectionFinalizer,
+		c.deps.Clientset.PingcapV1alpha1().TidbResourceGroups(ns).Update)
+}
+
+// addProtectionFinalizer adds the finalizer to drop the resource group when the TidbResourceGroup is deleted
+func (c *defaultTidbResourceGroupControl) addProtectionFinalizer(rg *v1alpha1.TidbResourceGroup) error {
+	return controller.AddProtectionFinalizer("tidb resource group", rg, label.TiDBResourceGroupProtectionFinalizer,
+		c.deps.Clientset.PingcapV1alpha1().TidbResourceGroups(rg.GetNamespace()).Update)
+}
+
+// openDB opens a session to the TiDB of the cluster with the admin account
+func (c *defaultTidbResourceGroupControl) openDB(rg *v1alpha1.TidbResourceGroup) (controller.SQLExecutor, error) {
+	tc, err := controller.GetTidbClusterOf(c.deps, rg)
+	if err != nil {
+		return nil, err
+	}
+	return controller.OpenTiDBSQL(c.deps, rg, tc)
+}
+
+func (c *defaultTidbResourceGroupControl) updateStatus(rg *v1alpha1.TidbResourceGroup) (*v1alpha1.TidbResourceGroup, error) {
+	ns := rg.GetNamespace()
+	return controller.UpdateTiDBSQLObjectStatus("TidbResourceGroup", rg,
+		c.deps.Clientset.PingcapV1alpha1().TidbResourceGroups(ns).UpdateStatus,
+		c.deps.TiDBResourceGroupLister.TidbResourceGroups(ns).Get,
+		func(latest, rg *v1alpha1.TidbResourceGroup) *v1alpha1.TidbResourceGroup {
+			updated := latest.DeepCopy()
+			updated.Status = *rg.Status.DeepCopy()
+			return updated
+		})
+}
+
+// resourceGroupOptions returns the options of the resource group, the string values are passed as the args.
+// The query limit is reset by `QUERY_LIMIT = NULL` when altering the resource group.
+func resourceGroupOptions(spec v1alpha1.TidbResourceGroupSpec, alter bool) (string, []interface{}) {
+	priority := spec.Priority
+	if priority == "" {
+		priority = defaultPriority
+	}
+	options := []string{
+		fmt.Sprintf("RU_PER_SEC = %d", spec.RUPerSec),
+		fmt.Sprintf("PRIORITY = %s", strings.ToUpper(priority)),
+		fmt.Sprintf("BURSTABLE = %s", strings.ToUpper(strconv.FormatBool(spec.Burstable))),
+	}
+	var args []interface{}
+	if limit := spec.QueryLimit; limit != nil {
+		queryLimit := fmt.Sprintf("EXEC_ELAPSED = ?, ACTION = %s", strings.ToUpper(limit.Action))
+		args = append(args, limit.ExecElapsed)
+		if limit.Watch != nil {
+			queryLimit += fmt.Sprintf(", WATCH = %s", strings.ToUpper(limit.Watch.Type))
+			if limit.Watch.Duration != "" {
+				queryLimit += " DURATION = ?"
+				args = append(args, limit.Watch.Duration)
+			}
+		}
+		options = append(options, fmt.Sprintf("QUERY_LIMIT = (%s)", queryLimit))
+	} else if alter {
+		options = append(options, "QUERY_LIMIT = NULL")
+	}
+	return strings.Join(options, " "), args
+}
+
+// diffResourceGroup returns the differences between the declared settings and the actual settings,
+// which are the RU_PER_SEC, PRIORITY, BURSTABLE and QUERY_LIMIT columns of information_schema.resource_groups
+func diffResourceGroup(spec v1alpha1.TidbResourceGroupSpec, actual []string) []string {
+	if len(actual) != 4 {
+		return nil
+	}
+	var diff []string
+	if ru := strconv.FormatInt(spec.RUPerSec, 10); actual[0] != ru {
+		diff = append(diff, fmt.Sprintf("RU_PER_SEC is %s, expected %s", actual[0], ru))
+	}
+	priority := spec.Priority
+	if priority == "" {
+		priority = defaultPriority
+	}
+	if !strings.EqualFold(actual[1], priority) {
+		diff = append(diff, fmt.Sprintf("PRIORITY is %s, expected %s", actual[1], strings.ToUpper(priority)))
+	}
+	// BURSTABLE is YES or NO before TiDB v8.4, and OFF, MODERATED or UNLIMITED since then
+	burstable := !strings.EqualFold(actual[2], "NO") && !strings.EqualFold(actual[2], "OFF")
+	if burstable != spec.Burstable {
+		diff = append(diff, fmt.Sprintf("BURSTABLE is %s, expected %t", actual[2], spec.Burstable))
+	}
+	switch limit := spec.QueryLimit; {
+	case limit == nil && actual[3] != "":
+		diff = append(diff, fmt.Sprintf("QUERY_LIMIT is %s, expected none", actual[3]))
+	case limit != nil && !strings.Contains(strings.ToUpper(actual[3]), "ACTION="+strings.ToUpper(limit.Action)):
+		diff = append(diff, fmt.Sprintf("QUERY_LIMIT is %q, expected action %s", actual[3], strings.ToUpper(limit.Action)))
+	}
+	return diff
+}
+
+// unbindUser binds the user to the default resource group, the users which do not exist are ignored
+func unbindUser(db controller.SQLExecutor, user v1alpha1.TidbResourceGroupUser) error {
+	stmt := fmt.Sprintf("ALTER USER ?@? RESOURCE GROUP %s", controller.QuoteIdentifier(defaultResourceGroup))
+	err := db.Exec(stmt, user.Name, user.GetHost())
+	var mysqlErr *mysql.MySQLError
+	if err != nil && !(stderrors.As(err, &mysqlErr) && mysqlErr.Number == errCannotUser) {
+		return fmt.Errorf("unbind user %s@%s failed, err: %v", user.Name, user.GetHost(), err)
+	}
+	return nil
+}
+
+func containsUser(users []v1alpha1.TidbResourceGroupUser, user v1alpha1.TidbResourceGroupUser) bool {
+	for _, u := range users {
+		if u.Name == user.Name && u.GetHost() == user.GetHost() {
+			return true
+		}
+	}
+	return false
+}
+
+func mergeUsers(a, b []v1alpha1.TidbResourceGroupUser) []v1alpha1.TidbResourceGroupUser {
+	merged := append([]v1alpha1.TidbResourceGroupUser(nil), a...)
+	for _, user := range b {
+		if !containsUser(merged, user) {
+			merged = append(merged, user)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,163 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tidbresourcegroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTidbResourceGroupControlReconcile(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	control := NewTidbResourceGroupControl(deps)
+	sqlControl := deps.TiDBSQLControl.(*controller.FakeTiDBSQLControl)
+
+	tc := &v1alpha1.TidbCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "tc", Namespace: "ns"},
+		Spec: v1alpha1.TidbClusterSpec{
+			TiDB: &v1alpha1.TiDBSpec{Replicas: 1},
+		},
+	}
+	g.Expect(deps.InformerFactory.Pingcap().V1alpha1().TidbClusters().Informer().GetIndexer().Add(tc)).To(Succeed())
+	g.Expect(deps.KubeInformerFactory.Core().V1().Secrets().Informer().GetIndexer().Add(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "admin", Namespace: "ns"},
+		Data:       map[string][]byte{"user": []byte("root"), "password": []byte("admin")},
+	})).To(Succeed())
+
+	var actual, consumption []string
+	sqlControl.QueryFn = func(query string, args ...interface{}) ([][]string, error) {
+		g.Expect(args).To(Equal([]interface{}{"tenant_a"}))
+		switch query {
+		case resourceGroupQuery:
+			if actual == nil {
+				return nil, nil
+			}
+			return [][]string{actual}, nil
+		case ruConsumptionQuery:
+			if consumption == nil {
+				return nil, &mysql.MySQLError{Number: 1146, Message: "Table 'mysql.request_unit_by_group' doesn't exist"}
+			}
+			return [][]string{consumption}, nil
+		}
+		return nil, nil
+	}
+
+	rg := &v1alpha1.TidbResourceGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant-a", Namespace: "ns", Generation: 1},
+		Spec: v1alpha1.TidbResourceGroupSpec{
+			Cluster:   v1alpha1.TidbClusterRef{Name: "tc"},
+			GroupName: "Tenant_A",
+			RUPerSec:  1000,
+			Priority:  "HIGH",
+			Burstable: true,
+			QueryLimit: &v1alpha1.TidbResourceGroupQueryLimit{
+				ExecElapsed: "60s",
+				Action:      "KILL",
+				Watch:       &v1alpha1.TidbResourceGroupQueryWatch{Type: "SIMILAR", Duration: "10m"},
+			},
+			Users:           []v1alpha1.TidbResourceGroupUser{{Name: "app"}},
+			AdminSecretName: "admin",
+		},
+	}
+	rg, err := deps.Clientset.PingcapV1alpha1().TidbResourceGroups("ns").Create(context.TODO(), rg, metav1.CreateOptions{})
+	g.Expect(err).To(Succeed())
+
+	// create the resource group and bind the users
+	g.Expect(control.Reconcile(rg)).To(Succeed())
+	g.Expect(sqlControl.Statements).To(Equal([]string{
+		"CREATE RESOURCE GROUP IF NOT EXISTS `Tenant_A` RU_PER_SEC = 1000 PRIORITY = HIGH BURSTABLE = TRUE " +
+			"QUERY_LIMIT = (EXEC_ELAPSED = ?, ACTION = KILL, WATCH = SIMILAR DURATION = ?) [60s 10m]",
+		"ALTER USER ?@? RESOURCE GROUP `Tenant_A` [app %]",
+	}))
+	rg, err = deps.Clientset.PingcapV1alpha1().TidbResourceGroups("ns").Get(context.TODO(), "tenant-a", metav1.GetOptions{})
+	g.Expect(err).To(Succeed())
+	g.Expect(rg.Finalizers).To(ContainElement(label.TiDBResourceGroupProtectionFinalizer))
+	g.Expect(rg.Status.Users).To(Equal([]v1alpha1.TidbResourceGroupUser{{Name: "app"}}))
+	g.Expect(rg.Status.ObservedGeneration).To(Equal(int64(1)))
+	g.Expect(rg.Status.RUConsumption).To(BeEmpty())
+
+	// nothing changed, the RU consumption is reported
+	sqlControl.Statements = nil
+	actual = []string{"1000", "HIGH", "YES", "EXEC_ELAPSED='60s', ACTION=KILL, WATCH=SIMILAR DURATION='10m0s'"}
+	consumption = []string{"123456.5", "2024-06-01 00:00:00"}
+	g.Expect(control.Reconcile(rg)).To(Succeed())
+	g.Expect(sqlControl.Statements).To(BeEmpty())
+	g.Expect(rg.Status.Drifted).To(BeFalse())
+	g.Expect(rg.Status.RUConsumption).To(Equal("123456.5"))
+	g.Expect(rg.Status.RUConsumptionPeriodEnd).To(Equal("2024-06-01 00:00:00"))
+
+	// the drift is reported
+	actual = []string{"500", "MEDIUM", "NO", ""}
+	g.Expect(control.Reconcile(rg)).To(Succeed())
+	g.Expect(sqlControl.Statements).To(BeEmpty())
+	g.Expect(rg.Status.Drifted).To(BeTrue())
+	g.Expect(rg.Status.Drift).To(Equal([]string{
+		"RU_PER_SEC is 500, expected 1000",
+		"PRIORITY is MEDIUM, expected HIGH",
+		"BURSTABLE is NO, expected true",
+		`QUERY_LIMIT is "", expected action KILL`,
+	}))
+
+	// the drift is corrected
+	rg.Spec.CorrectDrift = true
+	g.Expect(control.Reconcile(rg)).To(Succeed())
+	g.Expect(sqlControl.Statements).To(HaveLen(1))
+	g.Expect(sqlControl.Statements[0]).To(HavePrefix("ALTER RESOURCE GROUP `Tenant_A` RU_PER_SEC = 1000"))
+	g.Expect(rg.Status.Drifted).To(BeFalse())
+
+	// alter the resource group and rebind the users, the users which do not exist are ignored
+	sqlControl.Statements = nil
+	rg.Spec.QueryLimit = nil
+	rg.Spec.Burstable = false
+	rg.Spec.Users = []v1alpha1.TidbResourceGroupUser{{Name: "report", Host: "10.0.0.%"}}
+	rg.Generation = 2
+	sqlControl.ExecErrFn = func(query string, args ...interface{}) error {
+		if len(args) > 0 && args[0] == "app" {
+			return &mysql.MySQLError{Number: errCannotUser}
+		}
+		return nil
+	}
+	g.Expect(control.Reconcile(rg)).To(Succeed())
+	g.Expect(sqlControl.Statements).To(Equal([]string{
+		"ALTER RESOURCE GROUP `Tenant_A` RU_PER_SEC = 1000 PRIORITY = HIGH BURSTABLE = FALSE QUERY_LIMIT = NULL",
+		"ALTER USER ?@? RESOURCE GROUP `Tenant_A` [report 10.0.0.%]",
+	}))
+	g.Expect(rg.Status.Users).To(Equal(rg.Spec.Users))
+	g.Expect(rg.Status.ObservedGeneration).To(Equal(int64(2)))
+
+	// drop the resource group
+	sqlControl.Statements = nil
+	sqlControl.ExecErrFn = nil
+	now := metav1.Now()
+	rg.DeletionTimestamp = &now
+	g.Expect(control.Reconcile(rg)).To(Succeed())
+	g.Expect(sqlControl.Statements).To(Equal([]string{
+		"ALTER USER ?@? RESOURCE GROUP `default` [report 10.0.0.%]",
+		"DROP RESOURCE GROUP IF EXISTS `Tenant_A`",
+	}))
+	rg, err = deps.Clientset.PingcapV1alpha1().TidbResourceGroups("ns").Get(context.TODO(), "tenant-a", metav1.GetOptions{})
+	g.Expect(err).To(Succeed())
+	g.Expect(rg.Finalizers).NotTo(ContainElement(label.TiDBResourceGroupProtectionFinalizer))
+}
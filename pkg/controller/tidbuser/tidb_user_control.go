@@ -0,0 +1,338 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tidbuser
+
+import (
+	stderrors "errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/third_party/k8s"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// passwordKey is the key of the password in the password secret
+	passwordKey = "password"
+
+	// the error numbers returned by TiDB when revoking the privileges which are not granted
+	errNonexistingGrant      = 1141
+	errNonexistingTableGrant = 1147
+)
+
+// privilegePattern matches the privileges such as `SELECT` and `ALL PRIVILEGES`
+var privilegePattern = regexp.MustCompile(`^[A-Za-z_]+( [A-Za-z_]+)*$`)
+
+// ControlInterface abstracts the business logic for TidbUser reconciliation.
+type ControlInterface interface {
+	Reconcile(*v1alpha1.TidbUser) error
+}
+
+func NewTidbUserControl(deps *controller.Dependencies) ControlInterface {
+	return &defaultTidbUserControl{
+		deps: deps,
+	}
+}
+
+type defaultTidbUserControl struct {
+	deps *controller.Dependencies
+}
+
+func (c *defaultTidbUserControl) Reconcile(user *v1alpha1.TidbUser) error {
+	if user.DeletionTimestamp != nil {
+		return c.dropUser(user)
+	}
+
+	if err := c.addProtectionFinalizer(user); err != nil {
+		return err
+	}
+
+	oldStatus := user.Status.DeepCopy()
+	err := c.syncUser(user)
+	if err != nil {
+		user.Status.Error = err.Error()
+	} else {
+		user.Status.Error = ""
+	}
+
+	if !apiequality.Semantic.DeepEqual(&user.Status, oldStatus) {
+		now := metav1.Now()
+		user.Status.LastSyncTime = &now
+		if _, updateErr := c.updateStatus(user); updateErr != nil {
+			return updateErr
+		}
+	}
+	// the user is synced again when the informer resyncs, so the password secret changes
+	// and the privileges revoked outside the operator are applied in the next resync
+	return err
+}
+
+// syncUser creates the user and applies the password, resource group, privileges and roles of the user.
+// The privileges and roles granted by the operator are recorded in the status, so they can be revoked
+// when they are removed from the spec.
+func (c *defaultTidbUserControl) syncUser(user *v1alpha1.TidbUser) error {
+	ns := user.GetNamespace()
+	name := user.GetName()
+	userName := user.GetUserName()
+	host := user.GetHost()
+
+	for _, grant := range user.Spec.Grants {
+		if _, err := privilegeList(grant.Privileges); err != nil {
+			return err
+		}
+	}
+
+	tc, err := controller.GetTidbClusterOf(c.deps, user)
+	if err != nil {
+		return err
+	}
+
+	passwordSecret, err := c.deps.SecretLister.Secrets(ns).Get(user.Spec.PasswordSecretName)
+	if err != nil {
+		return fmt.Errorf("get password secret %s/%s failed, err: %v", ns, user.Spec.PasswordSecretName, err)
+	}
+	password, ok := passwordSecret.Data[passwordKey]
+	if !ok {
+		return fmt.Errorf("key %s not found in password secret %s/%s", passwordKey, ns, user.Spec.PasswordSecretName)
+	}
+
+	db, err := controller.OpenTiDBSQL(c.deps, user, tc)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if err := db.Exec("CREATE USER IF NOT EXISTS ?@? IDENTIFIED BY ?", userName, host, string(password)); err != nil {
+		return fmt.Errorf("create user %s@%s failed, err: %v", userName, host, err)
+	}
+	if user.Status.PasswordSecretVersion != passwordSecret.ResourceVersion {
+		klog.Infof("tidb user %s/%s: set the password of user %s@%s", ns, name, userName, host)
+		if err := db.Exec("ALTER USER ?@? IDENTIFIED BY ?", userName, host, string(password)); err != nil {
+			return fmt.Errorf("set password of user %s@%s failed, err: %v", userName, host, err)
+		}
+		user.Status.PasswordSecretVersion = passwordSecret.ResourceVersion
+	}
+
+	if user.Spec.ResourceGroup != "" || user.Status.ResourceGroup != "" {
+		resourceGroup := user.Spec.ResourceGroup
+		if resourceGroup == "" {
+			resourceGroup = "default"
+		}
+		if err := db.Exec(fmt.Sprintf("ALTER USER ?@? RESOURCE GROUP %s", controller.QuoteIdentifier(resourceGroup)), userName, host); err != nil {
+			return fmt.Errorf("set resource group of user %s@%s failed, err: %v", userName, host, err)
+		}
+		user.Status.ResourceGroup = user.Spec.ResourceGroup
+	}
+
+	if err := c.syncGrants(db, user); err != nil {
+		return err
+	}
+	if err := c.syncRoles(db, user); err != nil {
+		return err
+	}
+
+	user.Status.ObservedGeneration = user.Generation
+	return nil
+}
+
+func (c *defaultTidbUserControl) syncGrants(db controller.SQLExecutor, user *v1alpha1.TidbUser) error {
+	userName := user.GetUserName()
+	host := user.GetHost()
+
+	for _, grant := range user.Status.Grants {
+		if containsGrant(user.Spec.Grants, grant) {
+			continue
+		}
+		privileges, err := privilegeList(grant.Privileges)
+		if err != nil {
+			return err
+		}
+		if grant.WithGrantOption {
+			privileges += ", GRANT OPTION"
+		}
+		stmt := fmt.Sprintf("REVOKE %s ON %s FROM ?@?", privileges, grantObject(grant))
+		if err := db.Exec(stmt, userName, host); err != nil && !isNonexistingGrant(err) {
+			return fmt.Errorf("revoke %s from user %s@%s failed, err: %v", privileges, userName, host, err)
+		}
+	}
+	// the grants are recorded before granting, so they are revoked if some of them are removed from the spec
+	// before they are granted successfully
+	user.Status.Grants = mergeGrants(user.Status.Grants, user.Spec.Grants)
+
+	for _, grant := range user.Spec.Grants {
+		privileges, err := privilegeList(grant.Privileges)
+		if err != nil {
+			return err
+		}
+		stmt := fmt.Sprintf("GRANT %s ON %s TO ?@?", privileges, grantObject(grant))
+		if grant.WithGrantOption {
+			stmt += " WITH GRANT OPTION"
+		}
+		if err := db.Exec(stmt, userName, host); err != nil {
+			return fmt.Errorf("grant %s to user %s@%s failed, err: %v", privileges, userName, host, err)
+		}
+	}
+	user.Status.Grants = append([]v1alpha1.TidbUserGrant(nil), user.Spec.Grants...)
+	return nil
+}
+
+func (c *defaultTidbUserControl) syncRoles(db controller.SQLExecutor, user *v1alpha1.TidbUser) error {
+	userName := user.GetUserName()
+	host := user.GetHost()
+
+	for _, role := range user.Status.Roles {
+		if k8s.ContainsString(user.Spec.Roles, role, nil) {
+			continue
+		}
+		if err := db.Exec("REVOKE ? FROM ?@?", role, userName, host); err != nil && !isNonexistingGrant(err) {
+			return fmt.Errorf("revoke role %s from user %s@%s failed, err: %v", role, userName, host, err)
+		}
+	}
+	user.Status.Roles = mergeRoles(user.Status.Roles, user.Spec.Roles)
+
+	for _, role := range user.Spec.Roles {
+		if err := db.Exec("GRANT ? TO ?@?", role, userName, host); err != nil {
+			return fmt.Errorf("grant role %s to user %s@%s failed, err: %v", role, userName, host, err)
+		}
+	}
+	if len(user.Spec.Roles) > 0 {
+		if err := db.Exec("SET DEFAULT ROLE ALL TO ?@?", userName, host); err != nil {
+			return fmt.Errorf("set default roles of user %s@%s failed, err: %v", userName, host, err)
+		}
+	}
+	user.Status.Roles = append([]string(nil), user.Spec.Roles...)
+	return nil
+}
+
+// dropUser drops the user from TiDB and removes the protection finalizer from the TidbUser
+func (c *defaultTidbUserControl) dropUser(user *v1alpha1.TidbUser) error {
+	ns := user.GetNamespace()
+	name := user.GetName()
+	if !k8s.ContainsString(user.Finalizers, label.TiDBUserProtectionFinalizer, nil) {
+		return nil
+	}
+
+	tc, err := controller.GetTidbClusterOf(c.deps, user)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	// the user is dropped with the cluster if the tidbcluster is deleted
+	if tc != nil && tc.Spec.TiDB != nil {
+		db, err := controller.OpenTiDBSQL(c.deps, user, tc)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+		if err := db.Exec("DROP USER IF EXISTS ?@?", user.GetUserName(), user.GetHost()); err != nil {
+			return fmt.Errorf("drop user %s@%s failed, err: %v", user.GetUserName(), user.GetHost(), err)
+		}
+		klog.Infof("tidb user %s/%s: user %s@%s is dropped", ns, name, user.GetUserName(), user.GetHost())
+	}
+
+	return controller.RemoveProtectionFinalizer("tidb user", user, label.TiDBUserProtectionFinalizer,
+		c.deps.Clientset.PingcapV1alpha1().TidbUsers(ns).Update)
+}
+
+// addProtectionFinalizer adds the finalizer to drop the user from TiDB when the TidbUser is deleted
+func (c *defaultTidbUserControl) addProtectionFinalizer(user *v1alpha1.TidbUser) error {
+	return controller.AddProtectionFinalizer("tidb user", user, label.TiDBUserProtectionFinalizer,
+		c.deps.Clientset.PingcapV1alpha1().TidbUsers(user.GetNamespace()).Update)
+}
+
+func (c *defaultTidbUserControl) updateStatus(user *v1alpha1.TidbUser) (*v1alpha1.TidbUser, error) {
+	ns := user.GetNamespace()
+	return controller.UpdateTiDBSQLObjectStatus("TidbUser", user,
+		c.deps.Clientset.PingcapV1alpha1().TidbUsers(ns).UpdateStatus,
+		c.deps.TiDBUserLister.TidbUsers(ns).Get,
+		func(latest, user *v1alpha1.TidbUser) *v1alpha1.TidbUser {
+			updated := latest.DeepCopy()
+			updated.Status = *user.Status.DeepCopy()
+			return updated
+		})
+}
+
+// privilegeList validates the privileges and joins them to be used in the GRANT and REVOKE statements
+func privilegeList(privileges []string) (string, error) {
+	if len(privileges) == 0 {
+		return "", fmt.Errorf("no privileges in grant")
+	}
+	list := make([]string, 0, len(privileges))
+	for _, p := range privileges {
+		if !privilegePattern.MatchString(p) {
+			return "", fmt.Errorf("invalid privilege %q", p)
+		}
+		list = append(list, strings.ToUpper(p))
+	}
+	return strings.Join(list, ", "), nil
+}
+
+// grantObject returns the database and table the privileges are granted on
+func grantObject(grant v1alpha1.TidbUserGrant) string {
+	return fmt.Sprintf("%s.%s", quoteIdentifier(grant.Database), quoteIdentifier(grant.Table))
+}
+
+// quoteIdentifier quotes the identifier with backticks, empty or `*` means all
+func quoteIdentifier(name string) string {
+	if name == "" || name == "*" {
+		return "*"
+	}
+	return controller.QuoteIdentifier(name)
+}
+
+func containsGrant(grants []v1alpha1.TidbUserGrant, grant v1alpha1.TidbUserGrant) bool {
+	for _, g := range grants {
+		if apiequality.Semantic.DeepEqual(g, grant) {
+			return true
+		}
+	}
+	return false
+}
+
+func mergeGrants(a, b []v1alpha1.TidbUserGrant) []v1alpha1.TidbUserGrant {
+	merged := append([]v1alpha1.TidbUserGrant(nil), a...)
+	for _, grant := range b {
+		if !containsGrant(merged, grant) {
+			merged = append(merged, grant)
+		}
+	}
+	return merged
+}
+
+func mergeRoles(a, b []string) []string {
+	merged := append([]string(nil), a...)
+	for _, role := range b {
+		if !k8s.ContainsString(merged, role, nil) {
+			merged = append(merged, role)
+		}
+	}
+	return merged
+}
+
+// isNonexistingGrant returns true if the error is returned because the privileges are not granted
+func isNonexistingGrant(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if stderrors.As(err, &mysqlErr) {
+		return mysqlErr.Number == errNonexistingGrant || mysqlErr.Number == errNonexistingTableGrant
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tidbuser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTidbUserControlReconcile(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	control := NewTidbUserControl(deps)
+	sqlControl := deps.TiDBSQLControl.(*controller.FakeTiDBSQLControl)
+
+	tc := &v1alpha1.TidbCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "tc", Namespace: "ns"},
+		Spec: v1alpha1.TidbClusterSpec{
+			TiDB: &v1alpha1.TiDBSpec{Replicas: 1},
+		},
+	}
+	g.Expect(deps.InformerFactory.Pingcap().V1alpha1().TidbClusters().Informer().GetIndexer().Add(tc)).To(Succeed())
+	secrets := deps.KubeInformerFactory.Core().V1().Secrets().Informer().GetIndexer()
+	g.Expect(secrets.Add(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "admin", Namespace: "ns"},
+		Data:       map[string][]byte{"user": []byte("root"), "password": []byte("admin")},
+	})).To(Succeed())
+	passwordSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-password", Namespace: "ns", ResourceVersion: "1"},
+		Data:       map[string][]byte{"password": []byte("pass")},
+	}
+	g.Expect(secrets.Add(passwordSecret)).To(Succeed())
+
+	user := &v1alpha1.TidbUser{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "ns", Generation: 1},
+		Spec: v1alpha1.TidbUserSpec{
+			Cluster:            v1alpha1.TidbClusterRef{Name: "tc"},
+			PasswordSecretName: "app-password",
+			AdminSecretName:    "admin",
+			Roles:              []string{"reader"},
+			Grants: []v1alpha1.TidbUserGrant{
+				{Privileges: []string{"select", "insert"}, Database: "app"},
+			},
+			ResourceGroup: "rg1",
+		},
+	}
+	user, err := deps.Clientset.PingcapV1alpha1().TidbUsers("ns").Create(context.TODO(), user, metav1.CreateOptions{})
+	g.Expect(err).To(Succeed())
+
+	// create the user
+	g.Expect(control.Reconcile(user)).To(Succeed())
+	g.Expect(sqlControl.Statements).To(Equal([]string{
+		"CREATE USER IF NOT EXISTS ?@? IDENTIFIED BY ? [app % pass]",
+		"ALTER USER ?@? IDENTIFIED BY ? [app % pass]",
+		"ALTER USER ?@? RESOURCE GROUP `rg1` [app %]",
+		"GRANT SELECT, INSERT ON `app`.* TO ?@? [app %]",
+		"GRANT ? TO ?@? [reader app %]",
+		"SET DEFAULT ROLE ALL TO ?@? [app %]",
+	}))
+	user, err = deps.Clientset.PingcapV1alpha1().TidbUsers("ns").Get(context.TODO(), "app", metav1.GetOptions{})
+	g.Expect(err).To(Succeed())
+	g.Expect(user.Finalizers).To(ContainElement(label.TiDBUserProtectionFinalizer))
+	g.Expect(user.Status.PasswordSecretVersion).To(Equal("1"))
+	g.Expect(user.Status.ObservedGeneration).To(Equal(int64(1)))
+	g.Expect(user.Status.Roles).To(Equal([]string{"reader"}))
+
+	// rotate the password
+	sqlControl.Statements = nil
+	passwordSecret = passwordSecret.DeepCopy()
+	passwordSecret.ResourceVersion = "2"
+	passwordSecret.Data["password"] = []byte("new-pass")
+	g.Expect(secrets.Update(passwordSecret)).To(Succeed())
+	g.Expect(control.Reconcile(user)).To(Succeed())
+	g.Expect(sqlControl.Statements).To(ContainElement("ALTER USER ?@? IDENTIFIED BY ? [app % new-pass]"))
+	g.Expect(user.Status.PasswordSecretVersion).To(Equal("2"))
+
+	// the removed grants and roles are revoked
+	sqlControl.Statements = nil
+	user.Spec.Roles = nil
+	user.Spec.Grants = []v1alpha1.TidbUserGrant{
+		{Privileges: []string{"SELECT"}, Database: "app", Table: "t`1", WithGrantOption: true},
+	}
+	user.Spec.ResourceGroup = ""
+	user.Generation = 2
+	g.Expect(control.Reconcile(user)).To(Succeed())
+	g.Expect(sqlControl.Statements).To(Equal([]string{
+		"CREATE USER IF NOT EXISTS ?@? IDENTIFIED BY ? [app % new-pass]",
+		"ALTER USER ?@? RESOURCE GROUP `default` [app %]",
+		"REVOKE SELECT, INSERT ON `app`.* FROM ?@? [app %]",
+		"GRANT SELECT ON `app`.`t``1` TO ?@? WITH GRANT OPTION [app %]",
+		"REVOKE ? FROM ?@? [reader app %]",
+	}))
+	g.Expect(user.Status.Roles).To(BeEmpty())
+	g.Expect(user.Status.Grants).To(Equal(user.Spec.Grants))
+	g.Expect(user.Status.ResourceGroup).To(BeEmpty())
+	g.Expect(user.Status.ObservedGeneration).To(Equal(int64(2)))
+
+	// the privileges which are already revoked are ignored
+	sqlControl.Statements = nil
+	user.Spec.Grants = nil
+	sqlControl.ExecErrFn = func(query string, args ...interface{}) error {
+		if query == "REVOKE SELECT, GRANT OPTION ON `app`.`t``1` FROM ?@?" {
+			return &mysql.MySQLError{Number: errNonexistingTableGrant}
+		}
+		return nil
+	}
+	g.Expect(control.Reconcile(user)).To(Succeed())
+	g.Expect(user.Status.Grants).To(BeEmpty())
+	g.Expect(user.Status.Error).To(BeEmpty())
+
+	// invalid privileges are reported in the status
+	sqlControl.ExecErrFn = nil
+	user.Spec.Grants = []v1alpha1.TidbUserGrant{{Privileges: []string{"SELECT; DROP"}}}
+	g.Expect(control.Reconcile(user)).NotTo(Succeed())
+	g.Expect(user.Status.Error).To(ContainSubstring("invalid privilege"))
+
+	// drop the user
+	sqlControl.Statements = nil
+	now := metav1.Now()
+	user.DeletionTimestamp = &now
+	g.Expect(control.Reconcile(user)).To(Succeed())
+	g.Expect(sqlControl.Statements).To(Equal([]string{"DROP USER IF EXISTS ?@? [app %]"}))
+	user, err = deps.Clientset.PingcapV1alpha1().TidbUsers("ns").Get(context.TODO(), "app", metav1.GetOptions{})
+	g.Expect(err).To(Succeed())
+	g.Expect(user.Finalizers).NotTo(ContainElement(label.TiDBUserProtectionFinalizer))
+}
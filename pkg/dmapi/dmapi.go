@@ -36,6 +36,19 @@ type MasterClient interface {
 	EvictLeader() error
 	DeleteMaster(name string) error
 	DeleteWorker(name string) error
+
+	// GetSource returns the source with its status through the OpenAPI, nil is returned if the source does not exist
+	GetSource(name string) (*Source, error)
+	CreateSource(source *Source) error
+	UpdateSource(source *Source) error
+	DeleteSource(name string) error
+	// GetTask returns the task with the status of its subtasks through the OpenAPI, nil is returned if the task does not exist
+	GetTask(name string) (*Task, error)
+	CreateTask(task *Task) error
+	UpdateTask(task *Task) error
+	DeleteTask(name string) error
+	StartTask(name string) error
+	StopTask(name string) error
 }
 
 var (
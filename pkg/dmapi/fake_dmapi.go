@@ -26,6 +26,16 @@ const (
 	EvictLeaderActionType  ActionType = "EvictLeader"
 	DeleteMasterActionType ActionType = "DeleteMaster"
 	DeleteWorkerActionType ActionType = "DeleteWorker"
+	GetSourceActionType    ActionType = "GetSource"
+	CreateSourceActionType ActionType = "CreateSource"
+	UpdateSourceActionType ActionType = "UpdateSource"
+	DeleteSourceActionType ActionType = "DeleteSource"
+	GetTaskActionType      ActionType = "GetTask"
+	CreateTaskActionType   ActionType = "CreateTask"
+	UpdateTaskActionType   ActionType = "UpdateTask"
+	DeleteTaskActionType   ActionType = "DeleteTask"
+	StartTaskActionType    ActionType = "StartTask"
+	StopTaskActionType     ActionType = "StopTask"
 )
 
 type NotFoundReaction struct {
@@ -40,6 +50,8 @@ type Action struct {
 	ID     uint64
 	Name   string
 	Labels map[string]string
+	Source *Source
+	Task   *Task
 }
 
 type Reaction func(action *Action) (interface{}, error)
@@ -113,3 +125,71 @@ func (c *FakeMasterClient) DeleteWorker(_ string) error {
 	_, err := c.fakeAPI(DeleteWorkerActionType, action)
 	return err
 }
+
+func (c *FakeMasterClient) GetSource(name string) (*Source, error) {
+	action := &Action{Name: name}
+	result, err := c.fakeAPI(GetSourceActionType, action)
+	if err != nil {
+		return nil, err
+	}
+	source, _ := result.(*Source)
+	return source, nil
+}
+
+func (c *FakeMasterClient) CreateSource(source *Source) error {
+	action := &Action{Name: source.SourceName, Source: source}
+	_, err := c.fakeAPI(CreateSourceActionType, action)
+	return err
+}
+
+func (c *FakeMasterClient) UpdateSource(source *Source) error {
+	action := &Action{Name: source.SourceName, Source: source}
+	_, err := c.fakeAPI(UpdateSourceActionType, action)
+	return err
+}
+
+func (c *FakeMasterClient) DeleteSource(name string) error {
+	action := &Action{Name: name}
+	_, err := c.fakeAPI(DeleteSourceActionType, action)
+	return err
+}
+
+func (c *FakeMasterClient) GetTask(name string) (*Task, error) {
+	action := &Action{Name: name}
+	result, err := c.fakeAPI(GetTaskActionType, action)
+	if err != nil {
+		return nil, err
+	}
+	task, _ := result.(*Task)
+	return task, nil
+}
+
+func (c *FakeMasterClient) CreateTask(task *Task) error {
+	action := &Action{Name: task.Name, Task: task}
+	_, err := c.fakeAPI(CreateTaskActionType, action)
+	return err
+}
+
+func (c *FakeMasterClient) UpdateTask(task *Task) error {
+	action := &Action{Name: task.Name, Task: task}
+	_, err := c.fakeAPI(UpdateTaskActionType, action)
+	return err
+}
+
+func (c *FakeMasterClient) DeleteTask(name string) error {
+	action := &Action{Name: name}
+	_, err := c.fakeAPI(DeleteTaskActionType, action)
+	return err
+}
+
+func (c *FakeMasterClient) StartTask(name string) error {
+	action := &Action{Name: name}
+	_, err := c.fakeAPI(StartTaskActionType, action)
+	return err
+}
+
+func (c *FakeMasterClient) StopTask(name string) error {
+	action := &Action{Name: name}
+	_, err := c.fakeAPI(StopTaskActionType, action)
+	return err
+}
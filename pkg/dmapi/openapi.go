@@ -0,0 +1,296 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	httputil "github.com/pingcap/tidb-operator/pkg/util/http"
+)
+
+// the OpenAPI of dm-master is introduced in DM v5.3.0 and is enabled by `openapi = true`
+var (
+	sourcesPrefix = "api/v1/sources"
+	tasksPrefix   = "api/v1/tasks"
+)
+
+// the error codes of dm-master when the source or task does not exist
+const (
+	errCodeSourceNotExist = 46008
+	errCodeTaskNotExist   = 46018
+)
+
+// Source is the upstream database of DM in the OpenAPI
+type Source struct {
+	SourceName  string         `json:"source_name"`
+	Host        string         `json:"host"`
+	Port        int            `json:"port"`
+	User        string         `json:"user"`
+	Password    string         `json:"password,omitempty"`
+	EnableGTID  bool           `json:"enable_gtid"`
+	Enable      bool           `json:"enable"`
+	RelayConfig *RelayConfig   `json:"relay_config,omitempty"`
+	StatusList  []SourceStatus `json:"status_list,omitempty"`
+}
+
+// RelayConfig is the relay log config of the source
+type RelayConfig struct {
+	EnableRelay bool `json:"enable_relay"`
+}
+
+// SourceStatus is the status of the source on a dm-worker
+type SourceStatus struct {
+	SourceName  string       `json:"source_name"`
+	WorkerName  string       `json:"worker_name"`
+	RelayStatus *RelayStatus `json:"relay_status,omitempty"`
+	ErrorMsg    string       `json:"error_msg,omitempty"`
+}
+
+// RelayStatus is the status of the relay log of the source
+type RelayStatus struct {
+	Stage              string `json:"stage"`
+	RelayCatchUpMaster bool   `json:"relay_catch_up_master"`
+}
+
+// Task is the data migration task of DM in the OpenAPI
+type Task struct {
+	Name                      string                 `json:"name"`
+	TaskMode                  string                 `json:"task_mode"`
+	ShardMode                 string                 `json:"shard_mode,omitempty"`
+	MetaSchema                string                 `json:"meta_schema,omitempty"`
+	OnDuplicate               string                 `json:"on_duplicate"`
+	EnhanceOnlineSchemaChange bool                   `json:"enhance_online_schema_change"`
+	TargetConfig              TaskTargetDataBase     `json:"target_config"`
+	TableMigrateRules         []TaskTableMigrateRule `json:"table_migrate_rule"`
+	SourceConfig              TaskSourceConfig       `json:"source_config"`
+	StatusList                []SubTaskStatus        `json:"status_list,omitempty"`
+}
+
+// TaskTargetDataBase is the downstream database of the task
+type TaskTargetDataBase struct {
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	User     string `json:"user"`
+	Password string `json:"password"`
+}
+
+// TaskTableMigrateRule routes the upstream tables to the downstream
+type TaskTableMigrateRule struct {
+	Source TaskTableMigrateSource  `json:"source"`
+	Target *TaskTableMigrateTarget `json:"target,omitempty"`
+}
+
+// TaskTableMigrateSource matches the upstream tables
+type TaskTableMigrateSource struct {
+	SourceName string `json:"source_name"`
+	Schema     string `json:"schema"`
+	Table      string `json:"table"`
+}
+
+// TaskTableMigrateTarget is the downstream table
+type TaskTableMigrateTarget struct {
+	Schema string `json:"schema,omitempty"`
+	Table  string `json:"table,omitempty"`
+}
+
+// TaskSourceConfig is the sources of the task
+type TaskSourceConfig struct {
+	SourceConf []TaskSourceConf `json:"source_conf"`
+}
+
+// TaskSourceConf is a source of the task and the binlog position to start replicating from
+type TaskSourceConf struct {
+	SourceName string `json:"source_name"`
+	BinlogName string `json:"binlog_name,omitempty"`
+	BinlogPos  *int64 `json:"binlog_pos,omitempty"`
+	BinlogGTID string `json:"binlog_gtid,omitempty"`
+}
+
+// SubTaskStatus is the status of the subtask on a source
+type SubTaskStatus struct {
+	Name                string      `json:"name"`
+	SourceName          string      `json:"source_name"`
+	WorkerName          string      `json:"worker_name"`
+	Stage               string      `json:"stage"`
+	Unit                string      `json:"unit"`
+	UnresolvedDDLLockID string      `json:"unresolved_ddl_lock_id,omitempty"`
+	ErrorMsg            string      `json:"error_msg,omitempty"`
+	SyncStatus          *SyncStatus `json:"sync_status,omitempty"`
+}
+
+// SyncStatus is the status of the subtask in the sync unit
+type SyncStatus struct {
+	SecondsBehindMaster int64           `json:"seconds_behind_master"`
+	BlockingDDLs        []string        `json:"blocking_ddls,omitempty"`
+	UnresolvedGroups    []ShardingGroup `json:"unresolved_groups,omitempty"`
+}
+
+// ShardingGroup is a group of sharded tables whose DDLs are not resolved
+type ShardingGroup struct {
+	Target   string   `json:"target"`
+	DDLs     []string `json:"ddl_list"`
+	Synced   []string `json:"synced"`
+	Unsynced []string `json:"unsynced"`
+}
+
+// ErrorResp is the error response of the OpenAPI
+type ErrorResp struct {
+	ErrorCode int    `json:"error_code"`
+	ErrorMsg  string `json:"error_msg"`
+}
+
+type createSourceReq struct {
+	Source *Source `json:"source"`
+}
+
+type createTaskReq struct {
+	Task *Task `json:"task"`
+}
+
+// OpenAPIError is the error returned by the OpenAPI with an error response
+type OpenAPIError struct {
+	StatusCode int
+	URL        string
+	ErrorResp
+}
+
+func (e *OpenAPIError) Error() string {
+	return fmt.Sprintf("Error response %d URL %s, error code: %d, error msg: %s", e.StatusCode, e.URL, e.ErrorCode, e.ErrorMsg)
+}
+
+// IsNotExist returns true if the error is returned by the OpenAPI because the source or task does not exist.
+// Other errors, e.g. 404 returned if the OpenAPI is not enabled, are not treated as not exist.
+func IsNotExist(err error) bool {
+	var apiErr *OpenAPIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.ErrorCode == errCodeSourceNotExist || apiErr.ErrorCode == errCodeTaskNotExist
+}
+
+func (c *masterClient) openAPIURL(prefix string, elems ...string) string {
+	apiURL := fmt.Sprintf("%s/%s", c.url, prefix)
+	for _, e := range elems {
+		apiURL = fmt.Sprintf("%s/%s", apiURL, url.PathEscape(e))
+	}
+	return apiURL
+}
+
+func (c *masterClient) doOpenAPI(method, apiURL string, req interface{}, resp interface{}) error {
+	var reqBody io.Reader
+	if req != nil {
+		data, err := json.Marshal(req)
+		if err != nil {
+			return fmt.Errorf("unable to marshal request of %s %s, err: %s", method, apiURL, err)
+		}
+		reqBody = bytes.NewReader(data)
+	}
+	request, err := http.NewRequest(method, apiURL, reqBody)
+	if err != nil {
+		return err
+	}
+	res, err := c.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer httputil.DeferClose(res.Body)
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode >= 400 {
+		apiErr := &OpenAPIError{StatusCode: res.StatusCode, URL: apiURL}
+		if err := json.Unmarshal(body, &apiErr.ErrorResp); err != nil || apiErr.ErrorCode == 0 {
+			return fmt.Errorf("Error response %v URL %s,body response: %s", res.StatusCode, apiURL, string(body))
+		}
+		return apiErr
+	}
+	if resp == nil {
+		return nil
+	}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return fmt.Errorf("unable to unmarshal resp of %s %s: %s, err: %s", method, apiURL, body, err)
+	}
+	return nil
+}
+
+func (c *masterClient) GetSource(name string) (*Source, error) {
+	source := &Source{}
+	err := c.doOpenAPI(http.MethodGet, c.openAPIURL(sourcesPrefix, name)+"?with_status=true", nil, source)
+	if IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return source, nil
+}
+
+func (c *masterClient) CreateSource(source *Source) error {
+	return c.doOpenAPI(http.MethodPost, c.openAPIURL(sourcesPrefix), &createSourceReq{Source: source}, nil)
+}
+
+func (c *masterClient) UpdateSource(source *Source) error {
+	return c.doOpenAPI(http.MethodPut, c.openAPIURL(sourcesPrefix, source.SourceName), &createSourceReq{Source: source}, nil)
+}
+
+func (c *masterClient) DeleteSource(name string) error {
+	err := c.doOpenAPI(http.MethodDelete, c.openAPIURL(sourcesPrefix, name)+"?force=true", nil, nil)
+	if IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (c *masterClient) GetTask(name string) (*Task, error) {
+	task := &Task{}
+	err := c.doOpenAPI(http.MethodGet, c.openAPIURL(tasksPrefix, name)+"?with_status=true", nil, task)
+	if IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+func (c *masterClient) CreateTask(task *Task) error {
+	return c.doOpenAPI(http.MethodPost, c.openAPIURL(tasksPrefix), &createTaskReq{Task: task}, nil)
+}
+
+func (c *masterClient) UpdateTask(task *Task) error {
+	return c.doOpenAPI(http.MethodPut, c.openAPIURL(tasksPrefix, task.Name), &createTaskReq{Task: task}, nil)
+}
+
+func (c *masterClient) DeleteTask(name string) error {
+	err := c.doOpenAPI(http.MethodDelete, c.openAPIURL(tasksPrefix, name)+"?force=true", nil, nil)
+	if IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (c *masterClient) StartTask(name string) error {
+	return c.doOpenAPI(http.MethodPost, c.openAPIURL(tasksPrefix, name, "start"), struct{}{}, nil)
+}
+
+func (c *masterClient) StopTask(name string) error {
+	return c.doOpenAPI(http.MethodPost, c.openAPIURL(tasksPrefix, name, "stop"), struct{}{}, nil)
+}
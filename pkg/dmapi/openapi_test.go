@@ -0,0 +1,155 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmapi
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestOpenAPISource(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	source := &Source{
+		SourceName: "mysql-01",
+		Host:       "mysql",
+		Port:       3306,
+		User:       "root",
+		EnableGTID: true,
+		Enable:     true,
+		StatusList: []SourceStatus{{SourceName: "mysql-01", WorkerName: "dm-worker-0"}},
+	}
+	var requests []string
+	var created *Source
+	svc := getClientServer(func(w http.ResponseWriter, request *http.Request) {
+		requests = append(requests, request.Method+" "+request.URL.RequestURI())
+		switch {
+		case request.URL.Path == fmt.Sprintf("/%s/not-exist", sourcesPrefix):
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error_code":46008,"error_msg":"source config with ID not-exist not exists"}`)
+		case request.Method == http.MethodGet:
+			w.Header().Set("Content-Type", ContentTypeJSON)
+			g.Expect(json.NewEncoder(w).Encode(source)).To(Succeed())
+		case request.Method == http.MethodPost || request.Method == http.MethodPut:
+			body, err := io.ReadAll(request.Body)
+			g.Expect(err).NotTo(HaveOccurred())
+			req := &createSourceReq{}
+			g.Expect(json.Unmarshal(body, req)).To(Succeed())
+			created = req.Source
+		}
+	})
+	defer svc.Close()
+
+	masterClient := NewMasterClient(svc.URL, DefaultTimeout, &tls.Config{}, false)
+	result, err := masterClient.GetSource("not-exist")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result).To(BeNil())
+	g.Expect(masterClient.DeleteSource("not-exist")).To(Succeed())
+
+	result, err = masterClient.GetSource("mysql-01")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result).To(Equal(source))
+
+	g.Expect(masterClient.CreateSource(source)).To(Succeed())
+	g.Expect(created.SourceName).To(Equal("mysql-01"))
+	g.Expect(masterClient.UpdateSource(source)).To(Succeed())
+	g.Expect(masterClient.DeleteSource("mysql-01")).To(Succeed())
+
+	g.Expect(requests).To(Equal([]string{
+		"GET /api/v1/sources/not-exist?with_status=true",
+		"DELETE /api/v1/sources/not-exist?force=true",
+		"GET /api/v1/sources/mysql-01?with_status=true",
+		"POST /api/v1/sources",
+		"PUT /api/v1/sources/mysql-01",
+		"DELETE /api/v1/sources/mysql-01?force=true",
+	}))
+}
+
+func TestOpenAPITask(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	task := &Task{
+		Name:        "task",
+		TaskMode:    "all",
+		OnDuplicate: "overwrite",
+		TargetConfig: TaskTargetDataBase{
+			Host: "tidb",
+			Port: 4000,
+			User: "root",
+		},
+		SourceConfig: TaskSourceConfig{
+			SourceConf: []TaskSourceConf{{SourceName: "mysql-01"}},
+		},
+		StatusList: []SubTaskStatus{{
+			Name:       "task",
+			SourceName: "mysql-01",
+			Stage:      "Running",
+			Unit:       "Sync",
+			SyncStatus: &SyncStatus{SecondsBehindMaster: 3},
+		}},
+	}
+	var requests []string
+	svc := getClientServer(func(w http.ResponseWriter, request *http.Request) {
+		requests = append(requests, request.Method+" "+request.URL.RequestURI())
+		switch {
+		case request.URL.Path == fmt.Sprintf("/%s/not-exist", tasksPrefix):
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error_code":46018,"error_msg":"task with name not-exist not exist"}`)
+		case request.URL.Path == fmt.Sprintf("/%s/openapi-disabled", tasksPrefix):
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, "404 page not found")
+		case request.Method == http.MethodGet:
+			w.Header().Set("Content-Type", ContentTypeJSON)
+			g.Expect(json.NewEncoder(w).Encode(task)).To(Succeed())
+		}
+	})
+	defer svc.Close()
+
+	masterClient := NewMasterClient(svc.URL, DefaultTimeout, &tls.Config{}, false)
+	result, err := masterClient.GetTask("not-exist")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result).To(BeNil())
+
+	// 404 without the error code of DM is not treated as not exist
+	_, err = masterClient.GetTask("openapi-disabled")
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(IsNotExist(err)).To(BeFalse())
+
+	result, err = masterClient.GetTask("task")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result).To(Equal(task))
+
+	g.Expect(masterClient.CreateTask(task)).To(Succeed())
+	g.Expect(masterClient.StopTask("task")).To(Succeed())
+	g.Expect(masterClient.UpdateTask(task)).To(Succeed())
+	g.Expect(masterClient.StartTask("task")).To(Succeed())
+	g.Expect(masterClient.DeleteTask("task")).To(Succeed())
+
+	g.Expect(requests).To(Equal([]string{
+		"GET /api/v1/tasks/not-exist?with_status=true",
+		"GET /api/v1/tasks/openapi-disabled?with_status=true",
+		"GET /api/v1/tasks/task?with_status=true",
+		"POST /api/v1/tasks",
+		"POST /api/v1/tasks/task/stop",
+		"PUT /api/v1/tasks/task",
+		"POST /api/v1/tasks/task/start",
+		"DELETE /api/v1/tasks/task?force=true",
+	}))
+}
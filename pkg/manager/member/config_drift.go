@@ -0,0 +1,309 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/apis/util/toml"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
+	"github.com/pingcap/tidb-operator/pkg/util"
+	utiltidbcluster "github.com/pingcap/tidb-operator/pkg/util/tidbcluster"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// configDriftCheckInterval is the minimal interval between two runtime config drift detections
+	configDriftCheckInterval = 5 * time.Minute
+)
+
+// readableSizePattern matches the readable sizes such as `1GB`, `512MiB` used by TiKV and PD
+var readableSizePattern = regexp.MustCompile(`^(\d+(?:\.\d+)?)\s*([KMGTP]?)(I?B)?$`)
+
+// syncConfigDrift periodically compares the running config of each pod with the rendered config of its component,
+// records the drifted items in the status and updates the ConfigDrift condition.
+// Only the items set in the rendered config are compared, the items missing in the running config are ignored.
+func (m *TidbClusterStatusManager) syncConfigDrift(tc *v1alpha1.TidbCluster) {
+	if tc.Status.ConfigDrift != nil && time.Since(tc.Status.ConfigDrift.LastCheckTime.Time) < configDriftCheckInterval {
+		return
+	}
+
+	var drifts []v1alpha1.PodConfigDrift
+	drifts = append(drifts, m.pdConfigDrift(tc)...)
+	drifts = append(drifts, m.tikvConfigDrift(tc)...)
+	drifts = append(drifts, m.tiflashConfigDrift(tc)...)
+	drifts = append(drifts, m.tidbConfigDrift(tc)...)
+	sort.Slice(drifts, func(i, j int) bool {
+		if drifts[i].Component != drifts[j].Component {
+			return drifts[i].Component < drifts[j].Component
+		}
+		return drifts[i].PodName < drifts[j].PodName
+	})
+
+	lastKeys := map[string][]string{}
+	if tc.Status.ConfigDrift != nil {
+		for _, drift := range tc.Status.ConfigDrift.Pods {
+			lastKeys[drift.PodName] = drift.Keys
+		}
+	}
+	for _, drift := range drifts {
+		if reflect.DeepEqual(lastKeys[drift.PodName], drift.Keys) {
+			continue
+		}
+		m.deps.Recorder.Eventf(tc, corev1.EventTypeWarning, "ConfigDrift", "running config of %s %s differs from the rendered config: %s",
+			drift.Component, drift.PodName, strings.Join(drift.Keys, ", "))
+	}
+
+	tc.Status.ConfigDrift = &v1alpha1.ConfigDriftStatus{
+		Pods:          drifts,
+		LastCheckTime: metav1.Now(),
+	}
+	if len(drifts) > 0 {
+		message := fmt.Sprintf("running config of %d pods differs from the rendered config, see .status.configDrift", len(drifts))
+		utiltidbcluster.SetTidbClusterCondition(&tc.Status, *utiltidbcluster.NewTidbClusterCondition(
+			v1alpha1.TidbClusterConfigDrift, corev1.ConditionTrue, utiltidbcluster.ConfigDrifted, message))
+	} else {
+		utiltidbcluster.SetTidbClusterCondition(&tc.Status, *utiltidbcluster.NewTidbClusterCondition(
+			v1alpha1.TidbClusterConfigDrift, corev1.ConditionFalse, utiltidbcluster.ConfigInSync, "running config matches the rendered config"))
+	}
+}
+
+func (m *TidbClusterStatusManager) pdConfigDrift(tc *v1alpha1.TidbCluster) []v1alpha1.PodConfigDrift {
+	if tc.Spec.PD == nil || tc.Spec.PD.Config == nil || tc.PDUpgrading() || tc.Status.PD.Leader.Name == "" {
+		return nil
+	}
+	rendered, err := tc.Spec.PD.Config.MarshalTOML()
+	if err != nil {
+		klog.Warningf("failed to render pd config of %s/%s, error: %v", tc.Namespace, tc.Name, err)
+		return nil
+	}
+	config, err := controller.GetPDClient(m.deps.PDControl, tc).GetConfig()
+	if err != nil {
+		klog.Warningf("failed to get running pd config of %s/%s, error: %v", tc.Namespace, tc.Name, err)
+		return nil
+	}
+	data, err := json.Marshal(config)
+	if err != nil {
+		klog.Warningf("failed to marshal running pd config of %s/%s, error: %v", tc.Namespace, tc.Name, err)
+		return nil
+	}
+	running := map[string]interface{}{}
+	if err := json.Unmarshal(data, &running); err != nil {
+		klog.Warningf("failed to unmarshal running pd config of %s/%s, error: %v", tc.Namespace, tc.Name, err)
+		return nil
+	}
+	return podConfigDrift(tc, v1alpha1.PDMemberType, tc.Status.PD.Leader.Name, rendered, running)
+}
+
+func (m *TidbClusterStatusManager) tikvConfigDrift(tc *v1alpha1.TidbCluster) []v1alpha1.PodConfigDrift {
+	if tc.Spec.TiKV == nil || tc.Spec.TiKV.Config == nil || tc.TiKVUpgrading() {
+		return nil
+	}
+	rendered, err := tc.Spec.TiKV.Config.MarshalTOML()
+	if err != nil {
+		klog.Warningf("failed to render tikv config of %s/%s, error: %v", tc.Namespace, tc.Name, err)
+		return nil
+	}
+	var drifts []v1alpha1.PodConfigDrift
+	for _, store := range tc.Status.TiKV.Stores {
+		if store.State != v1alpha1.TiKVStateUp {
+			continue
+		}
+		running, err := m.deps.TiKVControl.GetTiKVPodClient(tc.Namespace, tc.Name, store.PodName, tc.Spec.ClusterDomain, tc.IsTLSClusterEnabled()).GetConfig()
+		if err != nil {
+			klog.Warningf("failed to get running config of tikv %s/%s, error: %v", tc.Namespace, store.PodName, err)
+			continue
+		}
+		drifts = append(drifts, podConfigDrift(tc, v1alpha1.TiKVMemberType, store.PodName, rendered, running)...)
+	}
+	return drifts
+}
+
+func (m *TidbClusterStatusManager) tiflashConfigDrift(tc *v1alpha1.TidbCluster) []v1alpha1.PodConfigDrift {
+	if tc.Spec.TiFlash == nil || tc.Spec.TiFlash.Config == nil || tc.Spec.TiFlash.Config.Proxy == nil || tc.TiFlashUpgrading() {
+		return nil
+	}
+	// only the proxy config is served by the status API of TiFlash
+	rendered, err := tc.Spec.TiFlash.Config.Proxy.MarshalTOML()
+	if err != nil {
+		klog.Warningf("failed to render tiflash proxy config of %s/%s, error: %v", tc.Namespace, tc.Name, err)
+		return nil
+	}
+	var drifts []v1alpha1.PodConfigDrift
+	for _, store := range tc.Status.TiFlash.Stores {
+		if store.State != v1alpha1.TiKVStateUp {
+			continue
+		}
+		running, err := m.deps.TiFlashControl.GetTiFlashPodClient(tc.Namespace, tc.Name, store.PodName, tc.IsTLSClusterEnabled()).GetConfig()
+		if err != nil {
+			klog.Warningf("failed to get running config of tiflash %s/%s, error: %v", tc.Namespace, store.PodName, err)
+			continue
+		}
+		drifts = append(drifts, podConfigDrift(tc, v1alpha1.TiFlashMemberType, store.PodName, rendered, running)...)
+	}
+	return drifts
+}
+
+func (m *TidbClusterStatusManager) tidbConfigDrift(tc *v1alpha1.TidbCluster) []v1alpha1.PodConfigDrift {
+	if tc.Spec.TiDB == nil || tc.Spec.TiDB.Config == nil || tc.TiDBUpgrading() {
+		return nil
+	}
+	rendered, err := tc.Spec.TiDB.Config.MarshalTOML()
+	if err != nil {
+		klog.Warningf("failed to render tidb config of %s/%s, error: %v", tc.Namespace, tc.Name, err)
+		return nil
+	}
+	var drifts []v1alpha1.PodConfigDrift
+	for name, member := range tc.Status.TiDB.Members {
+		if !member.Health {
+			continue
+		}
+		ordinal, err := util.GetOrdinalFromPodName(name)
+		if err != nil {
+			klog.Warningf("failed to parse ordinal of tidb %s/%s, error: %v", tc.Namespace, name, err)
+			continue
+		}
+		running, err := m.deps.TiDBControl.GetConfig(tc, ordinal)
+		if err != nil {
+			klog.Warningf("failed to get running config of tidb %s/%s, error: %v", tc.Namespace, name, err)
+			continue
+		}
+		drifts = append(drifts, podConfigDrift(tc, v1alpha1.TiDBMemberType, name, rendered, running)...)
+	}
+	return drifts
+}
+
+func podConfigDrift(tc *v1alpha1.TidbCluster, component v1alpha1.MemberType, podName string, rendered []byte, running map[string]interface{}) []v1alpha1.PodConfigDrift {
+	keys, err := diffRunningConfig(rendered, running)
+	if err != nil {
+		klog.Warningf("failed to compare running config of %s %s/%s, error: %v", component, tc.Namespace, podName, err)
+		return nil
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return []v1alpha1.PodConfigDrift{{Component: component, PodName: podName, Keys: keys}}
+}
+
+// diffRunningConfig returns the sorted dotted names of the items of the rendered toml config
+// whose value differs from the running config.
+func diffRunningConfig(rendered []byte, running map[string]interface{}) ([]string, error) {
+	renderedItems := map[string]interface{}{}
+	if err := toml.Unmarshal(rendered, &renderedItems); err != nil {
+		return nil, err
+	}
+	renderedFlat := map[string]interface{}{}
+	mngerutils.FlattenConfigItems("", renderedItems, renderedFlat)
+	runningFlat := map[string]interface{}{}
+	mngerutils.FlattenConfigItems("", running, runningFlat)
+
+	var keys []string
+	for k, v := range renderedFlat {
+		runningValue, ok := runningFlat[k]
+		if !ok {
+			continue
+		}
+		if !configValueEqual(v, runningValue) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// configValueEqual compares the value decoded from toml with the value decoded from json,
+// the numbers, readable sizes and durations are compared by their values.
+func configValueEqual(rendered, running interface{}) bool {
+	if reflect.DeepEqual(rendered, running) {
+		return true
+	}
+	if a, ok := configNumber(rendered); ok {
+		b, ok := configNumber(running)
+		return ok && a == b
+	}
+	switch r := rendered.(type) {
+	case string:
+		s, ok := running.(string)
+		if !ok {
+			return false
+		}
+		if a, err := time.ParseDuration(r); err == nil {
+			b, err := time.ParseDuration(s)
+			return err == nil && a == b
+		}
+		if a, ok := readableSize(r); ok {
+			b, ok := readableSize(s)
+			return ok && a == b
+		}
+		return false
+	case []interface{}:
+		s, ok := running.([]interface{})
+		if !ok || len(r) != len(s) {
+			return false
+		}
+		for i := range r {
+			if !configValueEqual(r[i], s[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func configNumber(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+// readableSize parses the readable size into bytes, the units are 1024-based as TiKV does
+func readableSize(s string) (float64, bool) {
+	matches := readableSizePattern.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(s)))
+	if matches == nil || (matches[2] == "" && matches[3] == "") {
+		return 0, false
+	}
+	n, err := strconv.ParseFloat(matches[1], 64)
+	if err != nil {
+		return 0, false
+	}
+	for _, unit := range []string{"K", "M", "G", "T", "P"} {
+		if matches[2] == "" {
+			break
+		}
+		n *= 1024
+		if matches[2] == unit {
+			break
+		}
+	}
+	return n, true
+}
@@ -0,0 +1,173 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/tiflashapi"
+	"github.com/pingcap/tidb-operator/pkg/tikvapi"
+	utiltidbcluster "github.com/pingcap/tidb-operator/pkg/util/tidbcluster"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestDiffRunningConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type testcase struct {
+		name     string
+		rendered string
+		running  map[string]interface{}
+		keys     []string
+	}
+
+	tests := []testcase{
+		{
+			name:     "equal",
+			rendered: "[raftstore]\nsync-log = true\nregion-split-check-diff = \"32MB\"\nraft-base-tick-interval = \"1s\"\n[gc]\nbatch-keys = 256\n",
+			running: map[string]interface{}{
+				"raftstore": map[string]interface{}{
+					"sync-log":                true,
+					"region-split-check-diff": "32MiB",
+					"raft-base-tick-interval": "1000ms",
+				},
+				"gc": map[string]interface{}{"batch-keys": float64(256)},
+			},
+		},
+		{
+			name:     "drifted",
+			rendered: "[raftstore]\nsync-log = true\n[storage.block-cache]\ncapacity = \"1GB\"\n[server]\nlabels = [\"a\"]\n",
+			running: map[string]interface{}{
+				"raftstore": map[string]interface{}{"sync-log": false},
+				"storage": map[string]interface{}{
+					"block-cache": map[string]interface{}{"capacity": "2GiB"},
+				},
+				"server": map[string]interface{}{"labels": []interface{}{"b"}},
+			},
+			keys: []string{"raftstore.sync-log", "server.labels", "storage.block-cache.capacity"},
+		},
+		{
+			name:     "items missing in the running config are ignored",
+			rendered: "[unknown]\nkey = 1\n",
+			running:  map[string]interface{}{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Log(test.name)
+		keys, err := diffRunningConfig([]byte(test.rendered), test.running)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(keys).To(Equal(test.keys))
+	}
+}
+
+func TestSyncConfigDrift(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	recorder := record.NewFakeRecorder(10)
+	deps.Recorder = recorder
+	m := NewTidbClusterStatusManager(deps)
+
+	tc := &v1alpha1.TidbCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "ns"},
+		Spec: v1alpha1.TidbClusterSpec{
+			TiKV:    &v1alpha1.TiKVSpec{Config: v1alpha1.NewTiKVConfig()},
+			TiDB:    &v1alpha1.TiDBSpec{Config: v1alpha1.NewTiDBConfig()},
+			TiFlash: &v1alpha1.TiFlashSpec{Config: v1alpha1.NewTiFlashConfig()},
+		},
+		Status: v1alpha1.TidbClusterStatus{
+			TiKV: v1alpha1.TiKVStatus{
+				Stores: map[string]v1alpha1.TiKVStore{
+					"1": {ID: "1", PodName: "demo-tikv-0", State: v1alpha1.TiKVStateUp},
+					"2": {ID: "2", PodName: "demo-tikv-1", State: v1alpha1.TiKVStateUp},
+					"3": {ID: "3", PodName: "demo-tikv-2", State: v1alpha1.TiKVStateDown},
+				},
+			},
+			TiFlash: v1alpha1.TiFlashStatus{
+				Stores: map[string]v1alpha1.TiKVStore{
+					"4": {ID: "4", PodName: "demo-tiflash-0", State: v1alpha1.TiKVStateUp},
+				},
+			},
+			TiDB: v1alpha1.TiDBStatus{
+				Members: map[string]v1alpha1.TiDBMember{
+					"demo-tidb-0": {Name: "demo-tidb-0", Health: true},
+				},
+			},
+		},
+	}
+	tc.Spec.TiKV.Config.Set("raftstore.sync-log", true)
+	tc.Spec.TiDB.Config.Set("log.level", "info")
+	tc.Spec.TiFlash.Config.Proxy.Set("server.grpc-concurrency", 4)
+
+	tikvControl := deps.TiKVControl.(*tikvapi.FakeTiKVControl)
+	for pod, syncLog := range map[string]bool{"demo-tikv-0": true, "demo-tikv-1": false} {
+		syncLog := syncLog
+		client := tikvapi.NewFakeTiKVClient()
+		client.AddReaction(tikvapi.GetConfigActionType, func(action *tikvapi.Action) (interface{}, error) {
+			return map[string]interface{}{"raftstore": map[string]interface{}{"sync-log": syncLog}}, nil
+		})
+		tikvControl.SetTiKVPodClient(tc.Namespace, tc.Name, pod, client)
+	}
+	tiflashClient := tiflashapi.NewFakeTiFlashClient()
+	tiflashClient.AddReaction(tiflashapi.GetConfigActionType, func(action *tiflashapi.Action) (interface{}, error) {
+		return map[string]interface{}{"server": map[string]interface{}{"grpc-concurrency": float64(4)}}, nil
+	})
+	deps.TiFlashControl.(*tiflashapi.FakeTiFlashControl).SetTiFlashPodClient(tc.Namespace, tc.Name, "demo-tiflash-0", tiflashClient)
+	tidbControl := deps.TiDBControl.(*controller.FakeTiDBControl)
+	tidbControl.SetConfig(map[string]map[string]interface{}{
+		"demo-tidb-0": {"log": map[string]interface{}{"level": "warn"}},
+	})
+
+	m.syncConfigDrift(tc)
+	g.Expect(tc.Status.ConfigDrift).NotTo(BeNil())
+	g.Expect(tc.Status.ConfigDrift.Pods).To(Equal([]v1alpha1.PodConfigDrift{
+		{Component: v1alpha1.TiDBMemberType, PodName: "demo-tidb-0", Keys: []string{"log.level"}},
+		{Component: v1alpha1.TiKVMemberType, PodName: "demo-tikv-1", Keys: []string{"raftstore.sync-log"}},
+	}))
+	cond := utiltidbcluster.GetTidbClusterCondition(tc.Status, v1alpha1.TidbClusterConfigDrift)
+	g.Expect(cond).NotTo(BeNil())
+	g.Expect(cond.Status).To(Equal(corev1.ConditionTrue))
+	g.Expect(cond.Reason).To(Equal(utiltidbcluster.ConfigDrifted))
+	g.Expect(collectEvents(recorder.Events)).To(HaveLen(2))
+
+	// not checked again within the interval
+	tidbControl.SetConfig(map[string]map[string]interface{}{
+		"demo-tidb-0": {"log": map[string]interface{}{"level": "info"}},
+	})
+	m.syncConfigDrift(tc)
+	g.Expect(tc.Status.ConfigDrift.Pods).To(HaveLen(2))
+
+	// no event for the pods whose drifted items are not changed
+	tc.Status.ConfigDrift.LastCheckTime = metav1.NewTime(time.Now().Add(-configDriftCheckInterval))
+	m.syncConfigDrift(tc)
+	g.Expect(tc.Status.ConfigDrift.Pods).To(Equal([]v1alpha1.PodConfigDrift{
+		{Component: v1alpha1.TiKVMemberType, PodName: "demo-tikv-1", Keys: []string{"raftstore.sync-log"}},
+	}))
+	g.Expect(collectEvents(recorder.Events)).To(BeEmpty())
+
+	tc.Spec.TiKV.Config.Set("raftstore.sync-log", false)
+	tc.Status.TiKV.Stores["1"] = v1alpha1.TiKVStore{ID: "1", PodName: "demo-tikv-0", State: v1alpha1.TiKVStateDown}
+	tc.Status.ConfigDrift.LastCheckTime = metav1.NewTime(time.Now().Add(-configDriftCheckInterval))
+	m.syncConfigDrift(tc)
+	g.Expect(tc.Status.ConfigDrift.Pods).To(BeEmpty())
+	cond = utiltidbcluster.GetTidbClusterCondition(tc.Status, v1alpha1.TidbClusterConfigDrift)
+	g.Expect(cond.Status).To(Equal(corev1.ConditionFalse))
+}
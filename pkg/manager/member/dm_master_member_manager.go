@@ -29,6 +29,7 @@ import (
 	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
 	"github.com/pingcap/tidb-operator/pkg/third_party/k8s"
 	"github.com/pingcap/tidb-operator/pkg/util"
+	"github.com/pingcap/tidb-operator/pkg/util/cmpver"
 
 	apps "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -50,6 +51,12 @@ const (
 	DefaultStorageSize = "10Gi"
 )
 
+var (
+	// the OpenAPI of dm-master is introduced as an experimental feature in v5.3.0, and is GA in v6.0.0
+	dmMasterOpenAPIExperimental, _ = cmpver.NewConstraint(cmpver.GreaterOrEqual, "v5.3.0")
+	dmMasterOpenAPIGA, _           = cmpver.NewConstraint(cmpver.GreaterOrEqual, "v6.0.0")
+)
+
 type masterMemberManager struct {
 	deps      *controller.Dependencies
 	scaler    Scaler
@@ -767,6 +774,16 @@ func getMasterConfigMap(dc *v1alpha1.DMCluster) (*corev1.ConfigMap, error) {
 		config.Set("ssl-key", path.Join(dmMasterClusterCertPath, corev1.TLSPrivateKeyKey))
 	}
 
+	// enable the OpenAPI used to reconcile DMSource and DMTask unless it's set by users
+	if config.Get("openapi") == nil && config.Get("experimental.openapi") == nil {
+		version := dc.MasterVersion()
+		if ok, err := dmMasterOpenAPIGA.Check(version); err == nil && ok {
+			config.Set("openapi", true)
+		} else if ok, err := dmMasterOpenAPIExperimental.Check(version); err == nil && ok {
+			config.Set("experimental.openapi", true)
+		}
+	}
+
 	confText, err := config.MarshalTOML()
 	if err != nil {
 		return nil, err
@@ -1441,7 +1441,7 @@ func TestGetMasterConfigMap(t *testing.T) {
 				},
 				Data: map[string]string{
 					"startup-script": "",
-					"config-file":    "",
+					"config-file":    "openapi = true\n",
 				},
 			},
 		},
@@ -1491,6 +1491,7 @@ func TestGetMasterConfigMap(t *testing.T) {
 				Data: map[string]string{
 					"startup-script": "",
 					"config-file": `log-level = "debug"
+openapi = true
 rpc-rate-limit = 15.0
 rpc-timeout = "40s"
 `,
@@ -2309,3 +2310,42 @@ func mustMasterConfig(x interface{}) *v1alpha1.MasterConfigWraper {
 
 	return c
 }
+
+func TestGetMasterConfigMapOpenAPI(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	newDMCluster := func(version string, config *v1alpha1.MasterConfigWraper) *v1alpha1.DMCluster {
+		return &v1alpha1.DMCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "ns"},
+			Spec: v1alpha1.DMClusterSpec{
+				Master:  v1alpha1.MasterSpec{BaseImage: "pingcap/dm", Config: config},
+				Worker:  &v1alpha1.WorkerSpec{},
+				Version: version,
+			},
+		}
+	}
+	getConfig := func(dc *v1alpha1.DMCluster) *v1alpha1.MasterConfigWraper {
+		cm, err := getMasterConfigMap(dc)
+		g.Expect(err).To(Succeed())
+		config := v1alpha1.NewMasterConfig()
+		g.Expect(config.UnmarshalTOML([]byte(cm.Data["config-file"]))).To(Succeed())
+		return config
+	}
+
+	config := getConfig(newDMCluster("v6.5.0", nil))
+	g.Expect(config.Get("openapi").Interface()).To(Equal(true))
+
+	config = getConfig(newDMCluster("v5.4.0", nil))
+	g.Expect(config.Get("openapi")).To(BeNil())
+	g.Expect(config.Get("experimental.openapi").Interface()).To(Equal(true))
+
+	config = getConfig(newDMCluster("v2.0.7", nil))
+	g.Expect(config.Get("openapi")).To(BeNil())
+	g.Expect(config.Get("experimental.openapi")).To(BeNil())
+
+	// the config set by users is kept
+	userConfig := v1alpha1.NewMasterConfig()
+	userConfig.Set("openapi", false)
+	config = getConfig(newDMCluster("v6.5.0", userConfig))
+	g.Expect(config.Get("openapi").Interface()).To(Equal(false))
+}
@@ -0,0 +1,206 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
+
+	"github.com/pingcap/advanced-statefulset/client/apis/apps/v1/helper"
+	perrors "github.com/pingcap/errors"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/klog/v2"
+)
+
+// pdOnlineConfigPrefixes are the config items that PD supports changing through `pd/api/v1/config`,
+// the changes are persisted by PD and take effect on all the members.
+var pdOnlineConfigPrefixes = []string{
+	"log.level",
+	"schedule.",
+	"replication.",
+	"pd-server.",
+}
+
+// tikvOnlineConfigPrefixes are the config items that TiKV supports changing through the `/config` status API,
+// see https://docs.pingcap.com/tidb/stable/dynamic-config#modify-tikv-configuration-dynamically.
+var tikvOnlineConfigPrefixes = []string{
+	"raftstore.",
+	"coprocessor.",
+	"pessimistic-txn.",
+	"gc.",
+	"split.",
+	"rocksdb.",
+	"raftdb.",
+	"readpool.",
+	"backup.",
+	"log-backup.",
+	"cdc.",
+	"resolved-ts.",
+	"quota.",
+	"server.grpc-memory-pool-quota",
+	"server.max-grpc-send-msg-len",
+	"server.raft-msg-max-batch-size",
+	"storage.block-cache.capacity",
+	"storage.scheduler-pending-write-threshold",
+	"storage.flow-control.",
+	"storage.io-rate-limit.max-bytes-per-sec",
+}
+
+// tidbOnlineSettings maps the config items that TiDB supports changing through the `/settings` status API
+// to the form parameters of the API.
+var tidbOnlineSettings = map[string]string{
+	"log.level":                                          "log_level",
+	"instance.tidb_general_log":                          "tidb_general_log",
+	"instance.ddl_slow_threshold":                        "ddl_slow_threshold",
+	"check-mb4-value-in-utf8":                            "check_mb4_value_in_utf8",
+	"pessimistic-txn.deadlock-history-capacity":          "deadlock_history_capacity",
+	"pessimistic-txn.deadlock-history-collect-retryable": "deadlock_history_collect_retryable",
+}
+
+// onlineConfigApplier applies the changed config items to the running component
+type onlineConfigApplier func(items map[string]interface{}) error
+
+func hasOnlineConfigPrefix(prefixes []string) func(string) bool {
+	return func(key string) bool {
+		for _, p := range prefixes {
+			if key == p || (strings.HasSuffix(p, ".") && strings.HasPrefix(key, p)) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func isTiDBOnlineConfigItem(key string) bool {
+	_, ok := tidbOnlineSettings[key]
+	return ok
+}
+
+// syncOnlineConfig is used by the Online config update strategy.
+// It diffs the in-use configmap and the desired one, applies the changed items that can be changed
+// at runtime through apply and returns the strategy to update the configmap with: InPlace if all
+// the changes are applied online so that the pods are not restarted, otherwise RollingUpdate.
+// The returned status is nil if nothing is changed.
+func syncOnlineConfig(
+	cmLister corelisters.ConfigMapLister,
+	inUseName string,
+	desired *corev1.ConfigMap,
+	isOnlineItem func(string) bool,
+	apply onlineConfigApplier,
+) (v1alpha1.ConfigUpdateStrategy, *v1alpha1.OnlineConfigStatus, error) {
+	existing, err := cmLister.ConfigMaps(desired.Namespace).Get(inUseName)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return v1alpha1.ConfigUpdateStrategyRollingUpdate, nil, nil
+		}
+		return "", nil, perrors.AddStack(err)
+	}
+
+	changed, restartKeys, err := mngerutils.DiffConfigItems(existing.Data["config-file"], desired.Data["config-file"])
+	if err != nil {
+		return "", nil, perrors.Annotatef(err, "diff config of configmap %s/%s", existing.Namespace, existing.Name)
+	}
+	if existing.Data["startup-script"] != desired.Data["startup-script"] {
+		restartKeys = append(restartKeys, "startup-script")
+	}
+
+	onlineItems := map[string]interface{}{}
+	for k, v := range changed {
+		if isOnlineItem(k) {
+			onlineItems[k] = v
+		} else {
+			restartKeys = append(restartKeys, k)
+		}
+	}
+	if len(onlineItems) == 0 && len(restartKeys) == 0 {
+		return v1alpha1.ConfigUpdateStrategyRollingUpdate, nil, nil
+	}
+
+	status := &v1alpha1.OnlineConfigStatus{LastAppliedTime: metav1.Now()}
+	var appliedKeys []string
+	for k := range onlineItems {
+		appliedKeys = append(appliedKeys, k)
+	}
+	if len(onlineItems) > 0 {
+		// fall back to rolling update if the component rejects the change, the restarted pods load the new config anyway
+		if err := apply(onlineItems); err != nil {
+			klog.Warningf("failed to apply config %v of configmap %s/%s online, fall back to rolling update, error: %v",
+				appliedKeys, desired.Namespace, desired.Name, err)
+			status.Error = err.Error()
+			restartKeys = append(restartKeys, appliedKeys...)
+			appliedKeys = nil
+		}
+	}
+	sort.Strings(appliedKeys)
+	sort.Strings(restartKeys)
+	status.AppliedKeys = appliedKeys
+	status.RestartKeys = restartKeys
+
+	if len(restartKeys) > 0 {
+		return v1alpha1.ConfigUpdateStrategyRollingUpdate, status, nil
+	}
+	klog.Infof("config %v of configmap %s/%s are applied online", appliedKeys, desired.Namespace, desired.Name)
+	return v1alpha1.ConfigUpdateStrategyInPlace, status, nil
+}
+
+func pdOnlineConfigApplier(deps *controller.Dependencies, tc *v1alpha1.TidbCluster) onlineConfigApplier {
+	return func(items map[string]interface{}) error {
+		return controller.GetPDClient(deps.PDControl, tc).UpdateConfig(items)
+	}
+}
+
+func tikvOnlineConfigApplier(deps *controller.Dependencies, tc *v1alpha1.TidbCluster, set *apps.StatefulSet) onlineConfigApplier {
+	return func(items map[string]interface{}) error {
+		for id := range helper.GetPodOrdinals(*set.Spec.Replicas, set) {
+			podName := fmt.Sprintf("%s-%d", controller.TiKVMemberName(tc.GetName()), id)
+			client := deps.TiKVControl.GetTiKVPodClient(tc.GetNamespace(), tc.GetName(), podName, tc.Spec.ClusterDomain, tc.IsTLSClusterEnabled())
+			if err := client.UpdateConfig(items); err != nil {
+				return fmt.Errorf("update config of tikv %s/%s failed: %v", tc.GetNamespace(), podName, err)
+			}
+		}
+		return nil
+	}
+}
+
+func tidbOnlineConfigApplier(deps *controller.Dependencies, tc *v1alpha1.TidbCluster, set *apps.StatefulSet) onlineConfigApplier {
+	return func(items map[string]interface{}) error {
+		settings := map[string]string{}
+		for k, v := range items {
+			switch value := v.(type) {
+			case bool:
+				settings[tidbOnlineSettings[k]] = "0"
+				if value {
+					settings[tidbOnlineSettings[k]] = "1"
+				}
+			default:
+				settings[tidbOnlineSettings[k]] = fmt.Sprint(value)
+			}
+		}
+		for id := range helper.GetPodOrdinals(*set.Spec.Replicas, set) {
+			if err := deps.TiDBControl.SetSettings(tc, id, settings); err != nil {
+				return fmt.Errorf("update settings of tidb %s/%s-%d failed: %v", tc.GetNamespace(), controller.TiDBMemberName(tc.GetName()), id, err)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/tikvapi"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+func TestSyncOnlineConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type testcase struct {
+		name         string
+		existing     string
+		desired      string
+		script       string
+		applyErr     error
+		strategy     v1alpha1.ConfigUpdateStrategy
+		applied      map[string]interface{}
+		appliedKeys  []string
+		restartKeys  []string
+		expectError  bool
+		expectStatus bool
+	}
+
+	tests := []testcase{
+		{
+			name:     "nothing changed",
+			existing: "[raftstore]\nsync-log = true\n",
+			desired:  "[raftstore]\nsync-log = true\n",
+			strategy: v1alpha1.ConfigUpdateStrategyRollingUpdate,
+		},
+		{
+			name:         "all changes are applied online",
+			existing:     "[raftstore]\nsync-log = true\n",
+			desired:      "[raftstore]\nsync-log = false\n[gc]\nbatch-keys = 256\n",
+			strategy:     v1alpha1.ConfigUpdateStrategyInPlace,
+			applied:      map[string]interface{}{"raftstore.sync-log": false, "gc.batch-keys": int64(256)},
+			appliedKeys:  []string{"gc.batch-keys", "raftstore.sync-log"},
+			expectStatus: true,
+		},
+		{
+			name:         "some changes need restart",
+			existing:     "[raftstore]\nsync-log = true\n[server]\ngrpc-concurrency = 4\n",
+			desired:      "[raftstore]\nsync-log = false\n",
+			strategy:     v1alpha1.ConfigUpdateStrategyRollingUpdate,
+			applied:      map[string]interface{}{"raftstore.sync-log": false},
+			appliedKeys:  []string{"raftstore.sync-log"},
+			restartKeys:  []string{"server.grpc-concurrency"},
+			expectStatus: true,
+		},
+		{
+			name:         "startup script changed",
+			existing:     "[raftstore]\nsync-log = true\n",
+			desired:      "[raftstore]\nsync-log = true\n",
+			script:       "changed",
+			strategy:     v1alpha1.ConfigUpdateStrategyRollingUpdate,
+			restartKeys:  []string{"startup-script"},
+			expectStatus: true,
+		},
+		{
+			name:         "fall back to rolling update if apply failed",
+			existing:     "[raftstore]\nsync-log = true\n",
+			desired:      "[raftstore]\nsync-log = false\n",
+			applyErr:     fmt.Errorf("config item not support"),
+			strategy:     v1alpha1.ConfigUpdateStrategyRollingUpdate,
+			applied:      map[string]interface{}{"raftstore.sync-log": false},
+			restartKeys:  []string{"raftstore.sync-log"},
+			expectStatus: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Log(test.name)
+		deps := controller.NewFakeDependencies()
+		existing := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo-tikv-1234", Namespace: "ns"},
+			Data:       map[string]string{"config-file": test.existing, "startup-script": "script"},
+		}
+		g.Expect(deps.LabelFilterKubeInformerFactory.Core().V1().ConfigMaps().Informer().GetIndexer().Add(existing)).To(Succeed())
+		script := "script"
+		if test.script != "" {
+			script = test.script
+		}
+		desired := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo-tikv", Namespace: "ns"},
+			Data:       map[string]string{"config-file": test.desired, "startup-script": script},
+		}
+
+		var applied map[string]interface{}
+		apply := func(items map[string]interface{}) error {
+			applied = items
+			return test.applyErr
+		}
+		strategy, status, err := syncOnlineConfig(deps.ConfigMapLister, existing.Name, desired, hasOnlineConfigPrefix(tikvOnlineConfigPrefixes), apply)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(strategy).To(Equal(test.strategy))
+		g.Expect(applied).To(Equal(test.applied))
+		if !test.expectStatus {
+			g.Expect(status).To(BeNil())
+			continue
+		}
+		g.Expect(status.AppliedKeys).To(Equal(test.appliedKeys))
+		g.Expect(status.RestartKeys).To(Equal(test.restartKeys))
+		g.Expect(status.Error != "").To(Equal(test.applyErr != nil))
+	}
+
+	// the in-use configmap is not found
+	deps := controller.NewFakeDependencies()
+	desired := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "demo-tikv", Namespace: "ns"}}
+	strategy, status, err := syncOnlineConfig(deps.ConfigMapLister, "demo-tikv-1234", desired, hasOnlineConfigPrefix(tikvOnlineConfigPrefixes), nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(strategy).To(Equal(v1alpha1.ConfigUpdateStrategyRollingUpdate))
+	g.Expect(status).To(BeNil())
+}
+
+func TestOnlineConfigItems(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	isPDOnline := hasOnlineConfigPrefix(pdOnlineConfigPrefixes)
+	g.Expect(isPDOnline("schedule.leader-schedule-limit")).To(BeTrue())
+	g.Expect(isPDOnline("log.level")).To(BeTrue())
+	g.Expect(isPDOnline("log.file.filename")).To(BeFalse())
+	g.Expect(isPDOnline("schedule")).To(BeFalse())
+
+	isTiKVOnline := hasOnlineConfigPrefix(tikvOnlineConfigPrefixes)
+	g.Expect(isTiKVOnline("rocksdb.defaultcf.block-cache-size")).To(BeTrue())
+	g.Expect(isTiKVOnline("storage.block-cache.capacity")).To(BeTrue())
+	g.Expect(isTiKVOnline("storage.data-dir")).To(BeFalse())
+
+	g.Expect(isTiDBOnlineConfigItem("log.level")).To(BeTrue())
+	g.Expect(isTiDBOnlineConfigItem("log.file.max-size")).To(BeFalse())
+}
+
+func TestOnlineConfigApplier(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	tc := &v1alpha1.TidbCluster{ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "ns"}}
+	set := &apps.StatefulSet{Spec: apps.StatefulSetSpec{Replicas: pointer.Int32Ptr(2)}}
+
+	// tidb
+	err := tidbOnlineConfigApplier(deps, tc, set)(map[string]interface{}{
+		"log.level":                 "warn",
+		"instance.tidb_general_log": true,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	tidbControl := deps.TiDBControl.(*controller.FakeTiDBControl)
+	for _, pod := range []string{"demo-tidb-0", "demo-tidb-1"} {
+		g.Expect(tidbControl.GetSettings(pod)).To(Equal(map[string]string{"log_level": "warn", "tidb_general_log": "1"}))
+	}
+	tidbControl.SetSettingsErr(fmt.Errorf("failed"))
+	g.Expect(tidbOnlineConfigApplier(deps, tc, set)(map[string]interface{}{"log.level": "info"})).To(HaveOccurred())
+
+	// tikv
+	tikvControl := deps.TiKVControl.(*tikvapi.FakeTiKVControl)
+	updated := map[string]interface{}{}
+	for _, pod := range []string{"demo-tikv-0", "demo-tikv-1"} {
+		pod := pod
+		client := tikvapi.NewFakeTiKVClient()
+		client.AddReaction(tikvapi.UpdateConfigActionType, func(action *tikvapi.Action) (interface{}, error) {
+			updated[pod] = action.Config["raftstore.sync-log"]
+			return nil, nil
+		})
+		tikvControl.SetTiKVPodClient(tc.Namespace, tc.Name, pod, client)
+	}
+	err = tikvOnlineConfigApplier(deps, tc, set)(map[string]interface{}{"raftstore.sync-log": false})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(updated).To(Equal(map[string]interface{}{"demo-tikv-0": false, "demo-tikv-1": false}))
+}
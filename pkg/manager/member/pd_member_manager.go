@@ -458,7 +458,19 @@ func (m *pdMemberManager) syncPDConfigMap(tc *v1alpha1.TidbCluster, set *apps.St
 		}
 	}
 
-	err = mngerutils.UpdateConfigMapIfNeed(m.deps.ConfigMapLister, tc.BasePDSpec().ConfigUpdateStrategy(), inUseName, newCm)
+	strategy := tc.BasePDSpec().ConfigUpdateStrategy()
+	if strategy == v1alpha1.ConfigUpdateStrategyOnline && set != nil {
+		var onlineStatus *v1alpha1.OnlineConfigStatus
+		strategy, onlineStatus, err = syncOnlineConfig(m.deps.ConfigMapLister, inUseName, newCm, hasOnlineConfigPrefix(pdOnlineConfigPrefixes), pdOnlineConfigApplier(m.deps, tc))
+		if err != nil {
+			return nil, err
+		}
+		if onlineStatus != nil {
+			tc.Status.PD.OnlineConfig = onlineStatus
+		}
+	}
+
+	err = mngerutils.UpdateConfigMapIfNeed(m.deps.ConfigMapLister, strategy, inUseName, newCm)
 	if err != nil {
 		return nil, err
 	}
@@ -733,6 +745,7 @@ func getNewPDSetForTidbCluster(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (
 	stsLabels := label.New().Instance(instanceName).PD()
 	podLabels := util.CombineStringMap(stsLabels, basePDSpec.Labels())
 	podAnnotations := util.CombineStringMap(basePDSpec.Annotations(), controller.AnnProm(v1alpha1.DefaultPDClientPort, "/metrics"))
+	podAnnotations = util.CombineStringMap(podAnnotations, tlsCertHashAnnotation(tc, label.PDLabelVal))
 	stsAnnotations := getStsAnnotations(tc.Annotations, label.PDLabelVal)
 
 	deleteSlotsNumber, err := util.GetDeleteSlotsNumber(stsAnnotations)
@@ -0,0 +1,114 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/manager"
+)
+
+// pdbManager creates the PodDisruptionBudgets of the components, the eviction of the pods
+// is further checked by the pod eviction admission webhook to evict the leaders first.
+type pdbManager struct {
+	deps *controller.Dependencies
+}
+
+// NewPDBManager returns a manager that syncs the PodDisruptionBudgets of the components
+func NewPDBManager(deps *controller.Dependencies) manager.Manager {
+	return &pdbManager{deps: deps}
+}
+
+func (m *pdbManager) Sync(tc *v1alpha1.TidbCluster) error {
+	components := map[v1alpha1.MemberType]bool{
+		v1alpha1.PDMemberType:      tc.Spec.PD != nil,
+		v1alpha1.TiKVMemberType:    tc.Spec.TiKV != nil,
+		v1alpha1.TiDBMemberType:    tc.Spec.TiDB != nil,
+		v1alpha1.TiFlashMemberType: tc.Spec.TiFlash != nil,
+		v1alpha1.TiCDCMemberType:   tc.Spec.TiCDC != nil,
+	}
+	for memberType, exist := range components {
+		if exist && tc.IsPodDisruptionBudgetEnabled() {
+			if err := m.syncPDB(tc, memberType); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := m.deletePDB(tc, memberType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *pdbManager) syncPDB(tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType) error {
+	maxUnavailable := tc.PodDisruptionBudgetMaxUnavailable()
+	podLabels := label.New().Instance(tc.Name).Component(memberType.String())
+	pdb := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      controller.MemberName(tc.Name, memberType),
+			Namespace: tc.Namespace,
+			Labels:    podLabels.Copy(),
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MaxUnavailable: &maxUnavailable,
+			Selector:       podLabels.LabelSelector(),
+		},
+	}
+	if _, err := m.deps.TypedControl.CreateOrUpdatePodDisruptionBudget(tc, pdb); err != nil {
+		return fmt.Errorf("syncPDB: failed to sync pdb %s/%s for cluster %s/%s, error: %s", pdb.Namespace, pdb.Name, tc.Namespace, tc.Name, err)
+	}
+	return nil
+}
+
+func (m *pdbManager) deletePDB(tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType) error {
+	pdb := &policyv1.PodDisruptionBudget{}
+	key := types.NamespacedName{Namespace: tc.Namespace, Name: controller.MemberName(tc.Name, memberType)}
+	exist, err := m.deps.GenericControl.Exist(key, pdb)
+	if err != nil {
+		return fmt.Errorf("deletePDB: failed to get pdb %s for cluster %s/%s, error: %s", key, tc.Namespace, tc.Name, err)
+	}
+	if !exist || !metav1.IsControlledBy(pdb, tc) {
+		return nil
+	}
+	klog.Infof("deletePDB: delete pdb %s for cluster %s/%s", key, tc.Namespace, tc.Name)
+	if err := m.deps.TypedControl.Delete(tc, pdb); err != nil {
+		return fmt.Errorf("deletePDB: failed to delete pdb %s for cluster %s/%s, error: %s", key, tc.Namespace, tc.Name, err)
+	}
+	return nil
+}
+
+type FakePDBManager struct {
+	err error
+}
+
+func NewFakePDBManager() *FakePDBManager {
+	return &FakePDBManager{}
+}
+
+func (m *FakePDBManager) SetSyncError(err error) {
+	m.err = err
+}
+
+func (m *FakePDBManager) Sync(_ *v1alpha1.TidbCluster) error {
+	return m.err
+}
@@ -0,0 +1,78 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+)
+
+func TestPDBManagerSync(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	m := NewPDBManager(deps)
+	genericCli := deps.GenericControl.(*controller.FakeGenericControl).FakeCli
+	getPDB := func(name string) (*policyv1.PodDisruptionBudget, error) {
+		pdb := &policyv1.PodDisruptionBudget{}
+		err := genericCli.Get(context.TODO(), types.NamespacedName{Namespace: "ns", Name: name}, pdb)
+		return pdb, err
+	}
+
+	tc := &v1alpha1.TidbCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "ns", UID: "demo-uid"},
+		Spec: v1alpha1.TidbClusterSpec{
+			PD:   &v1alpha1.PDSpec{},
+			TiKV: &v1alpha1.TiKVSpec{},
+		},
+	}
+
+	// disabled by default
+	g.Expect(m.Sync(tc)).To(Succeed())
+	_, err := getPDB("demo-pd")
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+
+	maxUnavailable := intstr.FromString("20%")
+	tc.Spec.PodDisruptionBudget = &v1alpha1.PodDisruptionBudgetSpec{Enabled: true, MaxUnavailable: &maxUnavailable}
+	g.Expect(m.Sync(tc)).To(Succeed())
+	pdb, err := getPDB("demo-tikv")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(*pdb.Spec.MaxUnavailable).To(Equal(maxUnavailable))
+	g.Expect(pdb.Spec.Selector.MatchLabels).To(HaveKeyWithValue(label.ComponentLabelKey, label.TiKVLabelVal))
+	g.Expect(pdb.Spec.Selector.MatchLabels).To(HaveKeyWithValue(label.InstanceLabelKey, "demo"))
+	g.Expect(metav1.IsControlledBy(pdb, tc)).To(BeTrue())
+	_, err = getPDB("demo-pd")
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = getPDB("demo-tidb")
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+
+	// PDBs are deleted after disabled
+	tc.Spec.PodDisruptionBudget.Enabled = false
+	g.Expect(m.Sync(tc)).To(Succeed())
+	for _, name := range []string{"demo-pd", "demo-tikv"} {
+		_, err = getPDB(name)
+		g.Expect(errors.IsNotFound(err)).To(BeTrue(), name)
+	}
+}
@@ -563,7 +563,19 @@ func (m *tidbMemberManager) syncTiDBConfigMap(tc *v1alpha1.TidbCluster, set *app
 
 	klog.V(3).Info("get tidb in use config map name: ", inUseName)
 
-	err = mngerutils.UpdateConfigMapIfNeed(m.deps.ConfigMapLister, tc.BaseTiDBSpec().ConfigUpdateStrategy(), inUseName, newCm)
+	strategy := tc.BaseTiDBSpec().ConfigUpdateStrategy()
+	if strategy == v1alpha1.ConfigUpdateStrategyOnline && set != nil {
+		var onlineStatus *v1alpha1.OnlineConfigStatus
+		strategy, onlineStatus, err = syncOnlineConfig(m.deps.ConfigMapLister, inUseName, newCm, isTiDBOnlineConfigItem, tidbOnlineConfigApplier(m.deps, tc, set))
+		if err != nil {
+			return nil, err
+		}
+		if onlineStatus != nil {
+			tc.Status.TiDB.OnlineConfig = onlineStatus
+		}
+	}
+
+	err = mngerutils.UpdateConfigMapIfNeed(m.deps.ConfigMapLister, strategy, inUseName, newCm)
 	if err != nil {
 		return nil, err
 	}
@@ -1090,6 +1102,7 @@ func getNewTiDBSetForTidbCluster(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap)
 	stsLabels := label.New().Instance(instanceName).TiDB()
 	podLabels := util.CombineStringMap(stsLabels, baseTiDBSpec.Labels())
 	podAnnotations := util.CombineStringMap(baseTiDBSpec.Annotations(), controller.AnnProm(v1alpha1.DefaultTiDBStatusPort, "/metrics"))
+	podAnnotations = util.CombineStringMap(podAnnotations, tlsCertHashAnnotation(tc, label.TiDBLabelVal))
 	stsAnnotations := getStsAnnotations(tc.Annotations, label.TiDBLabelVal)
 
 	deleteSlotsNumber, err := util.GetDeleteSlotsNumber(stsAnnotations)
@@ -1176,6 +1189,7 @@ func (m *tidbMemberManager) syncTidbClusterStatus(tc *v1alpha1.TidbCluster, set
 		newTidbMember.LastTransitionTime = metav1.Now()
 		if exist {
 			newTidbMember.NodeName = oldTidbMember.NodeName
+			newTidbMember.Drain = oldTidbMember.Drain
 			if oldTidbMember.Health == newTidbMember.Health {
 				newTidbMember.LastTransitionTime = oldTidbMember.LastTransitionTime
 			}
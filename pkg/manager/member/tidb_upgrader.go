@@ -103,6 +103,7 @@ func (u *tidbUpgrader) Upgrade(tc *v1alpha1.TidbCluster, oldSet *apps.StatefulSe
 
 	mngerutils.SetUpgradePartition(newSet, *oldSet.Spec.UpdateStrategy.RollingUpdate.Partition)
 	podOrdinals := helper.GetPodOrdinals(*oldSet.Spec.Replicas, oldSet).List()
+	upgraded := int32(0)
 	for _i := len(podOrdinals) - 1; _i >= 0; _i-- {
 		i := podOrdinals[_i]
 		podName := tidbPodName(tcName, i)
@@ -126,15 +127,67 @@ func (u *tidbUpgrader) Upgrade(tc *v1alpha1.TidbCluster, oldSet *apps.StatefulSe
 			}
 			if member, exist := tc.Status.TiDB.Members[podName]; !exist || !member.Health {
 				return controller.RequeueErrorf("tidbcluster: [%s/%s]'s tidb upgraded pod: [%s] is not ready", ns, tcName, podName)
+			} else if member.Drain != nil && member.Drain.Phase == v1alpha1.TiDBDrainPhaseDraining {
+				klog.Infof("tidbcluster: [%s/%s] tidb pod %s is drained and upgraded", ns, tcName, podName)
+				member.Drain.Phase = v1alpha1.TiDBDrainPhaseDrained
+				member.Drain.LastTransitionTime = metav1.Now()
+				tc.Status.TiDB.Members[podName] = member
 			}
+			upgraded++
 			continue
 		}
+
+		if err := checkUpgradeStage(u.deps, tc, v1alpha1.TiDBMemberType, &tc.Status.TiDB.UpgradeStage, tc.Status.TiDB.StatefulSet.UpdateRevision, upgraded); err != nil {
+			return err
+		}
+
+		if tc.Spec.TiProxy != nil && tc.Spec.TiProxy.Replicas > 0 {
+			u.drainTiDBPod(tc, i)
+		}
 		return u.upgradeTiDBPod(tc, i, newSet)
 	}
 
 	return nil
 }
 
+// drainTiDBPod records the drain of the tidb pod in the status of the tidb member before it's upgraded.
+// TiProxy migrates the sessions away from a tidb pod once the pod enters the graceful wait before shutdown
+// and fails the health check of tiproxy, so the pod is drained while it's shut down for the upgrade.
+// The drain is skipped if the graceful wait is not configured, as tiproxy can't migrate the sessions then.
+// The drain restarts if it's recorded for another update revision, which is left by the last upgrade.
+func (u *tidbUpgrader) drainTiDBPod(tc *v1alpha1.TidbCluster, ordinal int32) {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	podName := tidbPodName(tcName, ordinal)
+
+	if tc.TiDBGracefulWaitBeforeShutdown() <= 0 {
+		klog.V(4).Infof("tidbcluster: [%s/%s] graceful-wait-before-shutdown of tidb is not set, skip draining tidb pod %s through tiproxy", ns, tcName, podName)
+		return
+	}
+
+	if tc.Status.TiDB.Members == nil {
+		tc.Status.TiDB.Members = map[string]v1alpha1.TiDBMember{}
+	}
+	member, exist := tc.Status.TiDB.Members[podName]
+	if !exist {
+		member = v1alpha1.TiDBMember{Name: podName}
+	}
+	updateRevision := tc.Status.TiDB.StatefulSet.UpdateRevision
+	if member.Drain != nil && member.Drain.Revision == updateRevision {
+		return
+	}
+
+	klog.Infof("tidbcluster: [%s/%s] start draining tidb pod %s through tiproxy", ns, tcName, podName)
+	now := metav1.Now()
+	member.Drain = &v1alpha1.TiDBDrainStatus{
+		Phase:              v1alpha1.TiDBDrainPhaseDraining,
+		Revision:           updateRevision,
+		StartTime:          now,
+		LastTransitionTime: now,
+	}
+	tc.Status.TiDB.Members[podName] = member
+}
+
 func (u *tidbUpgrader) upgradeTiDBPod(tc *v1alpha1.TidbCluster, ordinal int32, newSet *apps.StatefulSet) error {
 	mngerutils.SetUpgradePartition(newSet, ordinal)
 	return nil
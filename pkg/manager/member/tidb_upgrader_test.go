@@ -15,6 +15,7 @@ package member
 
 import (
 	"testing"
+	"time"
 
 	"github.com/pingcap/tidb-operator/pkg/apis/label"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
@@ -109,6 +110,36 @@ func TestTiDBUpgrader_Upgrade(t *testing.T) {
 			},
 			errorExpect: true,
 		},
+		{
+			name: "paused after canary pods are upgraded",
+			changeFn: func(tc *v1alpha1.TidbCluster) {
+				tc.Status.PD.Phase = v1alpha1.NormalPhase
+				tc.Status.TiKV.Phase = v1alpha1.NormalPhase
+				tc.Spec.TiDB.UpgradeStrategy = &v1alpha1.UpgradeStrategy{CanaryReplicas: 1}
+			},
+			getLastAppliedConfigErr: false,
+			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster, newSet *apps.StatefulSet) {
+				g.Expect(tc.Status.TiDB.Phase).To(Equal(v1alpha1.UpgradePhase))
+				g.Expect(newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(pointer.Int32Ptr(1)))
+				g.Expect(tc.Status.TiDB.UpgradeStage.Stage).To(Equal(v1alpha1.UpgradeStagePaused))
+			},
+			errorExpect: true,
+		},
+		{
+			name: "promoted after canary pods are upgraded",
+			changeFn: func(tc *v1alpha1.TidbCluster) {
+				tc.Status.PD.Phase = v1alpha1.NormalPhase
+				tc.Status.TiKV.Phase = v1alpha1.NormalPhase
+				tc.Spec.TiDB.UpgradeStrategy = &v1alpha1.UpgradeStrategy{CanaryReplicas: 1}
+				tc.Annotations = map[string]string{label.AnnPromoteUpgradePrefix + "tidb": "2"}
+			},
+			getLastAppliedConfigErr: false,
+			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster, newSet *apps.StatefulSet) {
+				g.Expect(tc.Status.TiDB.Phase).To(Equal(v1alpha1.UpgradePhase))
+				g.Expect(newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(pointer.Int32Ptr(0)))
+				g.Expect(tc.Status.TiDB.UpgradeStage.Stage).To(Equal(v1alpha1.UpgradeStagePromoted))
+			},
+		},
 		{
 			name: "modify oldSet update strategy to OnDelete",
 			changeFn: func(tc *v1alpha1.TidbCluster) {
@@ -256,6 +287,102 @@ func TestTiDBUpgrader_Upgrade(t *testing.T) {
 
 }
 
+func TestTiDBUpgrader_DrainThroughTiProxy(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	upgrader, _, podInformer := newTiDBUpgrader()
+	for _, pod := range getTiDBPods() {
+		podInformer.Informer().GetIndexer().Add(pod)
+	}
+	tc := newTidbClusterForTiDBUpgrader()
+	tc.Spec.TiProxy = &v1alpha1.TiProxySpec{Replicas: 2}
+
+	upgrade := func() (*apps.StatefulSet, error) {
+		oldSet := newStatefulSetForTiDBUpgrader()
+		mngerutils.SetStatefulSetLastAppliedConfigAnnotation(oldSet)
+		newSet := oldSet.DeepCopy()
+		return newSet, upgrader.Upgrade(tc, oldSet, newSet)
+	}
+
+	// tiproxy can't migrate the sessions without the graceful wait of tidb, the pod is upgraded without draining
+	newSet, err := upgrade()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(pointer.Int32Ptr(0)))
+	g.Expect(tc.Status.TiDB.Members["upgrader-tidb-0"].Drain).To(BeNil())
+
+	// the pod is drained during the graceful wait after it's upgraded
+	tc.Spec.TiDB.Config = v1alpha1.NewTiDBConfig()
+	tc.Spec.TiDB.Config.Set("graceful-wait-before-shutdown", 30)
+	newSet, err = upgrade()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(pointer.Int32Ptr(0)))
+	drain := tc.Status.TiDB.Members["upgrader-tidb-0"].Drain
+	g.Expect(drain).NotTo(BeNil())
+	g.Expect(drain.Phase).To(Equal(v1alpha1.TiDBDrainPhaseDraining))
+	g.Expect(drain.Revision).To(Equal("2"))
+
+	// the drain is not restarted for the same revision
+	drain.StartTime = metav1.NewTime(time.Now().Add(-time.Minute))
+	_, err = upgrade()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tc.Status.TiDB.Members["upgrader-tidb-0"].Drain.StartTime).To(Equal(drain.StartTime))
+
+	// drained once the pod is started with the update revision
+	pod := getTiDBPods()[0]
+	pod.Labels[apps.ControllerRevisionHashLabelKey] = "2"
+	podInformer.Informer().GetIndexer().Update(pod)
+	_, err = upgrade()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tc.Status.TiDB.Members["upgrader-tidb-0"].Drain.Phase).To(Equal(v1alpha1.TiDBDrainPhaseDrained))
+	g.Expect(tc.Status.TiDB.Members["upgrader-tidb-1"].Drain).To(BeNil())
+}
+
+func TestTiDBUpgrader_DrainInConsecutiveUpgrades(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	upgrader, _, podInformer := newTiDBUpgrader()
+	tc := newTidbClusterForTiDBUpgrader()
+	tc.Spec.TiProxy = &v1alpha1.TiProxySpec{Replicas: 1}
+	tc.Spec.TiDB.Config = v1alpha1.NewTiDBConfig()
+	tc.Spec.TiDB.Config.Set("graceful-wait-before-shutdown", 30)
+
+	upgrade := func() (*apps.StatefulSet, error) {
+		oldSet := newStatefulSetForTiDBUpgrader()
+		mngerutils.SetStatefulSetLastAppliedConfigAnnotation(oldSet)
+		newSet := oldSet.DeepCopy()
+		return newSet, upgrader.Upgrade(tc, oldSet, newSet)
+	}
+	setPodRevisions := func(revisions ...string) {
+		for i, pod := range getTiDBPods() {
+			pod.Labels[apps.ControllerRevisionHashLabelKey] = revisions[i]
+			podInformer.Informer().GetIndexer().Update(pod)
+		}
+	}
+
+	// the first upgrade drains the last pod
+	setPodRevisions("1", "2")
+	newSet, err := upgrade()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(pointer.Int32Ptr(0)))
+	setPodRevisions("2", "2")
+	_, err = upgrade()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tc.Status.TiDB.Members["upgrader-tidb-0"].Drain.Phase).To(Equal(v1alpha1.TiDBDrainPhaseDrained))
+
+	// the upgrade completes and the drain status of the last pod is left
+	tc.Status.TiDB.StatefulSet.CurrentRevision = "2"
+	tc.Status.TiDB.StatefulSet.UpdateRevision = "3"
+
+	// the next upgrade drains the last pod again
+	setPodRevisions("2", "3")
+	newSet, err = upgrade()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(pointer.Int32Ptr(0)))
+	drain := tc.Status.TiDB.Members["upgrader-tidb-0"].Drain
+	g.Expect(drain.Phase).To(Equal(v1alpha1.TiDBDrainPhaseDraining))
+	g.Expect(drain.Revision).To(Equal("3"))
+}
+
 func newTiDBUpgrader() (Upgrader, *controller.FakeTiDBControl, podinformers.PodInformer) {
 	fakeDeps := controller.NewFakeDependencies()
 	upgrader := &tidbUpgrader{fakeDeps}
@@ -44,6 +44,8 @@ func NewTidbClusterStatusManager(deps *controller.Dependencies) *TidbClusterStat
 }
 
 func (m *TidbClusterStatusManager) Sync(tc *v1alpha1.TidbCluster) error {
+	m.syncConfigDrift(tc)
+
 	err := m.syncAutoScalerRef(tc)
 	if err != nil {
 		return err
@@ -268,6 +268,11 @@ func (m *tiflashMemberManager) syncStatefulSet(tc *v1alpha1.TidbCluster) error {
 		return err
 	}
 
+	if err := syncTiFlashTableReplicas(m.deps, tc); err != nil {
+		klog.Errorf("Sync TiFlash table replicas for tidbcluster %s/%s failed, error: %v", ns, tcName, err)
+		// No need to return err here, just continue to sync tiflash
+	}
+
 	// Scaling takes precedence over upgrading because:
 	// - if a tiflash fails in the upgrading, users may want to delete it or add
 	//   new replicas
@@ -524,6 +529,7 @@ func getNewStatefulSet(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (*apps.St
 	podLabels := util.CombineStringMap(stsLabels, baseTiFlashSpec.Labels())
 	podAnnotations := util.CombineStringMap(baseTiFlashSpec.Annotations(), controller.AnnProm(v1alpha1.DefaultTiFlashMetricsPort, "/metrics"))
 	podAnnotations = util.CombineStringMap(controller.AnnAdditionalProm("tiflash.proxy", v1alpha1.DefaultTiFlashProxyStatusPort), podAnnotations)
+	podAnnotations = util.CombineStringMap(podAnnotations, tlsCertHashAnnotation(tc, label.TiFlashLabelVal))
 	stsAnnotations := getStsAnnotations(tc.Annotations, label.TiFlashLabelVal)
 	capacity := controller.TiKVCapacity(tc.Spec.TiFlash.Limits)
 	headlessSvcName := controller.TiFlashPeerMemberName(tcName)
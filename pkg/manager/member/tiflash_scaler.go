@@ -102,6 +102,12 @@ func (s *tiflashScaler) ScaleIn(meta metav1.Object, oldSet *apps.StatefulSet, ne
 	_, ordinals, replicas, deleteSlots := scaleMulti(oldSet, newSet, scaleInParallelism)
 	klog.Infof("scaling in tiflash statefulset %s/%s, ordinal: %v (replicas: %d, delete slots: %v), scaleInParallelism: %v", oldSet.Namespace, oldSet.Name, ordinals, replicas, deleteSlots.List(), scaleInParallelism)
 
+	// the tables must keep their TiFlash replicas after scaling in
+	if err := checkTiFlashTableReplicasForScaleIn(tc, replicas); err != nil {
+		resetReplicas(newSet, oldSet)
+		return err
+	}
+
 	var (
 		errs                         []error
 		finishedOrdinals             = sets.NewInt32()
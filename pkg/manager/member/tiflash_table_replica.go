@@ -0,0 +1,129 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+
+	"k8s.io/klog/v2"
+)
+
+// tiflashReplicaQuery queries the TiFlash replicas of all the tables
+const tiflashReplicaQuery = "SELECT TABLE_SCHEMA, TABLE_NAME, REPLICA_COUNT, AVAILABLE, PROGRESS FROM information_schema.tiflash_replica"
+
+// syncTiFlashTableReplicas sets the TiFlash replicas of the tables declared in spec.tiflash.tableReplicas
+// and records the replication status of the tables in the status.
+func syncTiFlashTableReplicas(deps *controller.Dependencies, tc *v1alpha1.TidbCluster) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	spec := tc.Spec.TiFlash
+	if len(spec.TableReplicas) == 0 {
+		tc.Status.TiFlash.TableReplicas = nil
+		return nil
+	}
+	if tc.Spec.TiDB == nil {
+		return fmt.Errorf("tidbcluster %s/%s has no tidb to set the tiflash replicas", ns, tcName)
+	}
+	if spec.TableReplicaSecretName == "" {
+		return fmt.Errorf("tableReplicaSecretName is required to set the tiflash replicas of tidbcluster %s/%s", ns, tcName)
+	}
+
+	user, password, err := controller.GetUserAndPasswordFromSecret(deps.SecretLister, ns, spec.TableReplicaSecretName)
+	if err != nil {
+		return err
+	}
+	db, err := deps.TiDBSQLControl.Open(tc, user, password, nil)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	rows, err := db.Query(tiflashReplicaQuery)
+	if err != nil {
+		return fmt.Errorf("query tiflash replicas of tidbcluster %s/%s failed, err: %v", ns, tcName, err)
+	}
+	actual := make(map[string]v1alpha1.TiFlashTableReplicaStatus, len(rows))
+	for _, row := range rows {
+		if len(row) != 5 {
+			continue
+		}
+		var replicas int32
+		if _, err := fmt.Sscanf(row[2], "%d", &replicas); err != nil {
+			return fmt.Errorf("invalid replica count %q of table %s.%s", row[2], row[0], row[1])
+		}
+		actual[tableKey(row[0], row[1])] = v1alpha1.TiFlashTableReplicaStatus{
+			Database:  row[0],
+			Table:     row[1],
+			Replicas:  replicas,
+			Available: row[3] == "1",
+			Progress:  row[4],
+		}
+	}
+
+	status := make([]v1alpha1.TiFlashTableReplicaStatus, 0, len(spec.TableReplicas))
+	for _, replica := range spec.TableReplicas {
+		current, ok := actual[tableKey(replica.Database, replica.Table)]
+		if !ok {
+			current = v1alpha1.TiFlashTableReplicaStatus{Database: replica.Database, Table: replica.Table}
+		}
+		if current.Replicas != replica.Replicas {
+			table := fmt.Sprintf("%s.%s", controller.QuoteIdentifier(replica.Database), controller.QuoteIdentifier(replica.Table))
+			if err := db.Exec(fmt.Sprintf("ALTER TABLE %s SET TIFLASH REPLICA %d", table, replica.Replicas)); err != nil {
+				return fmt.Errorf("set tiflash replicas of table %s.%s to %d failed, err: %v", replica.Database, replica.Table, replica.Replicas, err)
+			}
+			klog.Infof("tidbcluster %s/%s: set tiflash replicas of table %s.%s from %d to %d",
+				ns, tcName, replica.Database, replica.Table, current.Replicas, replica.Replicas)
+			// the replication starts over after the replicas are changed
+			current.Replicas = replica.Replicas
+			current.Available = false
+			current.Progress = "0"
+		}
+		status = append(status, current)
+	}
+	tc.Status.TiFlash.TableReplicas = status
+	return nil
+}
+
+// checkTiFlashTableReplicasForScaleIn checks whether TiFlash can be scaled in to the replicas, TiFlash can not be
+// scaled in to fewer stores than the replicas of the declared tables, or when the replication is in progress.
+func checkTiFlashTableReplicasForScaleIn(tc *v1alpha1.TidbCluster, replicas int32) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	for _, replica := range tc.Spec.TiFlash.TableReplicas {
+		if replica.Replicas > replicas {
+			return controller.RequeueErrorf("tidbcluster %s/%s: can not scale in tiflash to %d, table %s.%s requires %d tiflash replicas",
+				ns, tcName, replicas, replica.Database, replica.Table, replica.Replicas)
+		}
+	}
+	for _, status := range tc.Status.TiFlash.TableReplicas {
+		if status.Replicas > replicas {
+			return controller.RequeueErrorf("tidbcluster %s/%s: can not scale in tiflash to %d, table %s.%s has %d tiflash replicas",
+				ns, tcName, replicas, status.Database, status.Table, status.Replicas)
+		}
+		if status.Replicas > 0 && !status.Available {
+			return controller.RequeueErrorf("tidbcluster %s/%s: can not scale in tiflash, the tiflash replicas of table %s.%s are not available, progress: %s",
+				ns, tcName, status.Database, status.Table, status.Progress)
+		}
+	}
+	return nil
+}
+
+// tableKey returns the key of the table, the names are case insensitive in TiDB
+func tableKey(database, table string) string {
+	return strings.ToLower(database) + "." + strings.ToLower(table)
+}
@@ -0,0 +1,114 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSyncTiFlashTableReplicas(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	sqlControl := deps.TiDBSQLControl.(*controller.FakeTiDBSQLControl)
+	g.Expect(deps.KubeInformerFactory.Core().V1().Secrets().Informer().GetIndexer().Add(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "tiflash-replica", Namespace: metav1.NamespaceDefault},
+		Data:       map[string][]byte{"user": []byte("root"), "password": []byte("pass")},
+	})).To(Succeed())
+
+	tc := newTidbClusterForTiFlashTableReplica()
+	rows := [][]string{
+		{"app", "orders", "2", "1", "1"},
+		{"App", "Users", "1", "0", "0.5"},
+	}
+	sqlControl.QueryFn = func(query string, args ...interface{}) ([][]string, error) {
+		g.Expect(query).To(Equal(tiflashReplicaQuery))
+		return rows, nil
+	}
+
+	// no tables declared
+	tc.Spec.TiFlash.TableReplicas = nil
+	g.Expect(syncTiFlashTableReplicas(deps, tc)).To(Succeed())
+	g.Expect(sqlControl.Statements).To(BeEmpty())
+	g.Expect(tc.Status.TiFlash.TableReplicas).To(BeNil())
+
+	// the secret is required
+	tc.Spec.TiFlash.TableReplicas = []v1alpha1.TiFlashTableReplica{
+		{Database: "app", Table: "orders", Replicas: 2},
+		{Database: "app", Table: "users", Replicas: 2},
+		{Database: "app", Table: "items", Replicas: 1},
+	}
+	g.Expect(syncTiFlashTableReplicas(deps, tc)).NotTo(Succeed())
+
+	// set the replicas of the changed tables
+	tc.Spec.TiFlash.TableReplicaSecretName = "tiflash-replica"
+	g.Expect(syncTiFlashTableReplicas(deps, tc)).To(Succeed())
+	g.Expect(sqlControl.Statements).To(Equal([]string{
+		"ALTER TABLE `app`.`users` SET TIFLASH REPLICA 2",
+		"ALTER TABLE `app`.`items` SET TIFLASH REPLICA 1",
+	}))
+	g.Expect(tc.Status.TiFlash.TableReplicas).To(Equal([]v1alpha1.TiFlashTableReplicaStatus{
+		{Database: "app", Table: "orders", Replicas: 2, Available: true, Progress: "1"},
+		{Database: "App", Table: "Users", Replicas: 2, Available: false, Progress: "0"},
+		{Database: "app", Table: "items", Replicas: 1, Available: false, Progress: "0"},
+	}))
+}
+
+func TestCheckTiFlashTableReplicasForScaleIn(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForTiFlashTableReplica()
+	g.Expect(checkTiFlashTableReplicasForScaleIn(tc, 1)).To(Succeed())
+
+	// the declared replicas are more than the stores
+	tc.Spec.TiFlash.TableReplicas = []v1alpha1.TiFlashTableReplica{{Database: "app", Table: "orders", Replicas: 2}}
+	err := checkTiFlashTableReplicasForScaleIn(tc, 1)
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(err.Error()).To(ContainSubstring("table app.orders requires 2 tiflash replicas"))
+	g.Expect(checkTiFlashTableReplicasForScaleIn(tc, 2)).To(Succeed())
+
+	// the replication is in progress
+	tc.Status.TiFlash.TableReplicas = []v1alpha1.TiFlashTableReplicaStatus{
+		{Database: "app", Table: "orders", Replicas: 2, Available: false, Progress: "0.3"},
+	}
+	err = checkTiFlashTableReplicasForScaleIn(tc, 2)
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(err.Error()).To(ContainSubstring("progress: 0.3"))
+
+	tc.Status.TiFlash.TableReplicas[0].Available = true
+	g.Expect(checkTiFlashTableReplicasForScaleIn(tc, 2)).To(Succeed())
+
+	// the actual replicas are more than the stores
+	tc.Status.TiFlash.TableReplicas[0].Replicas = 3
+	err = checkTiFlashTableReplicasForScaleIn(tc, 2)
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(err.Error()).To(ContainSubstring("has 3 tiflash replicas"))
+}
+
+func newTidbClusterForTiFlashTableReplica() *v1alpha1.TidbCluster {
+	return &v1alpha1.TidbCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: metav1.NamespaceDefault},
+		Spec: v1alpha1.TidbClusterSpec{
+			TiDB:    &v1alpha1.TiDBSpec{Replicas: 1},
+			TiFlash: &v1alpha1.TiFlashSpec{Replicas: 3},
+		},
+	}
+}
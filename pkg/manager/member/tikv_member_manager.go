@@ -317,7 +317,19 @@ func (m *tikvMemberManager) syncTiKVConfigMap(tc *v1alpha1.TidbCluster, set *app
 		}
 	}
 
-	err = mngerutils.UpdateConfigMapIfNeed(m.deps.ConfigMapLister, tc.BaseTiKVSpec().ConfigUpdateStrategy(), inUseName, newCm)
+	strategy := tc.BaseTiKVSpec().ConfigUpdateStrategy()
+	if strategy == v1alpha1.ConfigUpdateStrategyOnline && set != nil {
+		var onlineStatus *v1alpha1.OnlineConfigStatus
+		strategy, onlineStatus, err = syncOnlineConfig(m.deps.ConfigMapLister, inUseName, newCm, hasOnlineConfigPrefix(tikvOnlineConfigPrefixes), tikvOnlineConfigApplier(m.deps, tc, set))
+		if err != nil {
+			return nil, err
+		}
+		if onlineStatus != nil {
+			tc.Status.TiKV.OnlineConfig = onlineStatus
+		}
+	}
+
+	err = mngerutils.UpdateConfigMapIfNeed(m.deps.ConfigMapLister, strategy, inUseName, newCm)
 	if err != nil {
 		return nil, err
 	}
@@ -492,6 +504,7 @@ func getNewTiKVSetForTidbCluster(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap)
 	podLabels := util.CombineStringMap(stsLabels.Labels(), baseTiKVSpec.Labels())
 	setName := controller.TiKVMemberName(tcName)
 	podAnnotations := util.CombineStringMap(baseTiKVSpec.Annotations(), controller.AnnProm(v1alpha1.DefaultTiKVStatusPort, "/metrics"))
+	podAnnotations = util.CombineStringMap(podAnnotations, tlsCertHashAnnotation(tc, label.TiKVLabelVal))
 	stsAnnotations := getStsAnnotations(tc.Annotations, label.TiKVLabelVal)
 	capacity := controller.TiKVCapacity(tc.Spec.TiKV.Limits)
 	headlessSvcName := controller.TiKVPeerMemberName(tcName)
@@ -119,6 +119,7 @@ func (u *tikvUpgrader) Upgrade(meta metav1.Object, oldSet *apps.StatefulSet, new
 
 	mngerutils.SetUpgradePartition(newSet, *oldSet.Spec.UpdateStrategy.RollingUpdate.Partition)
 	podOrdinals := helper.GetPodOrdinals(*oldSet.Spec.Replicas, oldSet).List()
+	upgraded := int32(0)
 	for _i := len(podOrdinals) - 1; _i >= 0; _i-- {
 		i := podOrdinals[_i]
 		store := getStoreByOrdinal(meta.GetName(), *status, i)
@@ -154,6 +155,7 @@ func (u *tikvUpgrader) Upgrade(meta metav1.Object, oldSet *apps.StatefulSet, new
 				return controller.RequeueErrorf("waiting to end evict leader of pod %s for tc %s/%s", podName, ns, tcName)
 			}
 
+			upgraded++
 			continue
 		}
 
@@ -162,6 +164,10 @@ func (u *tikvUpgrader) Upgrade(meta metav1.Object, oldSet *apps.StatefulSet, new
 			return controller.RequeueErrorf("cluster is unstable: %s", unstableReason)
 		}
 
+		if err := checkUpgradeStage(u.deps, tc, v1alpha1.TiKVMemberType, &status.UpgradeStage, status.StatefulSet.UpdateRevision, upgraded); err != nil {
+			return err
+		}
+
 		return u.upgradeTiKVPod(tc, i, newSet)
 	}
 
@@ -393,6 +393,7 @@ func (m *tiproxyMemberManager) getNewStatefulSet(tc *v1alpha1.TidbCluster, cm *c
 	stsName := controller.TiProxyMemberName(tcName)
 	podLabels := util.CombineStringMap(stsLabels, baseTiProxySpec.Labels())
 	podAnnotations := util.CombineStringMap(baseTiProxySpec.Annotations(), controller.AnnProm(3080, "/api/metrics"))
+	podAnnotations = util.CombineStringMap(podAnnotations, tlsCertHashAnnotation(tc, label.TiProxyLabelVal))
 	stsAnnotations := getStsAnnotations(tc.Annotations, label.TiProxyLabelVal)
 	headlessSvcName := controller.TiProxyPeerMemberName(tcName)
 
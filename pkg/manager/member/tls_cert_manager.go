@@ -0,0 +1,383 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/manager"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
+	"github.com/pingcap/tidb-operator/pkg/util"
+	"github.com/pingcap/tidb-operator/pkg/util/crypto"
+)
+
+const (
+	// tlsCertCommonName is the CN of the issued certificates, it's the same as the one in the TLS documents
+	// so that `security.cert-allowed-cn` configured as the documents still works
+	tlsCertCommonName = "TiDB"
+	tlsCACommonName   = "TiDB Operator CA"
+	// tlsCADurationFactor is the validity of the CA in multiples of the certificate duration
+	tlsCADurationFactor = 10
+	// tlsClientCertKey is the key of the client certificate in the status
+	tlsClientCertKey = "client"
+)
+
+// tlsCertIPs are the IP SANs of the issued certificates, so that the components can be accessed through loopback
+var tlsCertIPs = []string{"127.0.0.1", "::1"}
+
+// tlsCA is the CA used to issue the certificates
+type tlsCA struct {
+	certPEM []byte
+	keyPEM  []byte
+	// bundle contains the current CA and the unexpired CAs before rotation,
+	// it's distributed as ca.crt so that the certificates issued by the old CA are still trusted
+	bundle []byte
+	cert   *x509.Certificate
+}
+
+// tlsCertManager issues the certificates of the cluster components when the BuiltIn TLS issuer is used.
+//
+// The certificates are renewed before they expire, and the hash of the certificate is added to the pod annotations
+// so that the pods are rolling updated by the member managers, PD is upgraded before the other components.
+// When the CA is rotated, the new CA is appended to the ca.crt of all secrets first, the certificates are
+// issued by the new CA only when they need renewal, at which time all the pods already trust the new CA.
+type tlsCertManager struct {
+	deps *controller.Dependencies
+}
+
+// NewTLSCertManager returns a manager that issues the cluster certificates
+func NewTLSCertManager(deps *controller.Dependencies) manager.Manager {
+	return &tlsCertManager{deps: deps}
+}
+
+func (m *tlsCertManager) Sync(tc *v1alpha1.TidbCluster) error {
+	if !tc.IsTLSBuiltInIssuer() {
+		return nil
+	}
+
+	ca, err := m.syncCA(tc)
+	if err != nil {
+		return err
+	}
+
+	status := &v1alpha1.TLSClusterStatus{
+		CANotAfter:   metav1.NewTime(ca.cert.NotAfter),
+		Certificates: map[string]v1alpha1.TLSCertificateStatus{},
+	}
+	for component, hosts := range tlsCertHosts(tc) {
+		secretName := util.ClusterTLSSecretName(tc.Name, component)
+		certStatus, err := m.syncCert(tc, ca, secretName, hosts)
+		if err != nil {
+			return err
+		}
+		if certStatus != nil {
+			status.Certificates[component] = *certStatus
+		}
+	}
+	certStatus, err := m.syncCert(tc, ca, util.ClusterClientTLSSecretName(tc.Name), nil)
+	if err != nil {
+		return err
+	}
+	if certStatus != nil {
+		status.Certificates[tlsClientCertKey] = *certStatus
+	}
+
+	tc.Status.TLSCluster = status
+	return nil
+}
+
+// syncCA creates the CA, or rotates it when it can't cover the validity of a new certificate and the renewal window.
+// A CA secret that is not created by the operator is used as is.
+func (m *tlsCertManager) syncCA(tc *v1alpha1.TidbCluster) (*tlsCA, error) {
+	ns := tc.GetNamespace()
+	secretName := util.ClusterTLSCASecretName(tc.Name)
+
+	secret, err := m.deps.SecretLister.Secrets(ns).Get(secretName)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, fmt.Errorf("syncCA: failed to get secret %s/%s for cluster %s/%s, error: %s", ns, secretName, ns, tc.Name, err)
+	}
+
+	var oldBundle []byte
+	if secret != nil {
+		ca := &tlsCA{
+			certPEM: secret.Data[corev1.TLSCertKey],
+			keyPEM:  secret.Data[corev1.TLSPrivateKeyKey],
+			bundle:  secret.Data[tlsSecretRootCAKey],
+		}
+		if len(ca.bundle) == 0 {
+			ca.bundle = ca.certPEM
+		}
+		ca.cert, err = crypto.ParseCertificate(ca.certPEM)
+		if !metav1.IsControlledBy(secret, tc) {
+			if err != nil {
+				return nil, fmt.Errorf("syncCA: failed to parse the CA in secret %s/%s for cluster %s/%s, error: %s", ns, secretName, ns, tc.Name, err)
+			}
+			return ca, nil
+		}
+		if err == nil && time.Until(ca.cert.NotAfter) > tc.TLSCertificateDuration()+tc.TLSRenewBefore() {
+			return ca, nil
+		}
+		if err != nil {
+			klog.Warningf("syncCA: failed to parse the CA in secret %s/%s, recreate it, error: %s", ns, secretName, err)
+		} else {
+			klog.Infof("syncCA: CA of cluster %s/%s expires at %s, rotate it", ns, tc.Name, ca.cert.NotAfter)
+		}
+		oldBundle = ca.bundle
+	}
+
+	certPEM, keyPEM, err := crypto.NewCA(tlsCACommonName, tlsCADurationFactor*tc.TLSCertificateDuration())
+	if err != nil {
+		return nil, fmt.Errorf("syncCA: failed to create CA for cluster %s/%s, error: %s", ns, tc.Name, err)
+	}
+	ca := &tlsCA{
+		certPEM: certPEM,
+		keyPEM:  keyPEM,
+		bundle:  appendUnexpiredCerts(certPEM, oldBundle),
+	}
+	if ca.cert, err = crypto.ParseCertificate(certPEM); err != nil {
+		return nil, err
+	}
+
+	newSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretName,
+			Namespace: ns,
+			Labels:    label.New().Instance(tc.Name),
+		},
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       ca.certPEM,
+			corev1.TLSPrivateKeyKey: ca.keyPEM,
+			tlsSecretRootCAKey:      ca.bundle,
+		},
+	}
+	if _, err := m.deps.TypedControl.CreateOrUpdateSecret(tc, newSecret); err != nil {
+		return nil, fmt.Errorf("syncCA: failed to save secret %s/%s for cluster %s/%s, error: %s", ns, secretName, ns, tc.Name, err)
+	}
+	m.deps.Recorder.Eventf(tc, corev1.EventTypeNormal, "CAIssued", "CA is issued and expires at %s", ca.cert.NotAfter.Format(time.RFC3339))
+	return ca, nil
+}
+
+// syncCert issues or renews the certificate in the secret, nil status is returned if the secret is not
+// managed by the operator.
+func (m *tlsCertManager) syncCert(tc *v1alpha1.TidbCluster, ca *tlsCA, secretName string, hosts []string) (*v1alpha1.TLSCertificateStatus, error) {
+	ns := tc.GetNamespace()
+
+	secret, err := m.deps.SecretLister.Secrets(ns).Get(secretName)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, fmt.Errorf("syncCert: failed to get secret %s/%s for cluster %s/%s, error: %s", ns, secretName, ns, tc.Name, err)
+	}
+	if secret != nil && !metav1.IsControlledBy(secret, tc) {
+		klog.V(4).Infof("syncCert: secret %s/%s is not created by cluster %s/%s, skip", ns, secretName, ns, tc.Name)
+		return nil, nil
+	}
+
+	certPEM, keyPEM := []byte(nil), []byte(nil)
+	if secret != nil {
+		certPEM, keyPEM = secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey]
+	}
+	cert, reason := checkCertRenewal(tc, ca, certPEM, hosts)
+	if reason != "" {
+		klog.Infof("syncCert: issue certificate in secret %s/%s, reason: %s", ns, secretName, reason)
+		csr, key, err := crypto.NewCSR(tlsCertCommonName, hosts, tlsCertIPs)
+		if err != nil {
+			return nil, fmt.Errorf("syncCert: failed to create CSR for secret %s/%s, error: %s", ns, secretName, err)
+		}
+		notAfter := time.Now().Add(tc.TLSCertificateDuration())
+		if notAfter.After(ca.cert.NotAfter) {
+			notAfter = ca.cert.NotAfter
+		}
+		if certPEM, err = crypto.SignCSR(csr, ca.certPEM, ca.keyPEM, notAfter); err != nil {
+			return nil, fmt.Errorf("syncCert: failed to sign certificate for secret %s/%s, error: %s", ns, secretName, err)
+		}
+		keyPEM = key
+		if cert, err = crypto.ParseCertificate(certPEM); err != nil {
+			return nil, err
+		}
+	}
+
+	data := map[string][]byte{
+		corev1.TLSCertKey:       certPEM,
+		corev1.TLSPrivateKeyKey: keyPEM,
+		tlsSecretRootCAKey:      ca.bundle,
+	}
+	if secret == nil || !reflect.DeepEqual(secret.Data, data) {
+		newSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      secretName,
+				Namespace: ns,
+				Labels:    label.New().Instance(tc.Name),
+			},
+			Type: corev1.SecretTypeTLS,
+			Data: data,
+		}
+		if _, err := m.deps.TypedControl.CreateOrUpdateSecret(tc, newSecret); err != nil {
+			return nil, fmt.Errorf("syncCert: failed to save secret %s/%s for cluster %s/%s, error: %s", ns, secretName, ns, tc.Name, err)
+		}
+		if reason != "" {
+			m.deps.Recorder.Eventf(tc, corev1.EventTypeNormal, "CertificateIssued", "certificate in secret %s is issued and expires at %s, reason: %s",
+				secretName, cert.NotAfter.Format(time.RFC3339), reason)
+		}
+	}
+
+	hash, err := mngerutils.Sha256Sum(data)
+	if err != nil {
+		return nil, err
+	}
+	return &v1alpha1.TLSCertificateStatus{
+		SecretName: secretName,
+		NotAfter:   metav1.NewTime(cert.NotAfter),
+		Hash:       hash,
+	}, nil
+}
+
+// checkCertRenewal returns the parsed certificate and the reason why it should be issued again,
+// empty reason means the certificate is still valid.
+func checkCertRenewal(tc *v1alpha1.TidbCluster, ca *tlsCA, certPEM []byte, hosts []string) (*x509.Certificate, string) {
+	if len(certPEM) == 0 {
+		return nil, "certificate does not exist"
+	}
+	cert, err := crypto.ParseCertificate(certPEM)
+	if err != nil {
+		return nil, fmt.Sprintf("failed to parse certificate: %s", err)
+	}
+	if time.Until(cert.NotAfter) < tc.TLSRenewBefore() {
+		return cert, fmt.Sprintf("certificate expires at %s", cert.NotAfter.Format(time.RFC3339))
+	}
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM(ca.bundle)
+	if _, err := cert.Verify(x509.VerifyOptions{Roots: pool, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny}}); err != nil {
+		return cert, "certificate is not issued by a trusted CA"
+	}
+	if !equalHosts(cert.DNSNames, hosts) {
+		return cert, "hosts of the certificate are changed"
+	}
+	return cert, ""
+}
+
+// appendUnexpiredCerts appends the unexpired certificates in bundle to certPEM
+func appendUnexpiredCerts(certPEM []byte, bundle []byte) []byte {
+	buf := bytes.NewBuffer(append([]byte(nil), certPEM...))
+	certs, err := crypto.ParseCertificates(bundle)
+	if err != nil {
+		klog.Warningf("failed to parse the old CA bundle, drop it, error: %s", err)
+		return buf.Bytes()
+	}
+	now := time.Now()
+	for _, cert := range certs {
+		if cert.NotAfter.Before(now) {
+			continue
+		}
+		buf.Write(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+	}
+	return buf.Bytes()
+}
+
+// tlsCertHosts returns the SANs of the certificates of every component, the key is the component name
+// used in the secret name.
+func tlsCertHosts(tc *v1alpha1.TidbCluster) map[string][]string {
+	services := map[string][]string{}
+	if tc.Spec.PD != nil || len(tc.Spec.PDMS) > 0 {
+		// PD microservices and discovery share the certificate of PD
+		svcs := []string{controller.PDMemberName(tc.Name), controller.PDPeerMemberName(tc.Name)}
+		for _, ms := range tc.Spec.PDMS {
+			svcs = append(svcs, controller.PDMSMemberName(tc.Name, ms.Name), controller.PDMSPeerMemberName(tc.Name, ms.Name))
+		}
+		services[label.PDLabelVal] = append(svcs, controller.DiscoveryMemberName(tc.Name))
+	}
+	if tc.Spec.TiKV != nil {
+		services[label.TiKVLabelVal] = []string{controller.TiKVPeerMemberName(tc.Name)}
+	}
+	if tc.Spec.TiDB != nil {
+		services[label.TiDBLabelVal] = []string{controller.TiDBMemberName(tc.Name), controller.TiDBPeerMemberName(tc.Name)}
+	}
+	if tc.Spec.TiFlash != nil {
+		services[label.TiFlashLabelVal] = []string{controller.TiFlashPeerMemberName(tc.Name)}
+	}
+	if tc.Spec.TiCDC != nil {
+		services[label.TiCDCLabelVal] = []string{controller.TiCDCPeerMemberName(tc.Name)}
+	}
+	if tc.Spec.Pump != nil {
+		services[label.PumpLabelVal] = []string{controller.PumpPeerMemberName(tc.Name)}
+	}
+	if tc.Spec.TiProxy != nil {
+		services[label.TiProxyLabelVal] = []string{controller.TiProxyMemberName(tc.Name), controller.TiProxyPeerMemberName(tc.Name)}
+	}
+
+	hosts := map[string][]string{}
+	for component, svcs := range services {
+		var list []string
+		for _, svc := range svcs {
+			for _, name := range []string{svc, "*." + svc} {
+				list = append(list, name, fmt.Sprintf("%s.%s", name, tc.Namespace), fmt.Sprintf("%s.%s.svc", name, tc.Namespace))
+				if tc.Spec.ClusterDomain != "" {
+					list = append(list, fmt.Sprintf("%s.%s.svc.%s", name, tc.Namespace, tc.Spec.ClusterDomain))
+				}
+			}
+		}
+		hosts[component] = append(list, "localhost")
+	}
+	return hosts
+}
+
+// equalHosts returns whether the DNS names of the certificate are the desired hosts
+func equalHosts(dnsNames []string, hosts []string) bool {
+	if len(dnsNames) != len(hosts) {
+		return false
+	}
+	got := append([]string(nil), dnsNames...)
+	desired := append([]string(nil), hosts...)
+	sort.Strings(got)
+	sort.Strings(desired)
+	return reflect.DeepEqual(got, desired)
+}
+
+// tlsCertHashAnnotation returns the pod annotation of the certificate hash of the component,
+// the pods are rolling updated once the certificate is renewed
+func tlsCertHashAnnotation(tc *v1alpha1.TidbCluster, component string) map[string]string {
+	hash := tc.TLSCertHash(component)
+	if hash == "" {
+		return nil
+	}
+	return map[string]string{label.AnnTLSCertHash: hash}
+}
+
+type FakeTLSCertManager struct {
+	err error
+}
+
+func NewFakeTLSCertManager() *FakeTLSCertManager {
+	return &FakeTLSCertManager{}
+}
+
+func (m *FakeTLSCertManager) SetSyncError(err error) {
+	m.err = err
+}
+
+func (m *FakeTLSCertManager) Sync(_ *v1alpha1.TidbCluster) error {
+	return m.err
+}
@@ -0,0 +1,161 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"context"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/util"
+	"github.com/pingcap/tidb-operator/pkg/util/crypto"
+)
+
+func TestTLSCertManagerSync(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	m := NewTLSCertManager(deps)
+	genericCli := deps.GenericControl.(*controller.FakeGenericControl).FakeCli
+	secretIndexer := deps.KubeInformerFactory.Core().V1().Secrets().Informer().GetIndexer()
+
+	getSecret := func(name string) *corev1.Secret {
+		secret := &corev1.Secret{}
+		err := genericCli.Get(context.TODO(), types.NamespacedName{Namespace: "ns", Name: name}, secret)
+		g.Expect(err).NotTo(HaveOccurred())
+		// make the secret visible to the lister like the informer does
+		g.Expect(secretIndexer.Update(secret)).To(Succeed())
+		return secret
+	}
+
+	tc := &v1alpha1.TidbCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "ns", UID: "demo-uid"},
+		Spec: v1alpha1.TidbClusterSpec{
+			PD:   &v1alpha1.PDSpec{},
+			TiKV: &v1alpha1.TiKVSpec{},
+			TiDB: &v1alpha1.TiDBSpec{},
+			TLSCluster: &v1alpha1.TLSCluster{
+				Enabled:             true,
+				CertificateDuration: &metav1.Duration{Duration: time.Hour},
+				RenewBefore:         &metav1.Duration{Duration: 30 * time.Minute},
+			},
+		},
+	}
+
+	// certificates are provided by the user by default
+	g.Expect(m.Sync(tc)).To(Succeed())
+	g.Expect(tc.Status.TLSCluster).To(BeNil())
+
+	// secret of TiDB is provided by the user and should be kept
+	userSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: util.ClusterTLSSecretName(tc.Name, label.TiDBLabelVal), Namespace: "ns"},
+		Data:       map[string][]byte{corev1.TLSCertKey: []byte("user")},
+	}
+	g.Expect(secretIndexer.Add(userSecret)).To(Succeed())
+
+	tc.Spec.TLSCluster.Issuer = v1alpha1.TLSIssuerBuiltIn
+	g.Expect(m.Sync(tc)).To(Succeed())
+	g.Expect(tc.Status.TLSCluster).NotTo(BeNil())
+	g.Expect(tc.Status.TLSCluster.Certificates).To(HaveLen(3))
+	g.Expect(tc.Status.TLSCluster.Certificates).To(HaveKey(label.PDLabelVal))
+	g.Expect(tc.Status.TLSCluster.Certificates).To(HaveKey(label.TiKVLabelVal))
+	g.Expect(tc.Status.TLSCluster.Certificates).To(HaveKey(tlsClientCertKey))
+	g.Expect(tlsCertHashAnnotation(tc, label.PDLabelVal)).To(HaveKeyWithValue(label.AnnTLSCertHash, tc.Status.TLSCluster.Certificates[label.PDLabelVal].Hash))
+	g.Expect(tlsCertHashAnnotation(tc, label.TiDBLabelVal)).To(BeNil())
+
+	caSecret := getSecret(util.ClusterTLSCASecretName(tc.Name))
+	g.Expect(metav1.IsControlledBy(caSecret, tc)).To(BeTrue())
+	roots := x509.NewCertPool()
+	g.Expect(roots.AppendCertsFromPEM(caSecret.Data[tlsSecretRootCAKey])).To(BeTrue())
+
+	pdSecret := getSecret(util.ClusterTLSSecretName(tc.Name, label.PDLabelVal))
+	g.Expect(pdSecret.Data[tlsSecretRootCAKey]).To(Equal(caSecret.Data[tlsSecretRootCAKey]))
+	pdCert, err := crypto.ParseCertificate(pdSecret.Data[corev1.TLSCertKey])
+	g.Expect(err).NotTo(HaveOccurred())
+	for _, host := range []string{"demo-pd", "demo-pd-0.demo-pd-peer.ns.svc", "demo-discovery.ns", "127.0.0.1"} {
+		_, err := pdCert.Verify(x509.VerifyOptions{DNSName: host, Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}})
+		g.Expect(err).NotTo(HaveOccurred(), host)
+	}
+	getSecret(util.ClusterTLSSecretName(tc.Name, label.TiKVLabelVal))
+	getSecret(util.ClusterClientTLSSecretName(tc.Name))
+
+	// nothing changes if the certificates are still valid
+	status := tc.Status.TLSCluster.DeepCopy()
+	g.Expect(m.Sync(tc)).To(Succeed())
+	g.Expect(tc.Status.TLSCluster).To(Equal(status))
+
+	// hosts of PD are changed after enabling PD microservices
+	tc.Spec.PDMS = []*v1alpha1.PDMSSpec{{Name: "tso"}}
+	g.Expect(m.Sync(tc)).To(Succeed())
+	g.Expect(tc.Status.TLSCluster.Certificates[label.PDLabelVal].Hash).NotTo(Equal(status.Certificates[label.PDLabelVal].Hash))
+	g.Expect(tc.Status.TLSCluster.Certificates[label.TiKVLabelVal].Hash).To(Equal(status.Certificates[label.TiKVLabelVal].Hash))
+	pdSecret = getSecret(util.ClusterTLSSecretName(tc.Name, label.PDLabelVal))
+	pdCert, err = crypto.ParseCertificate(pdSecret.Data[corev1.TLSCertKey])
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(pdCert.VerifyHostname("demo-tso-0.demo-tso-peer")).To(Succeed())
+
+	// certificates are renewed before they expire
+	status = tc.Status.TLSCluster.DeepCopy()
+	tc.Spec.TLSCluster.RenewBefore = &metav1.Duration{Duration: 2 * time.Hour}
+	g.Expect(m.Sync(tc)).To(Succeed())
+	for _, key := range []string{label.PDLabelVal, label.TiKVLabelVal, tlsClientCertKey} {
+		g.Expect(tc.Status.TLSCluster.Certificates[key].Hash).NotTo(Equal(status.Certificates[key].Hash), key)
+	}
+	g.Expect(tc.Status.TLSCluster.CANotAfter).To(Equal(status.CANotAfter))
+	tc.Spec.TLSCluster.RenewBefore = &metav1.Duration{Duration: 30 * time.Minute}
+	g.Expect(m.Sync(tc)).To(Succeed())
+	tikvSecret := getSecret(util.ClusterTLSSecretName(tc.Name, label.TiKVLabelVal))
+
+	// the CA can't cover the validity of a new certificate and is rotated,
+	// the certificates are kept and the new CA is appended to the trusted CAs
+	status = tc.Status.TLSCluster.DeepCopy()
+	tc.Spec.TLSCluster.CertificateDuration = &metav1.Duration{Duration: 12 * time.Hour}
+	g.Expect(m.Sync(tc)).To(Succeed())
+	g.Expect(tc.Status.TLSCluster.CANotAfter).NotTo(Equal(status.CANotAfter))
+	g.Expect(tc.Status.TLSCluster.Certificates[label.TiKVLabelVal].Hash).NotTo(Equal(status.Certificates[label.TiKVLabelVal].Hash))
+	caSecret = getSecret(util.ClusterTLSCASecretName(tc.Name))
+	cas, err := crypto.ParseCertificates(caSecret.Data[tlsSecretRootCAKey])
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cas).To(HaveLen(2))
+	newTiKVSecret := getSecret(util.ClusterTLSSecretName(tc.Name, label.TiKVLabelVal))
+	g.Expect(newTiKVSecret.Data[corev1.TLSCertKey]).To(Equal(tikvSecret.Data[corev1.TLSCertKey]))
+	g.Expect(newTiKVSecret.Data[tlsSecretRootCAKey]).To(Equal(caSecret.Data[tlsSecretRootCAKey]))
+}
+
+func TestAppendUnexpiredCerts(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	current, _, err := crypto.NewCA("current", time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+	old, _, err := crypto.NewCA("old", time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+	expired, _, err := crypto.NewCA("expired", -time.Minute)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	bundle := appendUnexpiredCerts(current, append(append([]byte(nil), old...), expired...))
+	certs, err := crypto.ParseCertificates(bundle)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(certs).To(HaveLen(2))
+	g.Expect(certs[0].Subject.CommonName).To(Equal("current"))
+	g.Expect(certs[1].Subject.CommonName).To(Equal("old"))
+}
@@ -0,0 +1,107 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// upgradeLeaderImbalanceTolerance is the tolerance of the leader count of a TiKV store below the average
+// when checking the health of the cluster to promote a staged upgrade
+const upgradeLeaderImbalanceTolerance = 0.2
+
+// checkUpgradeStage checks whether the next pod of the component can be upgraded when the upgrade strategy
+// is set, upgraded is the number of the pods which are already upgraded to the update revision.
+// The upgrade stage is recorded in stageStatus, and a requeue error is returned if the upgrade is paused.
+func checkUpgradeStage(deps *controller.Dependencies, tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType,
+	stageStatus **v1alpha1.UpgradeStageStatus, updateRevision string, upgraded int32) error {
+	var strategy *v1alpha1.UpgradeStrategy
+	switch memberType {
+	case v1alpha1.TiKVMemberType:
+		if tc.Spec.TiKV != nil {
+			strategy = tc.Spec.TiKV.UpgradeStrategy
+		}
+	case v1alpha1.TiDBMemberType:
+		if tc.Spec.TiDB != nil {
+			strategy = tc.Spec.TiDB.UpgradeStrategy
+		}
+	}
+	if strategy == nil || strategy.CanaryReplicas <= 0 {
+		*stageStatus = nil
+		return nil
+	}
+
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	now := metav1.Now()
+	stage := *stageStatus
+	if stage == nil || stage.Revision != updateRevision {
+		klog.Infof("tidbcluster: [%s/%s] start upgrading %d canary pods of %s to revision %s",
+			ns, tcName, strategy.CanaryReplicas, memberType, updateRevision)
+		stage = &v1alpha1.UpgradeStageStatus{
+			Stage:              v1alpha1.UpgradeStageCanary,
+			Revision:           updateRevision,
+			CanaryReplicas:     strategy.CanaryReplicas,
+			LastTransitionTime: now,
+		}
+		*stageStatus = stage
+	}
+	if stage.Stage == v1alpha1.UpgradeStagePromoted || upgraded < stage.CanaryReplicas {
+		return nil
+	}
+
+	var reason string
+	if strategy.Promotion == v1alpha1.UpgradePromotionAuto {
+		reason = upgradeHealthCheck(deps, tc)
+	} else {
+		key := label.AnnPromoteUpgradePrefix + memberType.String()
+		if tc.Annotations[key] != updateRevision {
+			reason = fmt.Sprintf("waiting for annotation %s=%s", key, updateRevision)
+		}
+	}
+
+	if reason == "" {
+		klog.Infof("tidbcluster: [%s/%s]'s %s upgrade to revision %s is promoted", ns, tcName, memberType, updateRevision)
+		stage.Stage = v1alpha1.UpgradeStagePromoted
+		stage.Message = ""
+		stage.LastTransitionTime = now
+		return nil
+	}
+
+	if stage.Stage != v1alpha1.UpgradeStagePaused {
+		stage.Stage = v1alpha1.UpgradeStagePaused
+		stage.LastTransitionTime = now
+	}
+	stage.Message = reason
+	return controller.RequeueErrorf("tidbcluster: [%s/%s]'s %s upgrade is paused after %d canary pods are upgraded: %s",
+		ns, tcName, memberType, stage.CanaryReplicas, reason)
+}
+
+// upgradeHealthCheck checks that there are no down stores in PD and the region leaders are balanced
+// between the TiKV stores, it returns the reason if the cluster is unhealthy.
+func upgradeHealthCheck(deps *controller.Dependencies, tc *v1alpha1.TidbCluster) string {
+	pdClient := controller.GetPDClient(deps.PDControl, tc)
+	if reason := pdapi.IsTiKVStable(pdClient); reason != "" {
+		return reason
+	}
+	return pdapi.IsTiKVLeaderBalanced(pdClient, upgradeLeaderImbalanceTolerance)
+}
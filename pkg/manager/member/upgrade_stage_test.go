@@ -0,0 +1,111 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"testing"
+
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCheckUpgradeStage(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	tc := newTidbClusterForTiKVUpgrader()
+	status := &tc.Status.TiKV
+
+	// no upgrade strategy
+	g.Expect(checkUpgradeStage(deps, tc, v1alpha1.TiKVMemberType, &status.UpgradeStage, "2", 0)).To(Succeed())
+	g.Expect(status.UpgradeStage).To(BeNil())
+
+	// upgrade canary pods
+	tc.Spec.TiKV.UpgradeStrategy = &v1alpha1.UpgradeStrategy{CanaryReplicas: 1}
+	g.Expect(checkUpgradeStage(deps, tc, v1alpha1.TiKVMemberType, &status.UpgradeStage, "2", 0)).To(Succeed())
+	g.Expect(status.UpgradeStage.Stage).To(Equal(v1alpha1.UpgradeStageCanary))
+	g.Expect(status.UpgradeStage.Revision).To(Equal("2"))
+
+	// paused after the canary pods are upgraded
+	err := checkUpgradeStage(deps, tc, v1alpha1.TiKVMemberType, &status.UpgradeStage, "2", 1)
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(status.UpgradeStage.Stage).To(Equal(v1alpha1.UpgradeStagePaused))
+	g.Expect(status.UpgradeStage.Message).To(ContainSubstring(label.AnnPromoteUpgradePrefix + "tikv"))
+
+	// the promotion of another revision is ignored
+	tc.Annotations = map[string]string{label.AnnPromoteUpgradePrefix + "tikv": "1"}
+	err = checkUpgradeStage(deps, tc, v1alpha1.TiKVMemberType, &status.UpgradeStage, "2", 1)
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+
+	// promoted by annotation
+	tc.Annotations[label.AnnPromoteUpgradePrefix+"tikv"] = "2"
+	g.Expect(checkUpgradeStage(deps, tc, v1alpha1.TiKVMemberType, &status.UpgradeStage, "2", 1)).To(Succeed())
+	g.Expect(status.UpgradeStage.Stage).To(Equal(v1alpha1.UpgradeStagePromoted))
+	g.Expect(checkUpgradeStage(deps, tc, v1alpha1.TiKVMemberType, &status.UpgradeStage, "2", 2)).To(Succeed())
+
+	// a new revision starts a new upgrade stage
+	tc.Spec.TiKV.UpgradeStrategy.Promotion = v1alpha1.UpgradePromotionAuto
+	g.Expect(checkUpgradeStage(deps, tc, v1alpha1.TiKVMemberType, &status.UpgradeStage, "3", 0)).To(Succeed())
+	g.Expect(status.UpgradeStage.Stage).To(Equal(v1alpha1.UpgradeStageCanary))
+	g.Expect(status.UpgradeStage.Revision).To(Equal("3"))
+
+	// promoted by health check
+	pdClient := controller.NewFakePDClient(deps.PDControl.(*pdapi.FakePDControl), tc)
+	stores := []*pdapi.StoreInfo{
+		newStoreInfoForUpgradeStage(1, v1alpha1.TiKVStateUp, 100),
+		newStoreInfoForUpgradeStage(2, v1alpha1.TiKVStateUp, 10),
+		newStoreInfoForUpgradeStage(3, v1alpha1.TiKVStateDown, 0),
+	}
+	pdClient.AddReaction(pdapi.GetStoresActionType, func(action *pdapi.Action) (interface{}, error) {
+		return &pdapi.StoresInfo{Stores: stores}, nil
+	})
+	err = checkUpgradeStage(deps, tc, v1alpha1.TiKVMemberType, &status.UpgradeStage, "3", 1)
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(status.UpgradeStage.Message).To(ContainSubstring("is not up"))
+
+	stores[2].Store.StateName = v1alpha1.TiKVStateUp
+	stores[2].Status.LeaderCount = 100
+	err = checkUpgradeStage(deps, tc, v1alpha1.TiKVMemberType, &status.UpgradeStage, "3", 1)
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(status.UpgradeStage.Message).To(ContainSubstring("Store 2 has 10 leaders"))
+
+	// the message is stable when several stores are unbalanced
+	stores[0].Status.LeaderCount = 10
+	for i := 0; i < 10; i++ {
+		err = checkUpgradeStage(deps, tc, v1alpha1.TiKVMemberType, &status.UpgradeStage, "3", 1)
+		g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+		g.Expect(status.UpgradeStage.Message).To(ContainSubstring("Store 1 has 10 leaders"))
+	}
+
+	stores[0].Status.LeaderCount = 100
+	stores[1].Status.LeaderCount = 90
+	g.Expect(checkUpgradeStage(deps, tc, v1alpha1.TiKVMemberType, &status.UpgradeStage, "3", 1)).To(Succeed())
+	g.Expect(status.UpgradeStage.Stage).To(Equal(v1alpha1.UpgradeStagePromoted))
+	g.Expect(status.UpgradeStage.Message).To(BeEmpty())
+}
+
+func newStoreInfoForUpgradeStage(id uint64, state string, leaderCount int) *pdapi.StoreInfo {
+	return &pdapi.StoreInfo{
+		Store: &pdapi.MetaStore{
+			Store:     &metapb.Store{Id: id},
+			StateName: state,
+		},
+		Status: &pdapi.StoreStatus{LeaderCount: leaderCount},
+	}
+}
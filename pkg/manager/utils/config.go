@@ -15,6 +15,7 @@ package utils
 
 import (
 	"fmt"
+	"reflect"
 
 	perrors "github.com/pingcap/errors"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
@@ -87,7 +88,7 @@ func UpdateConfigMapIfNeed(
 			desired.Name = inUseName
 		}
 		return nil
-	case v1alpha1.ConfigUpdateStrategyRollingUpdate:
+	case v1alpha1.ConfigUpdateStrategyRollingUpdate, v1alpha1.ConfigUpdateStrategyOnline:
 		existing, err := cmLister.ConfigMaps(desired.Namespace).Get(inUseName)
 		if err != nil {
 			if errors.IsNotFound(err) {
@@ -126,3 +127,52 @@ func confirmNameByData(existing, desired *corev1.ConfigMap, dataEqual bool) {
 		desired.Name = fmt.Sprintf("%s-new", desired.Name)
 	}
 }
+
+// DiffConfigItems compares the toml config of the in-use configmap and the desired one item by item,
+// it returns the changed and added items keyed by their dotted names such as `raftstore.sync-log`,
+// and the names of the items that are removed from the desired config.
+func DiffConfigItems(oldData, newData string) (map[string]interface{}, []string, error) {
+	oldItems := map[string]interface{}{}
+	if err := toml.Unmarshal([]byte(oldData), &oldItems); err != nil {
+		return nil, nil, err
+	}
+	newItems := map[string]interface{}{}
+	if err := toml.Unmarshal([]byte(newData), &newItems); err != nil {
+		return nil, nil, err
+	}
+
+	oldFlat := map[string]interface{}{}
+	FlattenConfigItems("", oldItems, oldFlat)
+	newFlat := map[string]interface{}{}
+	FlattenConfigItems("", newItems, newFlat)
+
+	changed := map[string]interface{}{}
+	for k, v := range newFlat {
+		if old, ok := oldFlat[k]; !ok || !reflect.DeepEqual(old, v) {
+			changed[k] = v
+		}
+	}
+	var removed []string
+	for k := range oldFlat {
+		if _, ok := newFlat[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+	return changed, removed, nil
+}
+
+// FlattenConfigItems flattens the nested tables of the config into dotted names,
+// arrays (including arrays of tables) are kept as a single item.
+func FlattenConfigItems(prefix string, items map[string]interface{}, flat map[string]interface{}) {
+	for k, v := range items {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		if table, ok := v.(map[string]interface{}); ok {
+			FlattenConfigItems(key, table, flat)
+			continue
+		}
+		flat[key] = v
+	}
+}
@@ -299,3 +299,56 @@ func TestConfirmNameByData(t *testing.T) {
 		testFn(&tests[i], t)
 	}
 }
+
+func TestDiffConfigItems(t *testing.T) {
+	g := NewGomegaWithT(t)
+	type testcase struct {
+		name    string
+		old     string
+		new     string
+		changed map[string]interface{}
+		removed []string
+	}
+
+	tests := []testcase{
+		{
+			name:    "equal",
+			old:     "[raftstore]\nsync-log = true",
+			new:     "[raftstore]\n  sync-log = true\n",
+			changed: map[string]interface{}{},
+		},
+		{
+			name: "nested item changed and added",
+			old:  "[rocksdb.defaultcf]\nblock-size = \"64KB\"\n",
+			new:  "[rocksdb.defaultcf]\nblock-size = \"32KB\"\n[gc]\nbatch-keys = 256\n",
+			changed: map[string]interface{}{
+				"rocksdb.defaultcf.block-size": "32KB",
+				"gc.batch-keys":                int64(256),
+			},
+		},
+		{
+			name:    "item removed",
+			old:     "[log]\nlevel = \"info\"\n[gc]\nbatch-keys = 256\n",
+			new:     "[log]\nlevel = \"info\"\n",
+			changed: map[string]interface{}{},
+			removed: []string{"gc.batch-keys"},
+		},
+		{
+			name:    "array is a single item",
+			old:     "[server]\nlabels = [\"a\"]\n",
+			new:     "[server]\nlabels = [\"a\", \"b\"]\n",
+			changed: map[string]interface{}{"server.labels": []interface{}{"a", "b"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Log(test.name)
+		changed, removed, err := DiffConfigItems(test.old, test.new)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(changed).To(Equal(test.changed))
+		g.Expect(removed).To(Equal(test.removed))
+	}
+
+	_, _, err := DiffConfigItems("a = ", "a = 1")
+	g.Expect(err).To(HaveOccurred())
+}
@@ -38,6 +38,7 @@ const (
 	DeleteMemberActionType                      ActionType = "DeleteMember "
 	SetStoreLabelsActionType                    ActionType = "SetStoreLabels"
 	UpdateReplicationActionType                 ActionType = "UpdateReplicationConfig"
+	UpdateConfigActionType                      ActionType = "UpdateConfig"
 	BeginEvictLeaderActionType                  ActionType = "BeginEvictLeader"
 	EndEvictLeaderActionType                    ActionType = "EndEvictLeader"
 	GetEvictLeaderSchedulersActionType          ActionType = "GetEvictLeaderSchedulers"
@@ -47,6 +48,9 @@ const (
 	GetAutoscalingPlansActionType               ActionType = "GetAutoscalingPlans"
 	GetRecoveringMarkActionType                 ActionType = "GetRecoveringMark"
 	PDMSTransferPrimaryActionType               ActionType = "PDMSTransferPrimary"
+	GetPlacementRuleBundleActionType            ActionType = "GetPlacementRuleBundle"
+	SetPlacementRuleBundleActionType            ActionType = "SetPlacementRuleBundle"
+	DeletePlacementRuleBundleActionType         ActionType = "DeletePlacementRuleBundle"
 )
 
 type NotFoundReaction struct {
@@ -62,6 +66,8 @@ type Action struct {
 	Name        string
 	Labels      map[string]string
 	Replication PDReplicationConfig
+	RuleBundle  *PlacementRuleBundle
+	Config      map[string]interface{}
 }
 
 type Reaction func(action *Action) (interface{}, error)
@@ -230,6 +236,16 @@ func (c *FakePDClient) UpdateReplicationConfig(config PDReplicationConfig) error
 	return nil
 }
 
+// UpdateConfig updates the config items that PD supports changing online
+func (c *FakePDClient) UpdateConfig(config map[string]interface{}) error {
+	if reaction, ok := c.reactions[UpdateConfigActionType]; ok {
+		action := &Action{Config: config}
+		_, err := reaction(action)
+		return err
+	}
+	return nil
+}
+
 func (c *FakePDClient) BeginEvictLeader(storeID uint64) error {
 	if reaction, ok := c.reactions[BeginEvictLeaderActionType]; ok {
 		action := &Action{ID: storeID}
@@ -303,6 +319,33 @@ func (c *FakePDClient) GetRecoveringMark() (bool, error) {
 	return true, nil
 }
 
+func (c *FakePDClient) GetPlacementRuleBundle(groupID string) (*PlacementRuleBundle, error) {
+	action := &Action{Name: groupID}
+	result, err := c.fakeAPI(GetPlacementRuleBundleActionType, action)
+	if err != nil {
+		return nil, err
+	}
+	return result.(*PlacementRuleBundle), nil
+}
+
+func (c *FakePDClient) SetPlacementRuleBundle(bundle *PlacementRuleBundle) error {
+	if reaction, ok := c.reactions[SetPlacementRuleBundleActionType]; ok {
+		action := &Action{Name: bundle.ID, RuleBundle: bundle}
+		_, err := reaction(action)
+		return err
+	}
+	return nil
+}
+
+func (c *FakePDClient) DeletePlacementRuleBundle(groupID string) error {
+	if reaction, ok := c.reactions[DeletePlacementRuleBundleActionType]; ok {
+		action := &Action{Name: groupID}
+		_, err := reaction(action)
+		return err
+	}
+	return nil
+}
+
 // FakePDMSClient implements a fake version of PDMSClient.
 type FakePDMSClient struct {
 	reactions map[ActionType]Reaction
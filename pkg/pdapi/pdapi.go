@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -69,6 +70,9 @@ type PDClient interface {
 	SetStoreLabels(storeID uint64, labels map[string]string) (bool, error)
 	// UpdateReplicationConfig updates the replication config
 	UpdateReplicationConfig(config PDReplicationConfig) error
+	// UpdateConfig updates the config items that PD supports changing online,
+	// the keys of config are the dotted item names such as `schedule.leader-schedule-limit`
+	UpdateConfig(config map[string]interface{}) error
 	// DeleteStore deletes a TiKV store from cluster
 	DeleteStore(storeID uint64) error
 	// SetStoreState sets store to specified state.
@@ -98,6 +102,12 @@ type PDClient interface {
 	GetMSMembers(service string) ([]string, error)
 	// GetMSPrimary returns the primary PDMS member service-addr from cluster by specific microservice
 	GetMSPrimary(service string) (string, error)
+	// GetPlacementRuleBundle returns the placement rule group and its rules
+	GetPlacementRuleBundle(groupID string) (*PlacementRuleBundle, error)
+	// SetPlacementRuleBundle replaces the placement rule group and all its rules
+	SetPlacementRuleBundle(bundle *PlacementRuleBundle) error
+	// DeletePlacementRuleBundle deletes the placement rule group and all its rules
+	DeletePlacementRuleBundle(groupID string) error
 }
 
 var (
@@ -116,6 +126,7 @@ var (
 	evictLeaderSchedulerConfigPrefix = "pd/api/v1/scheduler-config/evict-leader-scheduler/list"
 	autoscalingPrefix                = "autoscaling"
 	recoveringMarkPrefix             = "pd/api/v1/admin/cluster/markers/snapshot-recovering"
+	placementRulePrefix              = "pd/api/v1/config/placement-rule"
 	// microservice
 	MicroservicePrefix = "pd/api/v2/ms"
 )
@@ -258,6 +269,38 @@ type Plan struct {
 	Labels       map[string]string `json:"labels"`
 }
 
+// below copied from github.com/tikv/pd/pkg/schedule/placement
+
+// PlacementLabelConstraint is used to filter the stores by the store labels
+type PlacementLabelConstraint struct {
+	Key    string   `json:"key,omitempty"`
+	Op     string   `json:"op,omitempty"`
+	Values []string `json:"values,omitempty"`
+}
+
+// PlacementRule is the placement rule which decides where the replicas of the key range are placed
+type PlacementRule struct {
+	GroupID          string                     `json:"group_id"`
+	ID               string                     `json:"id"`
+	Index            int                        `json:"index,omitempty"`
+	Override         bool                       `json:"override,omitempty"`
+	StartKeyHex      string                     `json:"start_key"`
+	EndKeyHex        string                     `json:"end_key"`
+	Role             string                     `json:"role"`
+	Count            int                        `json:"count"`
+	LabelConstraints []PlacementLabelConstraint `json:"label_constraints,omitempty"`
+	LocationLabels   []string                   `json:"location_labels,omitempty"`
+	IsolationLevel   string                     `json:"isolation_level,omitempty"`
+}
+
+// PlacementRuleBundle is a placement rule group with all the rules in it
+type PlacementRuleBundle struct {
+	ID       string           `json:"group_id"`
+	Index    int              `json:"group_index"`
+	Override bool             `json:"group_override"`
+	Rules    []*PlacementRule `json:"rules"`
+}
+
 type schedulerInfo struct {
 	Name    string `json:"name"`
 	StoreID uint64 `json:"store_id"`
@@ -562,6 +605,24 @@ func (c *pdClient) UpdateReplicationConfig(config PDReplicationConfig) error {
 	return fmt.Errorf("failed %v to update replication: %v", res.StatusCode, err)
 }
 
+func (c *pdClient) UpdateConfig(config map[string]interface{}) error {
+	apiURL := fmt.Sprintf("%s/%s", c.url, configPrefix)
+	data, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+	res, err := c.httpClient.Post(apiURL, "application/json", bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+	defer httputil.DeferClose(res.Body)
+	if res.StatusCode == http.StatusOK {
+		return nil
+	}
+	err = httputil.ReadErrorBody(res.Body)
+	return fmt.Errorf("failed %v to update config: %v", res.StatusCode, err)
+}
+
 func (c *pdClient) BeginEvictLeader(storeID uint64) error {
 	leaderEvictInfo := getLeaderEvictSchedulerInfo(storeID)
 	apiURL := fmt.Sprintf("%s/%s", c.url, schedulersPrefix)
@@ -795,6 +856,44 @@ func (c *pdClient) GetAutoscalingPlans(strategy Strategy) ([]Plan, error) {
 	return plans, nil
 }
 
+func (c *pdClient) GetPlacementRuleBundle(groupID string) (*PlacementRuleBundle, error) {
+	apiURL := fmt.Sprintf("%s/%s/%s", c.url, placementRulePrefix, url.PathEscape(groupID))
+	body, err := httputil.GetBodyOK(c.httpClient, apiURL)
+	if err != nil {
+		return nil, err
+	}
+	bundle := &PlacementRuleBundle{}
+	err = json.Unmarshal(body, bundle)
+	if err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
+func (c *pdClient) SetPlacementRuleBundle(bundle *PlacementRuleBundle) error {
+	apiURL := fmt.Sprintf("%s/%s/%s", c.url, placementRulePrefix, url.PathEscape(bundle.ID))
+	data, err := json.Marshal(bundle)
+	if err != nil {
+		return err
+	}
+	res, err := c.httpClient.Post(apiURL, "application/json", bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+	defer httputil.DeferClose(res.Body)
+	if res.StatusCode == http.StatusOK {
+		return nil
+	}
+	err = httputil.ReadErrorBody(res.Body)
+	return fmt.Errorf("failed %v to set placement rule group %s: %v", res.StatusCode, bundle.ID, err)
+}
+
+func (c *pdClient) DeletePlacementRuleBundle(groupID string) error {
+	apiURL := fmt.Sprintf("%s/%s/%s", c.url, placementRulePrefix, url.PathEscape(groupID))
+	_, err := httputil.DeleteBodyOK(c.httpClient, apiURL)
+	return err
+}
+
 func getLeaderEvictSchedulerInfo(storeID uint64) *schedulerInfo {
 	return &schedulerInfo{"evict-leader-scheduler", storeID}
 }
@@ -798,6 +798,18 @@ func TestGeneric(t *testing.T) {
 			wantPath:    fmt.Sprintf("/%s", pdReplicationPrefix),
 			checkResult: checkNoError,
 		},
+		{
+			name:   "UpdateConfig",
+			method: "UpdateConfig",
+			args: []reflect.Value{
+				reflect.ValueOf(map[string]interface{}{"schedule.leader-schedule-limit": 8}),
+			},
+			resp:        []byte(``),
+			statusCode:  http.StatusOK,
+			wantMethod:  "POST",
+			wantPath:    fmt.Sprintf("/%s", configPrefix),
+			checkResult: checkNoError,
+		},
 		{
 			name:   "BeginEvictLeader",
 			method: "BeginEvictLeader",
@@ -866,6 +878,48 @@ func TestGeneric(t *testing.T) {
 			wantPath:    fmt.Sprintf("/%s/%s", pdLeaderTransferPrefix, "foo"),
 			checkResult: checkNoError,
 		},
+		{
+			name:   "GetPlacementRuleBundle",
+			method: "GetPlacementRuleBundle",
+			args: []reflect.Value{
+				reflect.ValueOf("tidb"),
+			},
+			resp:       []byte(`{"group_id":"tidb","group_index":1,"group_override":true,"rules":[{"group_id":"tidb","id":"r1","start_key":"","end_key":"","role":"voter","count":3}]}`),
+			statusCode: http.StatusOK,
+			wantMethod: "GET",
+			wantPath:   fmt.Sprintf("/%s/tidb", placementRulePrefix),
+			checkResult: func(t *testing.T, results []reflect.Value) {
+				checkNoError(t, results)
+				bundle := results[0].Interface().(*PlacementRuleBundle)
+				if bundle.Index != 1 || !bundle.Override || len(bundle.Rules) != 1 || bundle.Rules[0].Count != 3 {
+					t.Errorf("unexpected placement rule bundle %+v", bundle)
+				}
+			},
+		},
+		{
+			name:   "SetPlacementRuleBundle",
+			method: "SetPlacementRuleBundle",
+			args: []reflect.Value{
+				reflect.ValueOf(&PlacementRuleBundle{ID: "tidb"}),
+			},
+			resp:        []byte(``),
+			statusCode:  http.StatusOK,
+			wantMethod:  "POST",
+			wantPath:    fmt.Sprintf("/%s/tidb", placementRulePrefix),
+			checkResult: checkNoError,
+		},
+		{
+			name:   "DeletePlacementRuleBundle",
+			method: "DeletePlacementRuleBundle",
+			args: []reflect.Value{
+				reflect.ValueOf("tidb"),
+			},
+			resp:        []byte(``),
+			statusCode:  http.StatusOK,
+			wantMethod:  "DELETE",
+			wantPath:    fmt.Sprintf("/%s/tidb", placementRulePrefix),
+			checkResult: checkNoError,
+		},
 	}
 
 	for _, tt := range tests {
@@ -15,6 +15,7 @@ package pdapi
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 )
@@ -62,3 +63,52 @@ func IsPDStable(pdClient PDClient) string {
 		return fmt.Sprintf("Only %d out of %d PDs are healthy", healthy, total)
 	}
 }
+
+// IsTiKVLeaderBalanced queries PD to verify that the region leaders are balanced between the up TiKV stores,
+// that is the leader count of each store is not less than (1 - tolerance) of the average leader count.
+func IsTiKVLeaderBalanced(pdClient PDClient, tolerance float64) string {
+	storesInfo, err := pdClient.GetStores()
+	if err != nil {
+		return fmt.Sprintf("can't access PD: %s", err)
+	}
+
+	leaderCounts := map[uint64]int{}
+	total := 0
+	for _, store := range storesInfo.Stores {
+		if store.Store == nil || store.Status == nil {
+			return "missing data for one of its stores"
+		}
+		if store.Store.StateName != v1alpha1.TiKVStateUp || isTiFlashStore(store.Store) {
+			continue
+		}
+		leaderCounts[store.Store.GetId()] = store.Status.LeaderCount
+		total += store.Status.LeaderCount
+	}
+	if len(leaderCounts) == 0 {
+		return ""
+	}
+
+	// check the stores in order, so the message is stable between syncs
+	ids := make([]uint64, 0, len(leaderCounts))
+	for id := range leaderCounts {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	average := float64(total) / float64(len(leaderCounts))
+	for _, id := range ids {
+		if count := leaderCounts[id]; float64(count) < average*(1-tolerance) {
+			return fmt.Sprintf("Store %d has %d leaders, less than the average %.0f", id, count, average)
+		}
+	}
+	return ""
+}
+
+func isTiFlashStore(store *MetaStore) bool {
+	for _, l := range store.GetLabels() {
+		if l.GetKey() == "engine" && l.GetValue() == "tiflash" {
+			return true
+		}
+	}
+	return false
+}
@@ -15,6 +15,7 @@ package tiflashapi
 
 import (
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
@@ -25,6 +26,7 @@ import (
 
 const (
 	storeStatusPath = "tiflash/store-status"
+	configPath      = "config"
 )
 
 type Status string
@@ -39,6 +41,8 @@ const (
 
 type TiFlashClient interface {
 	GetStoreStatus() (Status, error)
+	// GetConfig returns the running config of the TiFlash proxy
+	GetConfig() (map[string]interface{}, error)
 }
 
 type tiflashClient struct {
@@ -74,3 +78,16 @@ func (c *tiflashClient) GetStoreStatus() (Status, error) {
 
 	return Status(body), nil
 }
+
+func (c *tiflashClient) GetConfig() (map[string]interface{}, error) {
+	apiURL := fmt.Sprintf("%s/%s", c.url, configPath)
+	body, err := httputil.GetBodyOK(c.httpClient, apiURL)
+	if err != nil {
+		return nil, err
+	}
+	config := map[string]interface{}{}
+	if err := json.Unmarshal(body, &config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
@@ -14,10 +14,12 @@
 package crypto
 
 import (
+	"crypto/tls"
 	"crypto/x509"
 	"io/ioutil"
 	"os"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
@@ -133,3 +135,39 @@ EKTcWGekdmdDPsHloRNtsiCa697B2O9IFA==
 	_, err = LoadTlsConfigFromSecret(secret)
 	g.Expect(err).Should(BeNil())
 }
+
+func TestSignCSR(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	caCert, caKey, err := NewCA("test-ca", time.Hour)
+	g.Expect(err).Should(BeNil())
+	ca, err := ParseCertificate(caCert)
+	g.Expect(err).Should(BeNil())
+	g.Expect(ca.IsCA).Should(BeTrue())
+	g.Expect(ca.Subject.CommonName).Should(Equal("test-ca"))
+
+	csr, key, err := NewCSR("tidb", []string{"tidb.test-cluster.svc"}, []string{"127.0.0.1"})
+	g.Expect(err).Should(BeNil())
+	notAfter := time.Now().Add(30 * time.Minute).Truncate(time.Second)
+	cert, err := SignCSR(csr, caCert, caKey, notAfter)
+	g.Expect(err).Should(BeNil())
+
+	_, err = tls.X509KeyPair(cert, key)
+	g.Expect(err).Should(BeNil())
+	leaf, err := ParseCertificate(cert)
+	g.Expect(err).Should(BeNil())
+	g.Expect(leaf.DNSNames).Should(Equal([]string{"tidb.test-cluster.svc"}))
+	g.Expect(leaf.NotAfter.Equal(notAfter)).Should(BeTrue())
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ca)
+	_, err = leaf.Verify(x509.VerifyOptions{Roots: pool, DNSName: "tidb.test-cluster.svc", KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+	g.Expect(err).Should(BeNil())
+
+	certs, err := ParseCertificates(append(caCert, cert...))
+	g.Expect(err).Should(BeNil())
+	g.Expect(certs).Should(HaveLen(2))
+
+	_, err = SignCSR(csr, []byte("invalid"), caKey, notAfter)
+	g.Expect(err).ShouldNot(BeNil())
+}
@@ -36,6 +36,10 @@ const (
 	TiFlashStoreNotUp = "TiFlashStoreNotUp"
 	// TiCDCCaptureNotReady is added when one of ticdc capture is not ready.
 	TiCDCCaptureNotReady = "TiCDCCaptureNotReady"
+	// ConfigDrifted is added when the running config of some pods differs from the rendered config.
+	ConfigDrifted = "ConfigDrifted"
+	// ConfigInSync is added when the running config of all pods matches the rendered config.
+	ConfigInSync = "ConfigInSync"
 )
 
 // NewTidbClusterCondition creates a new tidbcluster condition.
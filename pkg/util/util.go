@@ -216,6 +216,11 @@ func ClusterTLSSecretName(tcName, component string) string {
 	return fmt.Sprintf("%s-%s-cluster-secret", tcName, component)
 }
 
+// ClusterTLSCASecretName returns the name of the secret that stores the CA of the BuiltIn TLS issuer
+func ClusterTLSCASecretName(tcName string) string {
+	return fmt.Sprintf("%s-tls-ca", tcName)
+}
+
 func TiDBClientTLSSecretName(tcName string, secretName *string) string {
 	if secretName != nil {
 		return *secretName
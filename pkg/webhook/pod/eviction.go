@@ -0,0 +1,264 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openshift/generic-admission-server/pkg/apiserver"
+	admission "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	"github.com/pingcap/tidb-operator/pkg/tikvapi"
+	operatorutils "github.com/pingcap/tidb-operator/pkg/util"
+	"github.com/pingcap/tidb-operator/pkg/webhook/util"
+)
+
+const (
+	// evictionAnnotationExpiration is the expiration of the leader eviction annotations added by the webhook,
+	// the operator stops evicting the leaders if the pod is not evicted in time, e.g. the node drain is canceled
+	evictionAnnotationExpiration = 30 * time.Minute
+)
+
+// PodEvictionAdmissionControl intercepts the eviction of the TiKV, PD and TiCDC pods, e.g. node drains.
+// The eviction is rejected with 429 and retried by the client until the leaders of the TiKV store are evicted,
+// the PD leader is transferred or the TiCDC capture is drained.
+type PodEvictionAdmissionControl struct {
+	lock        sync.RWMutex
+	initialized bool
+
+	kubeCli      kubernetes.Interface
+	operatorCli  versioned.Interface
+	pdControl    pdapi.PDControlInterface
+	tikvControl  tikvapi.TiKVControlInterface
+	ticdcControl controller.TiCDCControlInterface
+}
+
+var _ apiserver.ValidatingAdmissionHook = &PodEvictionAdmissionControl{}
+
+func NewPodEvictionAdmissionControl() *PodEvictionAdmissionControl {
+	return &PodEvictionAdmissionControl{}
+}
+
+func (pc *PodEvictionAdmissionControl) ValidatingResource() (plural schema.GroupVersionResource, singular string) {
+	return schema.GroupVersionResource{
+			Group:    "admission.tidb.pingcap.com",
+			Version:  "v1alpha1",
+			Resource: "podevictionvalidations",
+		},
+		"podevictionvalidation"
+}
+
+func (pc *PodEvictionAdmissionControl) Validate(ar *admission.AdmissionRequest) *admission.AdmissionResponse {
+	pc.lock.RLock()
+	defer pc.lock.RUnlock()
+	if !pc.initialized {
+		return &admission.AdmissionResponse{
+			Allowed: false,
+		}
+	}
+
+	if ar.Operation != admission.Create || ar.SubResource != "eviction" {
+		return util.ARSuccess()
+	}
+
+	namespace, name := ar.Namespace, ar.Name
+	pod, err := pc.kubeCli.CoreV1().Pods(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return util.ARSuccess()
+		}
+		err = fmt.Errorf("get pod %s/%s failed, err: %v", namespace, name, err)
+		klog.Error(err)
+		return util.ARFail(err)
+	}
+
+	if pod.Labels[label.ManagedByLabelKey] != label.TiDBOperator {
+		return util.ARSuccess()
+	}
+	tcName := pod.Labels[label.InstanceLabelKey]
+	if tcName == "" {
+		return util.ARSuccess()
+	}
+	component := pod.Labels[label.ComponentLabelKey]
+	if component != label.TiKVLabelVal && component != label.PDLabelVal && component != label.TiCDCLabelVal {
+		return util.ARSuccess()
+	}
+
+	tc, err := pc.operatorCli.PingcapV1alpha1().TidbClusters(namespace).Get(context.TODO(), tcName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return util.ARSuccess()
+		}
+		err = fmt.Errorf("get tidbcluster %s/%s failed, pod %s, err: %v", namespace, tcName, name, err)
+		klog.Error(err)
+		return util.ARFail(err)
+	}
+
+	// the annotations to start the leader eviction are not added for dry-run requests
+	dryRun := ar.DryRun != nil && *ar.DryRun
+	klog.Infof("admit eviction of %s pod %s/%s", component, namespace, name)
+	switch component {
+	case label.TiKVLabelVal:
+		err = pc.admitTiKVEviction(pod, tc, dryRun)
+	case label.PDLabelVal:
+		err = pc.admitPDEviction(pod, tc, dryRun)
+	case label.TiCDCLabelVal:
+		err = pc.admitTiCDCEviction(pod, tc)
+	}
+	if err != nil {
+		klog.Infof("eviction of pod %s/%s is rejected: %v", namespace, name, err)
+		return util.ARTooManyRequests(err)
+	}
+	return util.ARSuccess()
+}
+
+// admitTiKVEviction allows the eviction once the store has no leaders, the leader eviction is started
+// by the evict-leader annotation handled by the operator.
+func (pc *PodEvictionAdmissionControl) admitTiKVEviction(pod *corev1.Pod, tc *v1alpha1.TidbCluster, dryRun bool) error {
+	storeID := pod.Labels[label.StoreIDLabelKey]
+	if storeID == "" {
+		return nil
+	}
+	if store, ok := tc.Status.TiKV.Stores[storeID]; !ok || store.State != v1alpha1.TiKVStateUp {
+		return nil
+	}
+
+	kvClient := pc.tikvControl.GetTiKVPodClient(tc.Namespace, tc.Name, pod.Name, tc.Spec.ClusterDomain, tc.IsTLSClusterEnabled())
+	leaderCount, err := kvClient.GetLeaderCount()
+	if err != nil {
+		return fmt.Errorf("failed to get leader count of store %s: %v", storeID, err)
+	}
+	if leaderCount == 0 {
+		return nil
+	}
+
+	if _, ok := pod.Annotations[v1alpha1.EvictLeaderAnnKey]; !ok && !dryRun {
+		if err := pc.annotatePod(pod, v1alpha1.EvictLeaderAnnKey, v1alpha1.EvictLeaderValueNone, v1alpha1.TiKVEvictLeaderExpirationTimeAnnKey); err != nil {
+			return err
+		}
+	}
+	return fmt.Errorf("store %s still has %d leaders, wait for the leaders to be evicted", storeID, leaderCount)
+}
+
+// admitPDEviction allows the eviction once the pod is not the PD leader, the leader transfer is started
+// by the transfer-leader annotation handled by the operator. The leader is got from PD because the status
+// of the TidbCluster may be stale.
+func (pc *PodEvictionAdmissionControl) admitPDEviction(pod *corev1.Pod, tc *v1alpha1.TidbCluster, dryRun bool) error {
+	leader, err := controller.GetPDClient(pc.pdControl, tc).GetPDLeader()
+	if err != nil {
+		return fmt.Errorf("failed to get PD leader: %v", err)
+	}
+	leaderName := leader.GetName()
+	if leaderName != pod.Name && !strings.HasPrefix(leaderName, pod.Name+".") {
+		return nil
+	}
+
+	if _, ok := pod.Annotations[v1alpha1.PDLeaderTransferAnnKey]; !ok && !dryRun {
+		if err := pc.annotatePod(pod, v1alpha1.PDLeaderTransferAnnKey, v1alpha1.TransferLeaderValueNone, v1alpha1.PDLeaderTransferExpirationTimeAnnKey); err != nil {
+			return err
+		}
+	}
+	return fmt.Errorf("pod is the PD leader, wait for the leader to be transferred")
+}
+
+// admitTiCDCEviction allows the eviction once the capture is drained and is not the owner,
+// the same as the graceful shutdown of TiCDC during upgrade.
+func (pc *PodEvictionAdmissionControl) admitTiCDCEviction(pod *corev1.Pod, tc *v1alpha1.TidbCluster) error {
+	ordinal, err := operatorutils.GetOrdinalFromPodName(pod.Name)
+	if err != nil {
+		return err
+	}
+
+	tableCount, retry, err := pc.ticdcControl.DrainCapture(tc, ordinal)
+	if err != nil {
+		return fmt.Errorf("failed to drain capture: %v", err)
+	}
+	if retry || tableCount != 0 {
+		return fmt.Errorf("capture still has %d tables, wait for the capture to be drained", tableCount)
+	}
+	resigned, err := pc.ticdcControl.ResignOwner(tc, ordinal)
+	if err != nil {
+		return fmt.Errorf("failed to resign owner: %v", err)
+	}
+	if !resigned {
+		return fmt.Errorf("capture is still the owner, wait for the owner to be resigned")
+	}
+	return nil
+}
+
+func (pc *PodEvictionAdmissionControl) annotatePod(pod *corev1.Pod, key, value, expirationKey string) error {
+	pod = pod.DeepCopy()
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	pod.Annotations[key] = value
+	pod.Annotations[expirationKey] = time.Now().Add(evictionAnnotationExpiration).Format(time.RFC3339)
+	if _, err := pc.kubeCli.CoreV1().Pods(pod.Namespace).Update(context.TODO(), pod, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to add annotation %s to pod: %v", key, err)
+	}
+	klog.Infof("add annotation %s=%s to pod %s/%s for eviction", key, value, pod.Namespace, pod.Name)
+	return nil
+}
+
+// Initialize implements AdmissionHook.Initialize interface. It's is called as
+// a post-start hook.
+func (pc *PodEvictionAdmissionControl) Initialize(cfg *rest.Config, stopCh <-chan struct{}) error {
+	pc.lock.Lock()
+	defer pc.lock.Unlock()
+
+	kubeCli, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+	operatorCli, err := versioned.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	// the secrets are used to access the components when TLS is enabled
+	kubeInformerFactory := kubeinformers.NewSharedInformerFactory(kubeCli, 0)
+	secretInformer := kubeInformerFactory.Core().V1().Secrets()
+	secretLister := secretInformer.Lister()
+	kubeInformerFactory.Start(stopCh)
+	if !cache.WaitForCacheSync(stopCh, secretInformer.Informer().HasSynced) {
+		return fmt.Errorf("failed to sync secrets cache")
+	}
+
+	pc.kubeCli = kubeCli
+	pc.operatorCli = operatorCli
+	pc.pdControl = pdapi.NewDefaultPDControl(secretLister)
+	pc.tikvControl = tikvapi.NewDefaultTiKVControl(secretLister)
+	pc.ticdcControl = controller.NewDefaultTiCDCControl(secretLister)
+
+	pc.initialized = true
+	return nil
+}
@@ -0,0 +1,161 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/kvproto/pkg/pdpb"
+	admission "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/pointer"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned/fake"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	"github.com/pingcap/tidb-operator/pkg/tikvapi"
+)
+
+func newEvictionTestPod(name, component string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "ns",
+			Labels:    label.New().Instance("demo").Component(component),
+		},
+	}
+}
+
+func newEvictionRequest(pod *corev1.Pod) *admission.AdmissionRequest {
+	return &admission.AdmissionRequest{
+		Operation:   admission.Create,
+		SubResource: "eviction",
+		Namespace:   pod.Namespace,
+		Name:        pod.Name,
+	}
+}
+
+func TestPodEvictionValidate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := &v1alpha1.TidbCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "ns"},
+		Status: v1alpha1.TidbClusterStatus{
+			// the leader in the status is stale
+			PD: v1alpha1.PDStatus{Leader: v1alpha1.PDMember{Name: "demo-pd-1"}},
+			TiKV: v1alpha1.TiKVStatus{
+				Stores: map[string]v1alpha1.TiKVStore{
+					"1": {ID: "1", PodName: "demo-tikv-0", State: v1alpha1.TiKVStateUp},
+				},
+			},
+		},
+	}
+	tikvPod := newEvictionTestPod("demo-tikv-0", label.TiKVLabelVal)
+	tikvPod.Labels[label.StoreIDLabelKey] = "1"
+	pdLeaderPod := newEvictionTestPod("demo-pd-0", label.PDLabelVal)
+	pdPod := newEvictionTestPod("demo-pd-1", label.PDLabelVal)
+	cdcPod := newEvictionTestPod("demo-ticdc-0", label.TiCDCLabelVal)
+	tidbPod := newEvictionTestPod("demo-tidb-0", label.TiDBLabelVal)
+
+	kubeCli := kubefake.NewSimpleClientset(tikvPod, pdLeaderPod, pdPod, cdcPod, tidbPod)
+	tikvControl := tikvapi.NewFakeTiKVControl(nil)
+	leaderCount := 10
+	kvClient := tikvapi.NewFakeTiKVClient()
+	kvClient.AddReaction(tikvapi.GetLeaderCountActionType, func(action *tikvapi.Action) (interface{}, error) {
+		return leaderCount, nil
+	})
+	tikvControl.SetTiKVPodClient("ns", "demo", "demo-tikv-0", kvClient)
+	pdControl := pdapi.NewFakePDControl(nil)
+	pdClient := pdapi.NewFakePDClient()
+	pdClient.AddReaction(pdapi.GetPDLeaderActionType, func(action *pdapi.Action) (interface{}, error) {
+		return &pdpb.Member{Name: "demo-pd-0"}, nil
+	})
+	pdControl.SetPDClient("ns", "demo", pdClient)
+	tableCount := 3
+	resignCalled := false
+	ticdcControl := controller.NewFakeTiCDCControl()
+	ticdcControl.ResignOwnerFn = func(tc *v1alpha1.TidbCluster, ordinal int32) (bool, error) {
+		resignCalled = true
+		return true, nil
+	}
+	ticdcControl.DrainCaptureFn = func(tc *v1alpha1.TidbCluster, ordinal int32) (int, bool, error) {
+		return tableCount, false, nil
+	}
+	pc := &PodEvictionAdmissionControl{
+		initialized:  true,
+		kubeCli:      kubeCli,
+		operatorCli:  fake.NewSimpleClientset(tc),
+		pdControl:    pdControl,
+		tikvControl:  tikvControl,
+		ticdcControl: ticdcControl,
+	}
+	getPod := func(name string) *corev1.Pod {
+		pod, err := kubeCli.CoreV1().Pods("ns").Get(context.TODO(), name, metav1.GetOptions{})
+		g.Expect(err).NotTo(HaveOccurred())
+		return pod
+	}
+
+	// other operations and components are not checked
+	resp := pc.Validate(&admission.AdmissionRequest{Operation: admission.Delete, Namespace: "ns", Name: "demo-tikv-0"})
+	g.Expect(resp.Allowed).To(BeTrue())
+	resp = pc.Validate(newEvictionRequest(tidbPod))
+	g.Expect(resp.Allowed).To(BeTrue())
+
+	// dry-run requests don't start the leader eviction
+	req := newEvictionRequest(tikvPod)
+	req.DryRun = pointer.BoolPtr(true)
+	resp = pc.Validate(req)
+	g.Expect(resp.Allowed).To(BeFalse())
+	g.Expect(getPod("demo-tikv-0").Annotations).NotTo(HaveKey(v1alpha1.EvictLeaderAnnKey))
+
+	// TiKV store still has leaders
+	resp = pc.Validate(newEvictionRequest(tikvPod))
+	g.Expect(resp.Allowed).To(BeFalse())
+	g.Expect(resp.Result.Code).To(BeEquivalentTo(429))
+	g.Expect(getPod("demo-tikv-0").Annotations).To(HaveKeyWithValue(v1alpha1.EvictLeaderAnnKey, v1alpha1.EvictLeaderValueNone))
+	g.Expect(getPod("demo-tikv-0").Annotations).To(HaveKey(v1alpha1.TiKVEvictLeaderExpirationTimeAnnKey))
+	leaderCount = 0
+	resp = pc.Validate(newEvictionRequest(tikvPod))
+	g.Expect(resp.Allowed).To(BeTrue())
+
+	// leader count can't be got
+	kvClient.AddReaction(tikvapi.GetLeaderCountActionType, func(action *tikvapi.Action) (interface{}, error) {
+		return 0, fmt.Errorf("connection refused")
+	})
+	resp = pc.Validate(newEvictionRequest(tikvPod))
+	g.Expect(resp.Allowed).To(BeFalse())
+
+	// PD leader is transferred before eviction
+	resp = pc.Validate(newEvictionRequest(pdPod))
+	g.Expect(resp.Allowed).To(BeTrue())
+	resp = pc.Validate(newEvictionRequest(pdLeaderPod))
+	g.Expect(resp.Allowed).To(BeFalse())
+	g.Expect(getPod("demo-pd-0").Annotations).To(HaveKeyWithValue(v1alpha1.PDLeaderTransferAnnKey, v1alpha1.TransferLeaderValueNone))
+
+	// TiCDC capture is drained before the owner is resigned and the eviction
+	resp = pc.Validate(newEvictionRequest(cdcPod))
+	g.Expect(resp.Allowed).To(BeFalse())
+	g.Expect(resignCalled).To(BeFalse())
+	tableCount = 0
+	resp = pc.Validate(newEvictionRequest(cdcPod))
+	g.Expect(resp.Allowed).To(BeTrue())
+	g.Expect(resignCalled).To(BeTrue())
+}
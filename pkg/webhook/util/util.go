@@ -15,6 +15,7 @@ package util
 
 import (
 	"encoding/json"
+	"net/http"
 
 	"gomodules.xyz/jsonpatch/v2"
 	admission "k8s.io/api/admission/v1"
@@ -34,6 +35,19 @@ func ARFail(err error) *admission.AdmissionResponse {
 	}
 }
 
+// ARTooManyRequests is a helper function to create an AdmissionResponse that rejects the request
+// temporarily, clients such as `kubectl drain` retry the request later
+func ARTooManyRequests(err error) *admission.AdmissionResponse {
+	return &admission.AdmissionResponse{
+		Allowed: false,
+		Result: &metav1.Status{
+			Message: err.Error(),
+			Reason:  metav1.StatusReasonTooManyRequests,
+			Code:    http.StatusTooManyRequests,
+		},
+	}
+}
+
 // ARSuccess return allow to action
 func ARSuccess() *admission.AdmissionResponse {
 	return &admission.AdmissionResponse{
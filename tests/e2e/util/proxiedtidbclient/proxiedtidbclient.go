@@ -42,6 +42,22 @@ func (p *proxiedTiDBClient) SetServerLabels(tc *v1alpha1.TidbCluster, ordinal in
 	panic("implement when necessary")
 }
 
+func (p *proxiedTiDBClient) GetStatus(tc *v1alpha1.TidbCluster, ordinal int32) (*controller.ServerStatus, error) {
+	panic("implement when necessary")
+}
+
+func (p *proxiedTiDBClient) GetQueryTotal(tc *v1alpha1.TidbCluster, ordinal int32) (float64, error) {
+	panic("implement when necessary")
+}
+
+func (p *proxiedTiDBClient) SetSettings(tc *v1alpha1.TidbCluster, ordinal int32, settings map[string]string) error {
+	panic("implement when necessary")
+}
+
+func (p *proxiedTiDBClient) GetConfig(tc *v1alpha1.TidbCluster, ordinal int32) (map[string]interface{}, error) {
+	panic("implement when necessary")
+}
+
 func NewProxiedTiDBClient(fw portforward.PortForward, caCert []byte) controller.TiDBControlInterface {
 	return &proxiedTiDBClient{fw: fw, httpClient: &http.Client{Timeout: 5 * time.Second}, caCert: caCert}
 }